@@ -0,0 +1,43 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"golang.org/x/mod/semver"
+)
+
+type emptyAppOptions struct{}
+
+func (emptyAppOptions) Get(string) interface{} { return nil }
+
+func TestStoreUpgrades(t *testing.T) {
+	assert.Contains(t, storeUpgrades().Added, authzkeeper.StoreKey)
+	assert.Empty(t, storeUpgrades().Renamed)
+	assert.Empty(t, storeUpgrades().Deleted)
+}
+
+func TestUpgradeHandler(t *testing.T) {
+	appVersion := version.Version
+	version.Version = "v0.99.0"
+	defer func() { version.Version = appVersion }()
+
+	axelarApp := NewAxelarApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), emptyAppOptions{})
+	ctx := axelarApp.NewUncachedContext(false, tmproto.Header{Height: 1})
+
+	versions := axelarApp.mm.GetVersionMap()
+	expectedAuthzVersion := versions[authz.ModuleName]
+	delete(versions, authz.ModuleName)
+	axelarApp.upgradeKeeper.SetModuleVersionMap(ctx, versions)
+
+	axelarApp.upgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: semver.MajorMinor(version.Version), Height: ctx.BlockHeight()})
+
+	assert.Equal(t, expectedAuthzVersion, axelarApp.upgradeKeeper.GetModuleVersionMap(ctx)[authz.ModuleName])
+}
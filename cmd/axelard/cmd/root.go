@@ -41,6 +41,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/utils"
 	"github.com/axelarnetwork/axelar-core/config"
 	"github.com/axelarnetwork/axelar-core/vald"
+	nexusCli "github.com/axelarnetwork/axelar-core/x/nexus/client/cli"
 )
 
 const minGasPrice = "0.007uaxl"
@@ -223,6 +224,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	// add vald after the overwrite so it can set its own defaults
 	rootCmd.AddCommand(vald.GetValdCommand())
+	rootCmd.AddCommand(vald.GetReplayCommand())
 
 	// add health check command
 	rootCmd.AddCommand(vald.GetHealthCheckCommand())
@@ -312,6 +314,7 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		nexusCli.GetCmdTrace(),
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
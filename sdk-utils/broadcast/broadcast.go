@@ -8,9 +8,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/armon/go-metrics"
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -77,7 +79,8 @@ func isSequenceMismatch(err error) bool {
 // Broadcast sends the given tx to the blockchain and blocks until it is added to a block (or timeout).
 func Broadcast(ctx sdkClient.Context, txBytes []byte, options ...BroadcasterOption) (*sdk.TxResponse, error) {
 	res, err := ctx.BroadcastTx(txBytes)
-	if err == nil && ctx.BroadcastMode != flags.BroadcastBlock {
+	// txs that are rejected by the mempool will never be included in a block, so there is no need to wait for them
+	if err == nil && res.Code == abci.CodeTypeOK && ctx.BroadcastMode != flags.BroadcastBlock {
 		params := broadcastParams{
 			Timeout:         config.DefaultRPCConfig().TimeoutBroadcastTxCommit,
 			PollingInterval: 2 * time.Second,
@@ -150,7 +153,7 @@ func WithStateManager(clientCtx sdkClient.Context, txf tx.Factory, logger log.Lo
 }
 
 // Broadcast broadcasts the given msgs to the blockchain, keeps track of the sender's sequence number
-func (b *statefulBroadcaster) Broadcast(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+func (b *statefulBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
 	if len(msgs) == 0 {
 		return nil, fmt.Errorf("no messages to broadcast")
 	}
@@ -164,7 +167,12 @@ func (b *statefulBroadcaster) Broadcast(_ context.Context, msgs ...sdk.Msg) (*sd
 		return nil, err
 	}
 
-	bz, err := PrepareTx(b.clientCtx, b.txf, msgs...)
+	txf := b.txf
+	if gasPrice, ok := GasPriceFromContext(ctx); ok {
+		txf = txf.WithGasPrices(gasPrice.String())
+	}
+
+	bz, err := PrepareTx(b.clientCtx, txf, msgs...)
 	if sdkerrors.ErrWrongSequence.Is(err) {
 		b.txf = b.txf.
 			WithAccountNumber(0).
@@ -395,6 +403,8 @@ func (b *batchedBroadcaster) processBacklog() {
 }
 
 func (b *batchedBroadcaster) broadcast(batch ...broadcastTask) {
+	telemetry.SetGauge(float32(b.backlog.Len()), "broadcast", "backlog", "size")
+
 	var msgs []sdk.Msg
 	for _, task := range batch {
 		if task.Ctx.Err() != nil {
@@ -404,6 +414,7 @@ func (b *batchedBroadcaster) broadcast(batch ...broadcastTask) {
 		msgs = append(msgs, task.Msgs...)
 	}
 
+	metrics.AddSample([]string{"broadcast", "batch", "size"}, float32(len(msgs)))
 	response, err := b.broadcaster.Broadcast(context.Background(), msgs...)
 
 	for _, task := range batch {
@@ -456,3 +467,31 @@ func (s suppressorBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (
 	}
 	return res, err
 }
+
+type metricsBroadcaster struct {
+	broadcaster Broadcaster
+}
+
+// WithMetrics counts the broadcast msgs and broadcast failures per msg type
+func WithMetrics(b Broadcaster) Broadcaster {
+	return metricsBroadcaster{broadcaster: b}
+}
+
+// Broadcast implements the Broadcaster interface
+func (b metricsBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := b.broadcaster.Broadcast(ctx, msgs...)
+
+	status := "success"
+	if err != nil {
+		status = "failure"
+	}
+
+	for _, msg := range msgs {
+		telemetry.IncrCounterWithLabels([]string{"broadcast", "msgs"}, 1, []metrics.Label{
+			telemetry.NewLabel("type", sdk.MsgTypeURL(msg)),
+			telemetry.NewLabel("status", status),
+		})
+	}
+
+	return res, err
+}
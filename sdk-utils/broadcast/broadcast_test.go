@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -19,6 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	tx2 "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -27,6 +29,7 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	tm "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/axelarnetwork/axelar-core/app"
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
@@ -116,6 +119,12 @@ func TestStatefulBroadcaster(t *testing.T) {
 		}
 	})
 
+	broadcastRejected := When("broadcast is rejected by the mempool", func() {
+		clientMock.BroadcastTxSyncFunc = func(context.Context, tm.Tx) (*coretypes.ResultBroadcastTx, error) {
+			return &coretypes.ResultBroadcastTx{Code: sdkerrors.ErrInsufficientFee.ABCICode(), Codespace: sdkerrors.RootCodespace}, nil
+		}
+	})
+
 	txsGetExecuted := When("txs get executed correctly", func() {
 		clientMock.TxFunc = func(context.Context, []byte, bool) (*coretypes.ResultTx, error) {
 			expectedResponse = &coretypes.ResultTx{TxResult: abci.ResponseDeliverTx{
@@ -220,6 +229,14 @@ func TestStatefulBroadcaster(t *testing.T) {
 		When2(getAccountSequenceMismatch).
 		Then2(returnErrorWithCode).Run(t)
 
+	givenSetup.
+		When2(sendingMultipleMessages).
+		When2(accountExists).
+		When2(simulationSucceeds).
+		When2(broadcastRejected).
+		When2(txNotFound).
+		Then2(returnErrorWithCode).Run(t)
+
 	givenSetup.
 		When2(sendingNoMessages).
 		Then2(returnError).Run(t)
@@ -413,6 +430,47 @@ func TestSuppressExecutionErrs(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestWithMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	conf := metrics.DefaultConfig("")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(conf, sink)
+	assert.NoError(t, err)
+
+	counter := func(msg sdk.Msg, status string) metrics.AggregateSample {
+		key := fmt.Sprintf("broadcast.msgs;type=%s;status=%s", sdk.MsgTypeURL(msg), status)
+		for _, interval := range sink.Data() {
+			if value, ok := interval.Counters[key]; ok {
+				return *value.AggregateSample
+			}
+		}
+
+		return metrics.AggregateSample{}
+	}
+
+	broadcaster := &mock2.BroadcasterMock{
+		BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+			return nil, fmt.Errorf("error")
+		}}
+
+	msgs := randomMsgs(5)
+	_, err = broadcast.WithMetrics(broadcaster).Broadcast(context.Background(), msgs...)
+	assert.Error(t, err)
+	assert.Len(t, broadcaster.BroadcastCalls(), 1)
+	assert.Len(t, broadcaster.BroadcastCalls()[0].Msgs, 5)
+	assert.Equal(t, 5, counter(msgs[0], "failure").Count)
+	assert.EqualValues(t, 5, counter(msgs[0], "failure").Sum)
+	assert.Zero(t, counter(msgs[0], "success").Count)
+
+	broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+
+	_, err = broadcast.WithMetrics(broadcaster).Broadcast(context.Background(), msgs[:3]...)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, counter(msgs[0], "success").Count)
+	assert.Equal(t, 5, counter(msgs[0], "failure").Count)
+}
+
 func unsafePack(value sdk.Msg) *codectypes.Any {
 	return codectypes.UnsafePackAny(value)
 }
@@ -432,3 +490,296 @@ func randomMsgs(count int) []sdk.Msg {
 	}
 	return msgs
 }
+
+func TestWithPersistentBacklog(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Codec
+	height := rand.PosI64()
+	latestHeight := func(context.Context) (int64, error) { return height, nil }
+	maxAge := rand.I64Between(1, 20)
+
+	batchSize := int(rand.I64Between(1, 10))
+
+	// interrupt simulates a shutdown while msgs are being broadcast, so they remain in the backlog
+	interrupt := func(t *testing.T, ctx context.Context, db dbm.DB, msgs []sdk.Msg) {
+		called := make(chan struct{})
+		shutdown := make(chan struct{})
+		t.Cleanup(func() { close(shutdown) })
+
+		broadcaster := &mock2.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				close(called)
+				<-shutdown
+				return nil, fmt.Errorf("shutdown")
+			}}
+
+		go func() {
+			_, _ = broadcast.WithPersistentBacklog(broadcaster, db, cdc, latestHeight, maxAge, batchSize, log.TestingLogger()).Broadcast(ctx, msgs...)
+		}()
+		<-called
+	}
+
+	isEmpty := func(db dbm.DB) bool {
+		iter, err := db.Iterator(nil, nil)
+		assert.NoError(t, err)
+		defer iter.Close()
+
+		return !iter.Valid()
+	}
+
+	t.Run("should not broadcast msgs that are identical to pending msgs", func(t *testing.T) {
+		release := make(chan struct{})
+		called := make(chan struct{}, 1)
+		broadcaster := &mock2.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				called <- struct{}{}
+				<-release
+				return &sdk.TxResponse{}, nil
+			}}
+		b := broadcast.WithPersistentBacklog(broadcaster, dbm.NewMemDB(), cdc, latestHeight, maxAge, batchSize, log.TestingLogger())
+		msgs := randomMsgs(5)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, err := b.Broadcast(context.Background(), msgs...)
+			assert.NoError(t, err)
+		}()
+		<-called
+
+		res, err := b.Broadcast(context.Background(), msgs[2])
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+
+		close(release)
+		<-done
+
+		_, err = b.Broadcast(context.Background(), msgs[2])
+		<-called
+		assert.NoError(t, err)
+		assert.Len(t, broadcaster.BroadcastCalls(), 2)
+		assert.Len(t, broadcaster.BroadcastCalls()[1].Msgs, 1)
+	})
+
+	t.Run("should broadcast pending msgs again in batches after a restart", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		msgs := randomMsgs(int(rand.I64Between(1, 20)))
+		interrupt(t, context.Background(), db, msgs)
+
+		resumed := make(chan []sdk.Msg, len(msgs))
+		broadcaster := &mock2.BroadcasterMock{
+			BroadcastFunc: func(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				resumed <- msgs
+				return &sdk.TxResponse{}, nil
+			}}
+		broadcast.WithPersistentBacklog(broadcaster, db, cdc, latestHeight, maxAge, batchSize, log.TestingLogger())
+
+		var all []sdk.Msg
+		for len(all) < len(msgs) {
+			batch := <-resumed
+			assert.LessOrEqual(t, len(batch), batchSize)
+			all = append(all, batch...)
+		}
+
+		assert.ElementsMatch(t, msgs, all)
+		assert.Eventually(t, func() bool { return isEmpty(db) }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should drop msgs older than the max age after a restart", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		interrupt(t, context.Background(), db, randomMsgs(int(rand.I64Between(1, 20))))
+
+		broadcaster := &mock2.BroadcasterMock{}
+		expiredHeight := func(context.Context) (int64, error) { return height + maxAge + 1, nil }
+		broadcast.WithPersistentBacklog(broadcaster, db, cdc, expiredHeight, maxAge, batchSize, log.TestingLogger())
+
+		assert.True(t, isEmpty(db))
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	})
+
+	t.Run("should drop msgs after their session expired", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		expiresAt := height + rand.I64Between(1, maxAge+1)
+		interrupt(t, broadcast.WithExpiry(context.Background(), expiresAt), db, randomMsgs(int(rand.I64Between(1, 20))))
+
+		broadcaster := &mock2.BroadcasterMock{}
+		expiredHeight := func(context.Context) (int64, error) { return expiresAt, nil }
+		broadcast.WithPersistentBacklog(broadcaster, db, cdc, expiredHeight, maxAge, batchSize, log.TestingLogger())
+
+		assert.True(t, isEmpty(db))
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	})
+
+	t.Run("should keep msgs older than the max age until their session expires", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		msgs := randomMsgs(int(rand.I64Between(1, 20)))
+		expiresAt := height + maxAge + rand.I64Between(2, 100)
+		interrupt(t, broadcast.WithExpiry(context.Background(), expiresAt), db, msgs)
+
+		resumed := make(chan []sdk.Msg, len(msgs))
+		broadcaster := &mock2.BroadcasterMock{
+			BroadcastFunc: func(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				resumed <- msgs
+				return &sdk.TxResponse{}, nil
+			}}
+		laterHeight := func(context.Context) (int64, error) { return expiresAt - 1, nil }
+		broadcast.WithPersistentBacklog(broadcaster, db, cdc, laterHeight, maxAge, len(msgs), log.TestingLogger())
+
+		assert.ElementsMatch(t, msgs, <-resumed)
+	})
+}
+
+func TestWithAuthzExec(t *testing.T) {
+	broadcaster := &mock2.BroadcasterMock{
+		BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+	}
+	grantee := rand.AccAddr()
+	msgs := randomMsgs(int(rand.I64Between(1, 20)))
+
+	_, err := broadcast.WithAuthzExec(broadcaster, grantee).Broadcast(context.Background(), msgs...)
+	assert.NoError(t, err)
+
+	assert.Len(t, broadcaster.BroadcastCalls(), 1)
+	execs := broadcaster.BroadcastCalls()[0].Msgs
+	assert.Len(t, execs, len(msgs))
+	for i, msg := range execs {
+		exec, ok := msg.(*authz.MsgExec)
+		assert.True(t, ok)
+		assert.Equal(t, grantee.String(), exec.Grantee)
+
+		inner, err := exec.GetMessages()
+		assert.NoError(t, err)
+		assert.Equal(t, []sdk.Msg{msgs[i]}, inner)
+	}
+}
+
+func TestPooled(t *testing.T) {
+	t.Run("should distribute concurrent broadcasts across all broadcasters", func(t *testing.T) {
+		release := make(chan struct{})
+		poolSize := int(rand.I64Between(2, 10))
+
+		wg := &sync.WaitGroup{}
+		wg.Add(poolSize)
+		var broadcasters []broadcast.Broadcaster
+		var mocks []*mock2.BroadcasterMock
+		for i := 0; i < poolSize; i++ {
+			m := &mock2.BroadcasterMock{
+				BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+					wg.Done()
+					<-release
+					return &sdk.TxResponse{}, nil
+				},
+			}
+			mocks = append(mocks, m)
+			broadcasters = append(broadcasters, m)
+		}
+
+		pool := broadcast.Pooled(broadcasters...)
+		done := &sync.WaitGroup{}
+		done.Add(poolSize)
+		for i := 0; i < poolSize; i++ {
+			go func() {
+				defer done.Done()
+				_, err := pool.Broadcast(context.Background(), randomMsgs(1)...)
+				assert.NoError(t, err)
+			}()
+		}
+
+		// every broadcaster must be blocked with exactly one broadcast for the wait group to be released
+		wg.Wait()
+		close(release)
+		done.Wait()
+
+		for _, m := range mocks {
+			assert.Len(t, m.BroadcastCalls(), 1)
+		}
+	})
+
+	t.Run("should pass on broadcast errors", func(t *testing.T) {
+		broadcaster := &mock2.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return nil, errors.New("some error") },
+		}
+
+		_, err := broadcast.Pooled(broadcaster, broadcaster).Broadcast(context.Background(), randomMsgs(1)...)
+		assert.Error(t, err)
+	})
+}
+
+func TestWithDynamicGasPrice(t *testing.T) {
+	minGasPrice := sdk.NewDecCoinFromDec("uaxl", sdk.MustNewDecFromStr("0.007"))
+	maxGasPrice := sdk.NewDecCoinFromDec("uaxl", sdk.MustNewDecFromStr("0.1"))
+	increase := sdk.MustNewDecFromStr("1.5")
+	decay := sdk.MustNewDecFromStr("0.5")
+
+	insufficientFee := func(got, required string) error {
+		return sdkerrors.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrInsufficientFee.ABCICode(),
+			fmt.Sprintf("insufficient fees; got: %s required: %s: insufficient fee", got, required))
+	}
+
+	// newBroadcaster returns a broadcaster that returns the given errors in order and records the gas prices of all calls
+	newBroadcaster := func(errs ...error) (*mock2.BroadcasterMock, *[]sdk.DecCoin) {
+		var gasPrices []sdk.DecCoin
+		return &mock2.BroadcasterMock{
+			BroadcastFunc: func(ctx context.Context, _ ...sdk.Msg) (*sdk.TxResponse, error) {
+				gasPrice, ok := broadcast.GasPriceFromContext(ctx)
+				assert.True(t, ok)
+				gasPrices = append(gasPrices, gasPrice)
+
+				if len(errs) == 0 {
+					return &sdk.TxResponse{}, nil
+				}
+				err := errs[0]
+				errs = errs[1:]
+				return nil, err
+			},
+		}, &gasPrices
+	}
+
+	t.Run("should broadcast with the minimum gas price", func(t *testing.T) {
+		broadcaster, gasPrices := newBroadcaster()
+		b := broadcast.WithDynamicGasPrice(broadcaster, minGasPrice, maxGasPrice, increase, decay, log.TestingLogger())
+
+		_, err := b.Broadcast(context.Background(), randomMsgs(1)...)
+		assert.NoError(t, err)
+		assert.Equal(t, []sdk.DecCoin{minGasPrice}, *gasPrices)
+	})
+
+	t.Run("should raise the gas price to the required gas price and decay afterwards", func(t *testing.T) {
+		broadcaster, gasPrices := newBroadcaster(insufficientFee("700uaxl", "2800uaxl"))
+		b := broadcast.WithDynamicGasPrice(broadcaster, minGasPrice, maxGasPrice, increase, decay, log.TestingLogger())
+
+		_, err := b.Broadcast(context.Background(), randomMsgs(1)...)
+		assert.NoError(t, err)
+		_, err = b.Broadcast(context.Background(), randomMsgs(1)...)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"0.007000000000000000uaxl", "0.028000000000000000uaxl", "0.014000000000000000uaxl"},
+			slices.Map(*gasPrices, sdk.DecCoin.String))
+	})
+
+	t.Run("should raise the gas price by the increase factor if the required fees are unknown", func(t *testing.T) {
+		broadcaster, gasPrices := newBroadcaster(insufficientFee("", "2800uaxl"))
+		b := broadcast.WithDynamicGasPrice(broadcaster, minGasPrice, maxGasPrice, increase, decay, log.TestingLogger())
+
+		_, err := b.Broadcast(context.Background(), randomMsgs(1)...)
+		assert.NoError(t, err)
+		assert.Equal(t, "0.010500000000000000uaxl", (*gasPrices)[1].String())
+	})
+
+	t.Run("should not raise the gas price above the maximum", func(t *testing.T) {
+		broadcaster, gasPrices := newBroadcaster(insufficientFee("700uaxl", "1000000uaxl"), insufficientFee("10000uaxl", "1000000uaxl"))
+		b := broadcast.WithDynamicGasPrice(broadcaster, minGasPrice, maxGasPrice, increase, decay, log.TestingLogger())
+
+		_, err := b.Broadcast(context.Background(), randomMsgs(1)...)
+		assert.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+		assert.Equal(t, []sdk.DecCoin{minGasPrice, maxGasPrice}, *gasPrices)
+	})
+
+	t.Run("should not retry other errors", func(t *testing.T) {
+		broadcaster, gasPrices := newBroadcaster(sdkerrors.ErrOutOfGas)
+		b := broadcast.WithDynamicGasPrice(broadcaster, minGasPrice, maxGasPrice, increase, decay, log.TestingLogger())
+
+		_, err := b.Broadcast(context.Background(), randomMsgs(1)...)
+		assert.Error(t, err)
+		assert.Len(t, *gasPrices, 1)
+	})
+}
@@ -0,0 +1,146 @@
+package broadcast
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+type gasPriceKey struct{}
+
+// ContextWithGasPrice returns a context that overrides the gas price of the stateful broadcaster for a single broadcast
+func ContextWithGasPrice(ctx context.Context, gasPrice sdk.DecCoin) context.Context {
+	return context.WithValue(ctx, gasPriceKey{}, gasPrice)
+}
+
+// GasPriceFromContext returns the gas price set by ContextWithGasPrice
+func GasPriceFromContext(ctx context.Context) (sdk.DecCoin, bool) {
+	gasPrice, ok := ctx.Value(gasPriceKey{}).(sdk.DecCoin)
+	return gasPrice, ok
+}
+
+// insufficientFeeRegex matches the log of the ante handler that rejects txs with insufficient fees
+var insufficientFeeRegex = regexp.MustCompile(`insufficient fees; got: (\S*) required: ([^\s:]+)`)
+
+type gasPriceBroadcaster struct {
+	broadcaster Broadcaster
+	minGasPrice sdk.DecCoin
+	maxGasPrice sdk.DecCoin
+	increase    sdk.Dec
+	decay       sdk.Dec
+	logger      log.Logger
+
+	lock     sync.Mutex
+	gasPrice sdk.DecCoin
+}
+
+// WithDynamicGasPrice returns a broadcaster that raises the gas price when txs are rejected for insufficient fees
+// and lets it decay back to the minimum gas price with every successful broadcast.
+// The price is raised to the node's minimum gas price reported in the rejection, but at least by the increase factor,
+// and never above the maximum gas price. It must be wrapped around the broadcaster that sets the gas price of the tx.
+func WithDynamicGasPrice(broadcaster Broadcaster, minGasPrice, maxGasPrice sdk.DecCoin, increase, decay sdk.Dec, logger log.Logger) Broadcaster {
+	return &gasPriceBroadcaster{
+		broadcaster: broadcaster,
+		minGasPrice: minGasPrice,
+		maxGasPrice: maxGasPrice,
+		increase:    increase,
+		decay:       decay,
+		logger:      logger.With("process", "dynamic gas price"),
+		gasPrice:    minGasPrice,
+	}
+}
+
+// Broadcast implements the Broadcaster interface
+func (b *gasPriceBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	for {
+		gasPrice := b.currentGasPrice()
+
+		res, err := b.broadcaster.Broadcast(ContextWithGasPrice(ctx, gasPrice), msgs...)
+		if err == nil {
+			b.lower()
+			return res, nil
+		}
+
+		if !sdkerrors.ErrInsufficientFee.Is(err) || !b.raise(gasPrice, err) {
+			return nil, err
+		}
+	}
+}
+
+func (b *gasPriceBroadcaster) currentGasPrice() sdk.DecCoin {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.gasPrice
+}
+
+// raise increases the gas price after a tx was rejected with the given gas price,
+// and returns false if the gas price cannot be raised any further
+func (b *gasPriceBroadcaster) raise(rejected sdk.DecCoin, err error) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	// another broadcast has already raised the gas price in the meantime
+	if b.gasPrice.Amount.GT(rejected.Amount) {
+		return true
+	}
+
+	if b.gasPrice.Amount.GTE(b.maxGasPrice.Amount) {
+		b.logger.Error(fmt.Sprintf("tx rejected for insufficient fees at the maximum gas price of %s", b.maxGasPrice))
+		return false
+	}
+
+	amount := sdk.MaxDec(rejected.Amount.Mul(b.increase), requiredGasPrice(rejected, err))
+	b.gasPrice = sdk.NewDecCoinFromDec(rejected.Denom, sdk.MinDec(amount, b.maxGasPrice.Amount))
+
+	b.logger.Info(fmt.Sprintf("tx rejected for insufficient fees, raising gas price to %s", b.gasPrice))
+	b.recordGasPrice()
+
+	return true
+}
+
+// lower lets the gas price decay towards the minimum gas price
+func (b *gasPriceBroadcaster) lower() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.gasPrice.Amount.LTE(b.minGasPrice.Amount) {
+		return
+	}
+
+	b.gasPrice = sdk.NewDecCoinFromDec(b.gasPrice.Denom, sdk.MaxDec(b.gasPrice.Amount.Mul(b.decay), b.minGasPrice.Amount))
+	b.recordGasPrice()
+}
+
+func (b *gasPriceBroadcaster) recordGasPrice() {
+	gasPrice, err := b.gasPrice.Amount.Float64()
+	if err != nil {
+		return
+	}
+
+	telemetry.SetGauge(float32(gasPrice), "broadcast", "gas_price")
+}
+
+// requiredGasPrice derives the node's minimum gas price from the fees that were paid and the fees that were required
+func requiredGasPrice(rejected sdk.DecCoin, err error) sdk.Dec {
+	match := insufficientFeeRegex.FindStringSubmatch(err.Error())
+	if match == nil {
+		return sdk.ZeroDec()
+	}
+
+	got, gotErr := sdk.ParseCoinsNormalized(match[1])
+	required, requiredErr := sdk.ParseCoinsNormalized(match[2])
+	if gotErr != nil || requiredErr != nil || !got.AmountOf(rejected.Denom).IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return rejected.Amount.
+		MulInt(required.AmountOf(rejected.Denom)).
+		QuoInt(got.AmountOf(rejected.Denom))
+}
@@ -0,0 +1,246 @@
+package broadcast
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// backlogEntry is a pending msg that is stored in the persistent backlog
+type backlogEntry struct {
+	Height    int64  `json:"height"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+	Msg       []byte `json:"msg"`
+}
+
+type expiryKey struct{}
+
+// WithExpiry returns a context that tells the persistent backlog at which height the poll or signing session of the broadcast msgs expires
+func WithExpiry(ctx context.Context, expiresAt int64) context.Context {
+	return context.WithValue(ctx, expiryKey{}, expiresAt)
+}
+
+func getExpiry(ctx context.Context) int64 {
+	expiresAt, _ := ctx.Value(expiryKey{}).(int64)
+	return expiresAt
+}
+
+// isExpired checks if the entry cannot be handled anymore at the given height.
+// Entries without an expiry height expire after maxAge blocks.
+func (e backlogEntry) isExpired(height int64, maxAge int64) bool {
+	if e.ExpiresAt > 0 {
+		return height >= e.ExpiresAt
+	}
+
+	return height-e.Height > maxAge
+}
+
+type persistentBroadcaster struct {
+	broadcaster  Broadcaster
+	db           dbm.DB
+	cdc          codec.Codec
+	latestHeight func(ctx context.Context) (int64, error)
+	maxAge       int64
+	batchSize    int
+	logger       log.Logger
+
+	lock sync.Mutex
+}
+
+// WithPersistentBacklog returns a broadcaster that stores msgs in the given db until they have been broadcast,
+// so msgs that are still pending when the process stops are broadcast again after a restart.
+// Msgs that are identical to a pending msg are not broadcast a second time. Msgs whose poll or signing session has expired
+// are dropped instead of being broadcast again. The expiry height is set with WithExpiry, msgs without it expire after maxAge blocks.
+// Pending msgs are resumed in batches of at most batchSize msgs.
+func WithPersistentBacklog(broadcaster Broadcaster, db dbm.DB, cdc codec.Codec, latestHeight func(ctx context.Context) (int64, error), maxAge int64, batchSize int, logger log.Logger) Broadcaster {
+	b := &persistentBroadcaster{
+		broadcaster:  broadcaster,
+		db:           db,
+		cdc:          cdc,
+		latestHeight: latestHeight,
+		maxAge:       maxAge,
+		batchSize:    batchSize,
+		logger:       logger.With("process", "persistent backlog"),
+	}
+
+	b.resume()
+	return b
+}
+
+// Broadcast implements the Broadcaster interface
+func (b *persistentBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	height, err := b.latestHeight(ctx)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get the latest block height")
+	}
+
+	expiresAt := getExpiry(ctx)
+
+	var pending []sdk.Msg
+	var keys [][]byte
+	for _, msg := range msgs {
+		key, ok, err := b.add(msg, height, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			b.logger.Debug(fmt.Sprintf("skipping duplicate msg %s", sdk.MsgTypeURL(msg)))
+			continue
+		}
+
+		pending = append(pending, msg)
+		keys = append(keys, key)
+	}
+
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	return b.broadcast(ctx, keys, pending)
+}
+
+// broadcast forwards the given msgs and removes them from the backlog once they have been handled.
+// Msgs stay in the backlog if the process stops before the broadcast returns, so they are broadcast again after the restart
+func (b *persistentBroadcaster) broadcast(ctx context.Context, keys [][]byte, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := b.broadcaster.Broadcast(ctx, msgs...)
+	b.remove(keys...)
+
+	return res, err
+}
+
+// add stores the given msg in the backlog, unless an identical msg is already pending
+func (b *persistentBroadcaster) add(msg sdk.Msg, height int64, expiresAt int64) ([]byte, bool, error) {
+	bz, err := b.cdc.MarshalInterface(msg)
+	if err != nil {
+		return nil, false, sdkerrors.Wrap(err, "failed to marshal msg")
+	}
+	hash := sha256.Sum256(bz)
+	key := hash[:]
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	ok, err := b.db.Has(key)
+	if err != nil {
+		return nil, false, sdkerrors.Wrap(err, "failed to read from the persistent backlog")
+	}
+
+	if ok {
+		return nil, false, nil
+	}
+
+	entry, err := json.Marshal(backlogEntry{Height: height, ExpiresAt: expiresAt, Msg: bz})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := b.db.SetSync(key, entry); err != nil {
+		return nil, false, sdkerrors.Wrap(err, "failed to write to the persistent backlog")
+	}
+
+	return key, true, nil
+}
+
+func (b *persistentBroadcaster) remove(keys ...[]byte) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for _, key := range keys {
+		if err := b.db.DeleteSync(key); err != nil {
+			b.logger.Error(sdkerrors.Wrap(err, "failed to remove msg from the persistent backlog").Error())
+		}
+	}
+}
+
+// resume broadcasts all msgs that were still pending when the process stopped, unless they have expired.
+// Pending msgs are loaded before any new msgs are accepted, so they cannot be mixed up with each other.
+func (b *persistentBroadcaster) resume() {
+	ctx := context.Background()
+
+	height, err := b.latestHeight(ctx)
+	if err != nil {
+		b.logger.Error(sdkerrors.Wrap(err, "failed to get the latest block height, cannot resume the persistent backlog").Error())
+		return
+	}
+
+	keys, msgs, expired, err := b.loadPending(height)
+	if err != nil {
+		b.logger.Error(err.Error())
+		return
+	}
+
+	b.remove(expired...)
+	telemetry.IncrCounter(float32(len(expired)), "broadcast", "backlog", "expired")
+	if len(expired) > 0 {
+		b.logger.Info(fmt.Sprintf("dropped %d expired msgs from the persistent backlog", len(expired)))
+	}
+
+	if len(msgs) == 0 {
+		return
+	}
+
+	b.logger.Info(fmt.Sprintf("resuming broadcast of %d pending msgs from the persistent backlog", len(msgs)))
+	go func() {
+		for len(msgs) > 0 {
+			size := len(msgs)
+			if b.batchSize > 0 && size > b.batchSize {
+				size = b.batchSize
+			}
+
+			if _, err := b.broadcast(ctx, keys[:size], msgs[:size]); err != nil {
+				b.logger.Error(sdkerrors.Wrap(err, "failed to broadcast pending msgs from the persistent backlog").Error())
+			}
+
+			keys, msgs = keys[size:], msgs[size:]
+		}
+	}()
+}
+
+func (b *persistentBroadcaster) loadPending(height int64) (keys [][]byte, msgs []sdk.Msg, expired [][]byte, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	iter, err := b.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, nil, nil, sdkerrors.Wrap(err, "failed to read the persistent backlog")
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+
+		var entry backlogEntry
+		if err := json.Unmarshal(iter.Value(), &entry); err != nil {
+			b.logger.Error(sdkerrors.Wrap(err, "dropping persistent backlog entry in unexpected format").Error())
+			expired = append(expired, key)
+			continue
+		}
+
+		if entry.isExpired(height, b.maxAge) {
+			expired = append(expired, key)
+			continue
+		}
+
+		var msg sdk.Msg
+		if err := b.cdc.UnmarshalInterface(entry.Msg, &msg); err != nil {
+			b.logger.Error(sdkerrors.Wrap(err, "dropping persistent backlog entry with unknown msg").Error())
+			expired = append(expired, key)
+			continue
+		}
+
+		keys = append(keys, key)
+		msgs = append(msgs, msg)
+	}
+
+	return keys, msgs, expired, iter.Error()
+}
@@ -0,0 +1,84 @@
+package broadcast
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type authzExecBroadcaster struct {
+	broadcaster Broadcaster
+	grantee     sdk.AccAddress
+}
+
+// WithAuthzExec returns a broadcaster that executes msgs on behalf of their signers through the grantee's authorization.
+// Each msg is wrapped into its own MsgExec, so errors that refer to a msg index still point to the original msg.
+func WithAuthzExec(broadcaster Broadcaster, grantee sdk.AccAddress) Broadcaster {
+	return authzExecBroadcaster{
+		broadcaster: broadcaster,
+		grantee:     grantee,
+	}
+}
+
+// Broadcast implements the Broadcaster interface
+func (b authzExecBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	execs := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		exec := authz.NewMsgExec(b.grantee, []sdk.Msg{msg})
+		execs = append(execs, &exec)
+	}
+
+	return b.broadcaster.Broadcast(ctx, execs...)
+}
+
+type pooledBroadcaster struct {
+	broadcasters []Broadcaster
+
+	lock     sync.Mutex
+	inFlight []int
+}
+
+// Pooled returns a broadcaster that distributes msgs across the given broadcasters, so they can broadcast in parallel.
+// Each call is assigned to the broadcaster with the fewest broadcasts in flight.
+func Pooled(broadcasters ...Broadcaster) Broadcaster {
+	if len(broadcasters) == 1 {
+		return broadcasters[0]
+	}
+
+	return &pooledBroadcaster{
+		broadcasters: broadcasters,
+		inFlight:     make([]int, len(broadcasters)),
+	}
+}
+
+// Broadcast implements the Broadcaster interface
+func (b *pooledBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	i := b.acquire()
+	defer b.release(i)
+
+	return b.broadcasters[i].Broadcast(ctx, msgs...)
+}
+
+func (b *pooledBroadcaster) acquire() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	next := 0
+	for i, count := range b.inFlight {
+		if count < b.inFlight[next] {
+			next = i
+		}
+	}
+
+	b.inFlight[next]++
+	return next
+}
+
+func (b *pooledBroadcaster) release(i int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.inFlight[i]--
+}
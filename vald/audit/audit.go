@@ -0,0 +1,210 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// Record types of the audit log
+const (
+	TypeVote      = "vote"
+	TypeSignature = "signature"
+)
+
+// Vote is the audit record of a vote that vald cast in a poll
+type Vote struct {
+	PollID       vote.PollID     `json:"poll_id"`
+	Chain        nexus.ChainName `json:"chain"`
+	TxID         string          `json:"tx_id"`
+	Events       []evm.Event     `json:"-"`
+	BlockHash    string          `json:"block_hash,omitempty"` // empty if the vote is empty because the tx was not found or not final
+	RPCEndpoints []string        `json:"rpc_endpoints"`        // redacted URLs of the endpoints that served the receipt
+}
+
+// MarshalJSON implements json.Marshaler, so the events are encoded in their proto JSON representation
+func (v Vote) MarshalJSON() ([]byte, error) {
+	events, err := codec.ProtoMarshalJSON(evm.NewVoteEvents(v.Chain, v.Events...), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	type alias Vote
+	return json.Marshal(struct {
+		alias
+		Events json.RawMessage `json:"events"`
+	}{alias(v), json.RawMessage(events)})
+}
+
+// Signature is the audit record of a signature that vald produced in a signing session
+type Signature struct {
+	SigID       uint64         `json:"sig_id"`
+	KeyID       multisig.KeyID `json:"key_id"`
+	PayloadHash string         `json:"payload_hash"` // hex encoded
+}
+
+// Log records the votes and signatures of vald, so they can be reconstructed when they are marked as incorrect
+type Log interface {
+	RecordVote(vote Vote) error
+	RecordSignature(signature Signature) error
+}
+
+// NoOp is a Log that discards all records
+type NoOp struct{}
+
+// RecordVote implements the Log interface
+func (NoOp) RecordVote(Vote) error { return nil }
+
+// RecordSignature implements the Log interface
+func (NoOp) RecordSignature(Signature) error { return nil }
+
+type record struct {
+	Time      time.Time  `json:"time"`
+	Type      string     `json:"type"`
+	Vote      *Vote      `json:"vote,omitempty"`
+	Signature *Signature `json:"signature,omitempty"`
+}
+
+var _ Log = &FileLog{}
+
+// FileLog appends records to a JSON-lines file. Once the file exceeds the maximum size,
+// it is renamed with a timestamp suffix and a new file is started. Only the most recent backups are kept.
+type FileLog struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	lock sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileLog returns a new FileLog instance that writes to the given path
+func NewFileLog(path string, maxSize int64, maxBackups int) (*FileLog, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("max size of the audit log must be positive")
+	}
+
+	l := &FileLog{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// RecordVote implements the Log interface
+func (l *FileLog) RecordVote(vote Vote) error {
+	return l.write(record{Time: time.Now().UTC(), Type: TypeVote, Vote: &vote})
+}
+
+// RecordSignature implements the Log interface
+func (l *FileLog) RecordSignature(signature Signature) error {
+	return l.write(record{Time: time.Now().UTC(), Type: TypeSignature, Signature: &signature})
+}
+
+// Close closes the current audit log file
+func (l *FileLog) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.file.Close()
+}
+
+func (l *FileLog) write(r record) error {
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to encode audit record")
+	}
+	bz = append(bz, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.size > 0 && l.size+int64(len(bz)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(bz)
+	l.size += int64(n)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to write audit record")
+	}
+
+	return nil
+}
+
+func (l *FileLog) open() error {
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to open audit log")
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return sdkerrors.Wrap(err, "failed to open audit log")
+	}
+
+	l.file = file
+	l.size = info.Size()
+
+	return nil
+}
+
+func (l *FileLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return sdkerrors.Wrap(err, "failed to close audit log")
+	}
+
+	backup := fmt.Sprintf("%s.%s", l.path, time.Now().UTC().Format("20060102T150405.000000000"))
+	if err := os.Rename(l.path, backup); err != nil {
+		return sdkerrors.Wrap(err, "failed to rotate audit log")
+	}
+
+	if err := l.open(); err != nil {
+		return err
+	}
+
+	return l.removeOldBackups()
+}
+
+func (l *FileLog) removeOldBackups() error {
+	backups, err := filepath.Glob(l.path + ".*")
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to find audit log backups")
+	}
+
+	if len(backups) <= l.maxBackups {
+		return nil
+	}
+
+	// the timestamp suffixes sort chronologically
+	sort.Strings(backups)
+	for _, backup := range backups[:len(backups)-l.maxBackups] {
+		if err := os.Remove(backup); err != nil {
+			return sdkerrors.Wrap(err, "failed to remove audit log backup")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package audit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func TestFileLog(t *testing.T) {
+	readRecords := func(t *testing.T, path string) []map[string]interface{} {
+		f, err := os.Open(path)
+		assert.NoError(t, err)
+		defer f.Close()
+
+		var records []map[string]interface{}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var record map[string]interface{}
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			records = append(records, record)
+		}
+
+		return records
+	}
+
+	t.Run("should append votes and signatures as JSON lines", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.jsonl")
+		log, err := audit.NewFileLog(path, 1<<20, 1)
+		assert.NoError(t, err)
+
+		txID := evm.Hash(common.BytesToHash(rand.Bytes(32)))
+		assert.NoError(t, log.RecordVote(audit.Vote{
+			PollID: vote.PollID(rand.PosI64()),
+			Chain:  nexus.ChainName("Ethereum"),
+			TxID:   txID.Hex(),
+			Events: []evm.Event{{
+				Chain: "Ethereum",
+				TxID:  txID,
+				Event: &evm.Event_ContractCall{ContractCall: &evm.EventContractCall{DestinationChain: "Avalanche"}},
+			}},
+			BlockHash:    evm.Hash(common.BytesToHash(rand.Bytes(32))).Hex(),
+			RPCEndpoints: []string{"http://localhost:8545"},
+		}))
+		assert.NoError(t, log.RecordSignature(audit.Signature{
+			SigID:       uint64(rand.PosI64()),
+			KeyID:       multisig.KeyID(rand.HexStr(10)),
+			PayloadHash: rand.HexStr(64),
+		}))
+		assert.NoError(t, log.Close())
+
+		records := readRecords(t, path)
+		assert.Len(t, records, 2)
+		assert.Equal(t, audit.TypeVote, records[0]["type"])
+		assert.Equal(t, txID.Hex(), records[0]["vote"].(map[string]interface{})["tx_id"])
+		assert.Len(t, records[0]["vote"].(map[string]interface{})["events"].(map[string]interface{})["events"], 1)
+		assert.Equal(t, audit.TypeSignature, records[1]["type"])
+	})
+
+	t.Run("should rotate the log and keep only the most recent backups", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "audit.jsonl")
+		log, err := audit.NewFileLog(path, 10, 2)
+		assert.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, log.RecordSignature(audit.Signature{SigID: uint64(i)}))
+		}
+		assert.NoError(t, log.Close())
+
+		records := readRecords(t, path)
+		assert.Len(t, records, 1)
+		assert.EqualValues(t, 4, records[0]["signature"].(map[string]interface{})["sig_id"])
+
+		backups, err := filepath.Glob(path + ".*")
+		assert.NoError(t, err)
+		assert.Len(t, backups, 2)
+	})
+}
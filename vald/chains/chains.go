@@ -0,0 +1,152 @@
+package chains
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+)
+
+// ChainMgr integrates the chains of a chain module with vald.
+// It declares the events it processes, and vald runs it from the start until the shutdown of the event processing.
+type ChainMgr interface {
+	// Module returns the name of the chain module the manager belongs to
+	Module() string
+	// Subscriptions returns the events the manager processes
+	Subscriptions() []Subscription
+	// Start runs the background processes of the manager until the given context is done
+	Start(ctx context.Context) error
+	// Stop releases all resources of the manager
+	Stop()
+}
+
+// Subscription declares the events a chain manager processes and how to process them
+type Subscription struct {
+	// EventType identifies the events in metrics
+	EventType string
+	Filter    func(event tmEvents.ABCIEventWithHeight) bool
+	Process   func(event tmEvents.ABCIEventWithHeight) error
+	// JournalKey returns the key under which the outcome of processing the event is recorded,
+	// so the event is skipped after a restart if it has been processed successfully. Events are not journaled if it is nil.
+	JournalKey func(event tmEvents.ABCIEventWithHeight) (string, error)
+}
+
+// Subscribe returns a subscription to all typed events of type T
+func Subscribe[T proto.Message](process func(event T) error) Subscription {
+	return Subscription{
+		EventType: proto.MessageName(*new(T)),
+		Filter:    tmEvents.Filter[T](),
+		Process: func(event tmEvents.ABCIEventWithHeight) error {
+			e, err := parse[T](event)
+			if err != nil {
+				return err
+			}
+
+			return process(e)
+		},
+	}
+}
+
+// SubscribeWithJournal returns a subscription to all typed events of type T that records the outcome of processing under the given key
+func SubscribeWithJournal[T proto.Message](key func(event T) string, process func(event T) error) Subscription {
+	subscription := Subscribe(process)
+	subscription.JournalKey = func(event tmEvents.ABCIEventWithHeight) (string, error) {
+		e, err := parse[T](event)
+		if err != nil {
+			return "", err
+		}
+
+		return key(e), nil
+	}
+
+	return subscription
+}
+
+func parse[T proto.Message](event tmEvents.ABCIEventWithHeight) (T, error) {
+	msg, err := sdk.ParseTypedEvent(event.Event)
+	if err != nil {
+		return *new(T), err
+	}
+
+	e, ok := msg.(T)
+	if !ok {
+		return *new(T), fmt.Errorf("expected event of type %T, got %T", *new(T), msg)
+	}
+
+	return e, nil
+}
+
+// PollKey returns the journal key of the given poll
+func PollKey(pollID vote.PollID) string {
+	return fmt.Sprintf("poll_%s", pollID.String())
+}
+
+// Deps are the dependencies vald provides to create chain managers
+type Deps struct {
+	ClientCtx sdkClient.Context
+	Config    config.ValdConfig
+	// Broadcaster broadcasts refundable msgs
+	Broadcaster broadcast.Broadcaster
+	// ProxyBroadcaster broadcasts msgs that must be signed by the proxy account itself
+	ProxyBroadcaster broadcast.Broadcaster
+	Validator        sdk.ValAddress
+	Cdc              *codec.LegacyAmino
+	AuditLog         audit.Log
+	// StateDir is the folder in which chain managers persist their state across restarts.
+	// Shadow instances use a separate folder, so they do not interfere with a regular instance that shares the same home folder
+	StateDir string
+	Logger   log.Logger
+}
+
+// Factory creates the chain manager of a chain module
+type Factory func(deps Deps) (ChainMgr, error)
+
+var (
+	lock      sync.Mutex
+	modules   []string
+	factories = make(map[string]Factory)
+)
+
+// Register makes the chain manager of the given module available to vald.
+// Chain integrations call it from an init function, so it panics if the module is registered twice.
+func Register(module string, factory Factory) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, ok := factories[module]; ok {
+		panic(fmt.Sprintf("chain manager for module %s is already registered", module))
+	}
+
+	modules = append(modules, module)
+	factories[module] = factory
+}
+
+// CreateAll creates the chain managers of all registered modules in order of registration
+func CreateAll(deps Deps) ([]ChainMgr, error) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	var mgrs []ChainMgr
+	for _, module := range modules {
+		mgr, err := factories[module](deps)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to create chain manager for module %s", module)
+		}
+
+		mgrs = append(mgrs, mgr)
+	}
+
+	return mgrs, nil
+}
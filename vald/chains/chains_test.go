@@ -0,0 +1,91 @@
+package chains_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/chains"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+type chainMgr struct{ module string }
+
+func (m chainMgr) Module() string                       { return m.module }
+func (m chainMgr) Subscriptions() []chains.Subscription { return nil }
+func (m chainMgr) Start(ctx context.Context) error      { <-ctx.Done(); return nil }
+func (m chainMgr) Stop()                                {}
+
+func toEvent(t *testing.T, event proto.Message) tmEvents.ABCIEventWithHeight {
+	e, err := sdk.TypedEventToEvent(event)
+	assert.NoError(t, err)
+
+	return tmEvents.ABCIEventWithHeight{Height: rand.PosI64(), Event: abci.Event(e)}
+}
+
+func TestSubscribe(t *testing.T) {
+	pollID := vote.PollID(rand.PosI64())
+	depositEvent := toEvent(t, &evmTypes.ConfirmDepositStarted{PollParticipants: vote.PollParticipants{PollID: pollID}})
+	tokenEvent := toEvent(t, &evmTypes.ConfirmTokenStarted{})
+
+	t.Run("should only process events of the subscribed type", func(t *testing.T) {
+		var processed []vote.PollID
+		subscription := chains.Subscribe(func(e *evmTypes.ConfirmDepositStarted) error {
+			processed = append(processed, e.PollID)
+			return nil
+		})
+
+		assert.Equal(t, "axelar.evm.v1beta1.ConfirmDepositStarted", subscription.EventType)
+		assert.True(t, subscription.Filter(depositEvent))
+		assert.False(t, subscription.Filter(tokenEvent))
+		assert.Nil(t, subscription.JournalKey)
+
+		assert.NoError(t, subscription.Process(depositEvent))
+		assert.Equal(t, []vote.PollID{pollID}, processed)
+		assert.Error(t, subscription.Process(tokenEvent))
+	})
+
+	t.Run("should return the journal key of the event", func(t *testing.T) {
+		subscription := chains.SubscribeWithJournal(
+			func(e *evmTypes.ConfirmDepositStarted) string { return chains.PollKey(e.PollID) },
+			func(*evmTypes.ConfirmDepositStarted) error { return fmt.Errorf("error") },
+		)
+
+		assert.Equal(t, chains.PollKey(pollID), funcs.Must(subscription.JournalKey(depositEvent)))
+		assert.Error(t, subscription.Process(depositEvent))
+
+		_, err := subscription.JournalKey(tokenEvent)
+		assert.Error(t, err)
+	})
+}
+
+func TestRegister(t *testing.T) {
+	first := rand.Str(10)
+	second := rand.Str(10)
+
+	chains.Register(first, func(chains.Deps) (chains.ChainMgr, error) { return chainMgr{module: first}, nil })
+	chains.Register(second, func(chains.Deps) (chains.ChainMgr, error) { return chainMgr{module: second}, nil })
+
+	assert.Panics(t, func() {
+		chains.Register(first, func(chains.Deps) (chains.ChainMgr, error) { return chainMgr{module: first}, nil })
+	})
+
+	mgrs, err := chains.CreateAll(chains.Deps{})
+	assert.NoError(t, err)
+	assert.Equal(t, []chains.ChainMgr{chainMgr{module: first}, chainMgr{module: second}}, mgrs)
+
+	failing := rand.Str(10)
+	chains.Register(failing, func(chains.Deps) (chains.ChainMgr, error) { return nil, fmt.Errorf("error") })
+
+	_, err = chains.CreateAll(chains.Deps{})
+	assert.Error(t, err)
+}
@@ -18,19 +18,29 @@ type ValdConfig struct {
 	MaxBlocksBehindLatest int64         `mapstructure:"max_blocks_behind_latest"` // The max amount of blocks behind the latest until which the cached height is considered valid.
 	MaxLatestBlockAge     time.Duration `mapstructure:"max_latest_block_age"`     // If a block is older than this, vald does not consider it to be the latest block. This is supposed to be sufficiently larger than the block production time.
 
-	EVMConfig []evm.EVMConfig `mapstructure:"axelar_bridge_evm"`
+	EVMConfig               []evm.EVMConfig `mapstructure:"axelar_bridge_evm"`
+	EVMConfigReloadInterval time.Duration   `mapstructure:"evm_config_reload_interval"` // How often vald checks the config file for newly added EVM chains, in addition to checking it when a chain is added to the network. Polling is disabled if set to 0.
+
+	MetricsConfig          `mapstructure:"metrics"`
+	DryRunConfig           `mapstructure:"dry_run"`
+	AuditConfig            `mapstructure:"audit"`
+	GatewayDiscoveryConfig `mapstructure:"gateway_discovery"`
 }
 
 // DefaultValdConfig returns a configurations populated with default values
 func DefaultValdConfig() ValdConfig {
 	return ValdConfig{
-		TssConfig:             tss.DefaultConfig(),
-		BroadcastConfig:       DefaultBroadcastConfig(),
-		BatchSizeLimit:        250,
-		BatchThreshold:        3,
-		MaxBlocksBehindLatest: 10, // Max voting/sign/heartbeats periods are under 10 blocks
-		MaxLatestBlockAge:     15 * time.Second,
-		EVMConfig:             evm.DefaultConfig(),
+		TssConfig:               tss.DefaultConfig(),
+		BroadcastConfig:         DefaultBroadcastConfig(),
+		BatchSizeLimit:          250,
+		BatchThreshold:          3,
+		MaxBlocksBehindLatest:   10, // Max voting/sign/heartbeats periods are under 10 blocks
+		MaxLatestBlockAge:       15 * time.Second,
+		EVMConfig:               evm.DefaultConfig(),
+		EVMConfigReloadInterval: 30 * time.Second,
+		MetricsConfig:           DefaultMetricsConfig(),
+		AuditConfig:             DefaultAuditConfig(),
+		GatewayDiscoveryConfig:  DefaultGatewayDiscoveryConfig(),
 	}
 }
 
@@ -40,6 +50,12 @@ type BroadcastConfig struct {
 	MinSleepBeforeRetry time.Duration  `mapstructure:"min_sleep_before_retry"`
 	MaxTimeout          time.Duration  `mapstructure:"max_timeout"`
 	FeeGranter          sdk.AccAddress `mapstructure:"fee_granter"`
+	PersistentBacklog   bool           `mapstructure:"persistent_backlog"` // Stores pending msgs on disk, so they are still broadcast after a restart
+	BacklogMaxAge       int64          `mapstructure:"backlog_max_age"`    // Pending msgs without a known poll or signing session expiry are dropped after a restart if they are older than this many blocks
+	Accounts            []string       `mapstructure:"accounts"`           // Keyring names of additional accounts that broadcast in parallel to the proxy. Each account needs an authz grant for RefundMsgRequest from the proxy.
+	MaxGasPrice         string         `mapstructure:"max_gas_price"`      // Upper limit for raising the gas price when txs are rejected for insufficient fees, e.g. 0.1uaxl. The gas price is static if empty.
+	GasPriceIncrease    float64        `mapstructure:"gas_price_increase"` // Factor by which the gas price is raised at least when a tx is rejected for insufficient fees
+	GasPriceDecay       float64        `mapstructure:"gas_price_decay"`    // Factor by which a raised gas price decays with every successful broadcast
 }
 
 // DefaultBroadcastConfig returns a configurations populated with default values
@@ -48,5 +64,66 @@ func DefaultBroadcastConfig() BroadcastConfig {
 		MaxRetries:          3,
 		MinSleepBeforeRetry: 5 * time.Second,
 		MaxTimeout:          15 * time.Second,
+		PersistentBacklog:   false,
+		BacklogMaxAge:       10, // Only applies to msgs without a known expiry, e.g. heartbeats
+		GasPriceIncrease:    1.5,
+		GasPriceDecay:       0.9,
+	}
+}
+
+// MetricsConfig is the configuration for the prometheus metrics endpoint
+type MetricsConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	ListenAddr string `mapstructure:"listen_addr"`
+}
+
+// DefaultMetricsConfig returns a configurations populated with default values
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		Enabled:    false,
+		ListenAddr: "localhost:26661",
+	}
+}
+
+// DryRunConfig is the configuration for running vald in shadow mode, i.e. without broadcasting any transactions
+type DryRunConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Output  string `mapstructure:"output"` // The JSON-lines file that msgs are written to instead of broadcasting them. Defaults to dry-run.jsonl in the vald home folder.
+}
+
+// AuditConfig is the configuration for the log of all votes and signatures produced by vald
+type AuditConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Output     string `mapstructure:"output"`      // The JSON-lines file that votes and signatures are appended to. Defaults to audit.jsonl in the vald home folder.
+	MaxSize    int64  `mapstructure:"max_size"`    // The size in bytes at which the audit log is rotated
+	MaxBackups int    `mapstructure:"max_backups"` // The number of rotated audit logs that are kept
+}
+
+// DefaultAuditConfig returns a configurations populated with default values
+func DefaultAuditConfig() AuditConfig {
+	return AuditConfig{
+		Enabled:    false,
+		MaxSize:    100 << 20, // 100MB
+		MaxBackups: 10,
+	}
+}
+
+// GatewayDiscoveryConfig is the configuration for the automatic confirmation of gateway txs on EVM chains with gateway_discovery enabled
+type GatewayDiscoveryConfig struct {
+	Interval       time.Duration `mapstructure:"interval"`        // How often vald checks the gateways for new finalized txs
+	Lookback       uint64        `mapstructure:"lookback"`        // The number of finalized blocks before the latest finalized block that are scanned if no scanned block has been stored yet
+	MaxBlockRange  uint64        `mapstructure:"max_block_range"` // The max amount of blocks that are queried for gateway logs at once
+	GracePeriod    uint64        `mapstructure:"grace_period"`    // The number of blocks after which chain maintainers also request the confirmation of txs assigned to another maintainer if their events are still unknown
+	ConfirmRetries int           `mapstructure:"confirm_retries"` // How often a failed confirmation request of a tx is retried in the following scans
+}
+
+// DefaultGatewayDiscoveryConfig returns a configurations populated with default values
+func DefaultGatewayDiscoveryConfig() GatewayDiscoveryConfig {
+	return GatewayDiscoveryConfig{
+		Interval:       30 * time.Second,
+		Lookback:       0,
+		MaxBlockRange:  1000,
+		GracePeriod:    100,
+		ConfirmRetries: 3,
 	}
 }
@@ -0,0 +1,60 @@
+package vald
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+)
+
+// AuditEntry is a single line of the dry-run audit file
+type AuditEntry struct {
+	Time time.Time         `json:"time"`
+	Msgs []json.RawMessage `json:"msgs"`
+}
+
+type dryRunBroadcaster struct {
+	cdc codec.JSONCodec
+
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewDryRunBroadcaster returns a broadcaster that writes all msgs as JSON lines to the given writer instead of broadcasting them
+func NewDryRunBroadcaster(w io.Writer, cdc codec.JSONCodec) broadcast.Broadcaster {
+	return &dryRunBroadcaster{cdc: cdc, w: w}
+}
+
+// Broadcast implements the Broadcaster interface
+func (b *dryRunBroadcaster) Broadcast(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	entry := AuditEntry{Time: time.Now().UTC()}
+	for _, msg := range msgs {
+		bz, err := b.cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to encode msg %s", sdk.MsgTypeURL(msg))
+		}
+
+		entry.Msgs = append(entry.Msgs, bz)
+	}
+
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, err := b.w.Write(append(bz, '\n')); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to write to audit file")
+	}
+
+	return &sdk.TxResponse{}, nil
+}
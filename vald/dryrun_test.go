@@ -0,0 +1,50 @@
+package vald_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+func TestDryRunBroadcaster(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	buf := &bytes.Buffer{}
+	broadcaster := vald.NewDryRunBroadcaster(buf, encCfg.Codec)
+
+	sender := rand.AccAddr()
+	chain := nexus.ChainName(rand.Str(5))
+	var polls []vote.PollID
+	for i := 0; i < 3; i++ {
+		poll := vote.PollID(rand.PosI64())
+		polls = append(polls, poll)
+
+		_, err := broadcaster.Broadcast(context.Background(), voteTypes.NewVoteRequest(sender, poll, evmTypes.NewVoteEvents(chain)))
+		assert.NoError(t, err)
+	}
+
+	scanner := bufio.NewScanner(buf)
+	lines := 0
+	for ; scanner.Scan(); lines++ {
+		var entry vald.AuditEntry
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		assert.Len(t, entry.Msgs, 1)
+
+		var msg sdk.Msg
+		assert.NoError(t, encCfg.Codec.UnmarshalInterfaceJSON(entry.Msgs[0], &msg))
+		assert.Equal(t, polls[lines], msg.(*voteTypes.VoteRequest).PollID)
+	}
+	assert.Equal(t, len(polls), lines)
+}
@@ -0,0 +1,389 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/viper"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/chains"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	nexusTypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/jobs"
+)
+
+// Chain packages register their manager with vald when they are imported,
+// so vald starts (and replays) every chain without knowing about them
+func init() {
+	chains.Register(types.ModuleName, createChainMgr)
+}
+
+// createChainMgr creates the evm manager with its background processes
+func createChainMgr(deps chains.Deps) (chains.ChainMgr, error) {
+	evmMgr := createMgr(deps.Config, deps.ClientCtx, deps.Broadcaster, deps.Logger, deps.Cdc, deps.Validator)
+	evmMgr.SetAuditLog(deps.AuditLog)
+
+	// connect to chains as soon as they are added to the network if the operator already configured them
+	configPath := filepath.Join(deps.ClientCtx.HomeDir, "config", "config.toml")
+	evmMgr.SetChainLoader(func(nexus.ChainName) { loadNewChains(configPath, evmMgr, deps.Logger) })
+
+	if deps.Config.EVMConfigReloadInterval > 0 {
+		evmMgr.AddJobs(watchConfig(configPath, deps.Config.EVMConfigReloadInterval, evmMgr, deps.Logger))
+	}
+
+	for _, evmChainConf := range deps.Config.EVMConfig {
+		if evmChainConf.WithBridge && evmChainConf.GatewayDiscovery {
+			watcher, err := createGatewayWatcher(nexus.ChainName(evmChainConf.Name), evmMgr, deps)
+			if err != nil {
+				return nil, err
+			}
+
+			evmMgr.AddJobs(watcher)
+		}
+	}
+
+	return evmMgr, nil
+}
+
+func createMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broadcast.Broadcaster, logger tmLog.Logger, cdc *codec.LegacyAmino, valAddr sdk.ValAddress) *Mgr {
+	rpcs := make(map[string]rpc.Client)
+
+	for _, evmChainConf := range axelarCfg.EVMConfig {
+		if !evmChainConf.WithBridge {
+			logger.Debug(fmt.Sprintf("RPC connection is disabled for EVM chain %s. Skipping...", evmChainConf.Name))
+			continue
+		}
+
+		if _, found := rpcs[strings.ToLower(evmChainConf.Name)]; found {
+			msg := fmt.Errorf("duplicate bridge configuration found for EVM chain %s", evmChainConf.Name)
+			logger.Error(msg.Error())
+			panic(msg)
+		}
+
+		client, err := createRPC(evmChainConf, logger)
+		if err != nil {
+			logger.Error(err.Error())
+			panic(err)
+		}
+
+		rpcs[strings.ToLower(evmChainConf.Name)] = client
+		logger.Info(fmt.Sprintf("Successfully connected to EVM bridge for chain %s", evmChainConf.Name))
+	}
+
+	evmMgr := NewMgr(rpcs, cliCtx, b, logger, cdc, valAddr)
+
+	for _, evmChainConf := range axelarCfg.EVMConfig {
+		if !evmChainConf.WithBridge {
+			continue
+		}
+
+		if evmChainConf.Finality == "" {
+			continue
+		}
+
+		finality, err := createFinalityStrategy(evmChainConf, rpcs[strings.ToLower(evmChainConf.Name)])
+		if err != nil {
+			logger.Error(err.Error())
+			panic(err)
+		}
+
+		evmMgr.SetFinalityStrategy(nexus.ChainName(evmChainConf.Name), finality)
+	}
+
+	return evmMgr
+}
+
+func createGatewayWatcher(chain nexus.ChainName, evmMgr *Mgr, deps chains.Deps) (jobs.Job, error) {
+	cfg := deps.Config.GatewayDiscoveryConfig
+	if cfg.Interval <= 0 || cfg.MaxBlockRange == 0 {
+		return nil, fmt.Errorf("gateway discovery interval and max block range must be positive")
+	}
+
+	if cfg.ConfirmRetries < 0 {
+		return nil, fmt.Errorf("gateway discovery confirm retries must not be negative")
+	}
+
+	client, finality, ok := evmMgr.RPC(chain)
+	if !ok {
+		return nil, fmt.Errorf("gateway discovery of EVM chain %s requires an RPC connection", chain)
+	}
+
+	deps.Logger.Info(fmt.Sprintf("watching the gateway of EVM chain %s for new txs", chain))
+	store := fileBlockStore{path: filepath.Join(deps.StateDir, fmt.Sprintf("gateway-%s.json", strings.ToLower(chain.String())))}
+	watcher := NewGatewayWatcher(chain, client, finality, types.NewQueryServiceClient(deps.ClientCtx), chainMaintainerQuerier{clientCtx: deps.ClientCtx}, store,
+		deps.ProxyBroadcaster, deps.Validator, deps.ClientCtx.GetFromAddress(), cfg.Lookback, cfg.MaxBlockRange, cfg.GracePeriod, cfg.ConfirmRetries, deps.Logger)
+
+	return watcher.Watch(cfg.Interval), nil
+}
+
+// fileBlockStore persists the next block to scan in a file
+type fileBlockStore struct {
+	path string
+}
+
+// GetState returns the stored next block to scan
+func (s fileBlockStore) GetState() (int64, error) {
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "could not read the gateway watcher state")
+	}
+
+	var nextBlock int64
+	if err := json.Unmarshal(bz, &nextBlock); err != nil {
+		return 0, sdkerrors.Wrap(err, "gateway watcher state is in unexpected format")
+	}
+
+	if nextBlock < 0 {
+		return 0, fmt.Errorf("gateway watcher state must be a positive integer")
+	}
+
+	return nextBlock, nil
+}
+
+// SetState persists the next block to scan
+func (s fileBlockStore) SetState(nextBlock int64) error {
+	if nextBlock < 0 {
+		return fmt.Errorf("gateway watcher state must be a positive integer")
+	}
+
+	bz, err := json.Marshal(nextBlock)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path, bz, 0600)
+}
+
+// chainMaintainerQuerier queries the chain maintainers with the legacy querier of the nexus module
+type chainMaintainerQuerier struct {
+	clientCtx sdkClient.Context
+}
+
+// ChainMaintainers returns the maintainers of the given chain
+func (q chainMaintainerQuerier) ChainMaintainers(_ context.Context, chain nexus.ChainName) ([]sdk.ValAddress, error) {
+	bz, _, err := q.clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", nexusTypes.QuerierRoute, nexusKeeper.QueryChainMaintainers, chain.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	var res nexusTypes.QueryChainMaintainersResponse
+	if err := nexusTypes.ModuleCdc.UnmarshalLengthPrefixed(bz, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Maintainers, nil
+}
+
+func createFinalityStrategy(evmChainConf types.EVMConfig, client rpc.Client) (FinalityStrategy, error) {
+	switch evmChainConf.Finality {
+	case types.FinalityConfirmationHeight:
+		return ConfirmationHeightFinality{}, nil
+	case types.FinalityFinalizedTag:
+		if _, ok := client.(rpc.Eth2Client); !ok {
+			return nil, fmt.Errorf("JSON-RPC of EVM chain %s does not support the finalized tag", evmChainConf.Name)
+		}
+
+		return FinalizedTagFinality{}, nil
+	case types.FinalityMoonbeam:
+		if _, ok := client.(rpc.MoonbeamClient); !ok {
+			return nil, fmt.Errorf("JSON-RPC of EVM chain %s does not support moonbeam finality", evmChainConf.Name)
+		}
+
+		return MoonbeamFinality{}, nil
+	case types.FinalityRollup:
+		if _, ok := client.(rpc.RollupClient); !ok {
+			return nil, fmt.Errorf("JSON-RPC of rollup %s does not report the L1 block of its blocks", evmChainConf.Name)
+		}
+
+		if evmChainConf.L1RPCAddr == "" {
+			return nil, fmt.Errorf("rollup finality of EVM chain %s requires an L1 JSON-RPC endpoint", evmChainConf.Name)
+		}
+
+		l1, err := rpc.NewClient(evmChainConf.L1RPCAddr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, fmt.Sprintf("Failed to create an RPC connection to the L1 of EVM chain %s. Verify your RPC config.", evmChainConf.Name))
+		}
+
+		// the L1 must report finalized blocks, a confirmation height is only meaningful for the blocks of the rollup itself
+		l1Finality := DefaultFinalityStrategy(l1)
+		if _, ok := l1Finality.(ConfirmationHeightFinality); ok {
+			l1.Close()
+			return nil, fmt.Errorf("JSON-RPC of the L1 of rollup %s does not report finalized blocks", evmChainConf.Name)
+		}
+
+		return NewRollupFinality(l1, NewCachedFinality(l1Finality)), nil
+	default:
+		return nil, fmt.Errorf("unknown finality strategy %s for EVM chain %s", evmChainConf.Finality, evmChainConf.Name)
+	}
+}
+
+func createRPC(evmChainConf types.EVMConfig, logger tmLog.Logger) (rpc.Client, error) {
+	if len(evmChainConf.AdditionalRPCAddrs) == 0 {
+		client, err := rpc.NewClient(evmChainConf.RPCAddr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, fmt.Sprintf("Failed to create an RPC connection for EVM chain %s. Verify your RPC config.", evmChainConf.Name))
+		}
+		logger.Debug(fmt.Sprintf("created JSON-RPC client of type %T", client),
+			"chain", evmChainConf.Name,
+			"url", evmChainConf.RPCAddr,
+		)
+
+		return client, nil
+	}
+
+	var endpoints []rpc.Endpoint
+	closeAll := func() {
+		for _, endpoint := range endpoints {
+			endpoint.Client.Close()
+		}
+	}
+
+	for _, url := range evmChainConf.RPCAddrs() {
+		client, err := rpc.NewClient(url)
+		if err != nil {
+			closeAll()
+			return nil, sdkerrors.Wrap(err, fmt.Sprintf("Failed to create an RPC connection to %s for EVM chain %s. Verify your RPC config.", url, evmChainConf.Name))
+		}
+
+		endpoints = append(endpoints, rpc.Endpoint{URL: url, Client: client})
+	}
+
+	var client rpc.Client
+	var err error
+	switch evmChainConf.RPCMode {
+	case types.RPCModeQuorum, "":
+		quorum := evmChainConf.RPCQuorum
+		if quorum == 0 {
+			quorum = len(endpoints)/2 + 1
+		}
+
+		client, err = rpc.NewQuorumClient(endpoints, quorum, logger.With("chain", evmChainConf.Name))
+	case types.RPCModeFailover:
+		client, err = rpc.NewFailoverClient(evmChainConf.Name, endpoints, createFailoverConfig(evmChainConf), logger.With("chain", evmChainConf.Name))
+	default:
+		err = fmt.Errorf("unknown rpc mode %s", evmChainConf.RPCMode)
+	}
+	if err != nil {
+		closeAll()
+		return nil, sdkerrors.Wrap(err, fmt.Sprintf("Failed to create a multi-endpoint RPC client for EVM chain %s. Verify your RPC config.", evmChainConf.Name))
+	}
+	logger.Debug(fmt.Sprintf("created JSON-RPC client of type %T", client),
+		"chain", evmChainConf.Name,
+		"urls", strings.Join(evmChainConf.RPCAddrs(), ", "),
+	)
+
+	return client, nil
+}
+
+func createFailoverConfig(evmChainConf types.EVMConfig) rpc.FailoverConfig {
+	failoverConfig := rpc.DefaultFailoverConfig()
+	if evmChainConf.FailoverCallTimeout > 0 {
+		failoverConfig.CallTimeout = evmChainConf.FailoverCallTimeout
+	}
+	if evmChainConf.FailoverMaxFailures > 0 {
+		failoverConfig.MaxConsecutiveFailures = evmChainConf.FailoverMaxFailures
+	}
+	if evmChainConf.FailoverEjectionPeriod > 0 {
+		failoverConfig.EjectionPeriod = evmChainConf.FailoverEjectionPeriod
+	}
+
+	return failoverConfig
+}
+
+// watchConfig periodically checks the given config file for changes and connects to EVM chains that were added after vald started
+func watchConfig(configPath string, interval time.Duration, evmMgr *Mgr, logger tmLog.Logger) jobs.Job {
+	return func(ctx context.Context) error {
+		var lastModified time.Time
+		if info, err := os.Stat(configPath); err == nil {
+			lastModified = info.ModTime()
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				info, err := os.Stat(configPath)
+				if err != nil {
+					logger.Error(sdkerrors.Wrapf(err, "failed to check config file %s for new EVM chains", configPath).Error())
+					continue
+				}
+
+				if !info.ModTime().After(lastModified) {
+					continue
+				}
+				lastModified = info.ModTime()
+
+				logger.Info(fmt.Sprintf("config file %s changed, checking for new EVM chains", configPath))
+				loadNewChains(configPath, evmMgr, logger)
+			}
+		}
+	}
+}
+
+// loadNewChains reads the EVM configuration from the given file and registers all chains that are not yet known to the evm manager.
+// The evm manager closes their RPC clients when it is stopped.
+func loadNewChains(configPath string, evmMgr *Mgr, logger tmLog.Logger) {
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	if err := v.ReadInConfig(); err != nil {
+		logger.Error(sdkerrors.Wrapf(err, "failed to read config file %s", configPath).Error())
+		return
+	}
+
+	// do not start from the default config, otherwise the default chain would be added if the file contains no EVM configuration
+	var valdConf config.ValdConfig
+	if err := v.Unmarshal(&valdConf); err != nil {
+		logger.Error(sdkerrors.Wrapf(err, "failed to parse config file %s", configPath).Error())
+		return
+	}
+
+	for _, evmChainConf := range valdConf.EVMConfig {
+		chain := nexus.ChainName(evmChainConf.Name)
+		if !evmChainConf.WithBridge || evmMgr.HasRPC(chain) {
+			continue
+		}
+
+		client, err := createRPC(evmChainConf, logger)
+		if err != nil {
+			logger.Error(err.Error())
+			continue
+		}
+
+		if evmChainConf.Finality != "" {
+			finality, err := createFinalityStrategy(evmChainConf, client)
+			if err != nil {
+				logger.Error(err.Error())
+				client.Close()
+				continue
+			}
+
+			evmMgr.SetFinalityStrategy(chain, finality)
+		}
+
+		if !evmMgr.AddRPC(chain, client) {
+			client.Close()
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Successfully connected to EVM bridge for chain %s", evmChainConf.Name))
+	}
+}
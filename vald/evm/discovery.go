@@ -0,0 +1,373 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+//go:generate moq -out ./mock/discovery.go -pkg mock . GatewayQuerier MaintainerQuerier BlockStore
+
+// GatewayQuerier queries the state of the evm module that is needed to discover gateway txs
+type GatewayQuerier interface {
+	GatewayAddress(ctx context.Context, in *types.GatewayAddressRequest, opts ...grpc.CallOption) (*types.GatewayAddressResponse, error)
+	ConfirmationHeight(ctx context.Context, in *types.ConfirmationHeightRequest, opts ...grpc.CallOption) (*types.ConfirmationHeightResponse, error)
+	Event(ctx context.Context, in *types.EventRequest, opts ...grpc.CallOption) (*types.EventResponse, error)
+}
+
+// MaintainerQuerier queries the chain maintainers of a chain
+type MaintainerQuerier interface {
+	ChainMaintainers(ctx context.Context, chain nexus.ChainName) ([]sdk.ValAddress, error)
+}
+
+// BlockStore persists the next block to scan, so blocks that were finalized while vald was down are not skipped after a restart
+type BlockStore interface {
+	GetState() (int64, error)
+	SetState(nextBlock int64) error
+}
+
+// gatewayEventSigs are the gateway events that need to be confirmed with a ConfirmGatewayTx request
+var gatewayEventSigs = []common.Hash{ContractCallSig, ContractCallWithTokenSig, TokenSentSig}
+
+// GatewayWatcher discovers txs with gateway events on an EVM chain and requests their confirmation,
+// so they do not need to be confirmed manually. Only finalized blocks are scanned,
+// and txs whose events are already known to the evm module are skipped.
+// Each tx is assigned to a single chain maintainer, so the same tx is not submitted by every maintainer.
+// All other maintainers request the confirmation of the tx after a grace period if its events are still unknown,
+// e.g. because the assigned maintainer has not enabled gateway discovery.
+// Failed confirmation requests are retried in the following scans a limited number of times without holding up the scan of new blocks.
+// Because the block to resume at is persisted, a maintainer that was down catches up on its txs after a restart.
+type GatewayWatcher struct {
+	chain         nexus.ChainName
+	client        rpc.Client
+	finality      FinalityStrategy
+	querier       GatewayQuerier
+	maintainers   MaintainerQuerier
+	store         BlockStore
+	broadcaster   broadcast.Broadcaster
+	validator     sdk.ValAddress
+	sender        sdk.AccAddress
+	lookback      uint64
+	maxBlockRange uint64
+	gracePeriod   uint64
+	maxRetries    int
+	logger        tmLog.Logger
+
+	gateway    *common.Address
+	confHeight uint64
+	nextBlock  *big.Int
+	// pending holds the discovered txs whose confirmation has not been requested successfully yet
+	pending map[common.Hash]pendingTx
+}
+
+// pendingTx is a discovered gateway tx whose confirmation is requested once the due block is finalized
+type pendingTx struct {
+	blockNumber uint64
+	dueBlock    uint64
+	failures    int
+}
+
+// NewGatewayWatcher returns a new GatewayWatcher instance. It resumes scanning at the stored block, or if there is none,
+// the given number of blocks before the latest finalized block, and scans at most maxBlockRange blocks at a time.
+// Txs assigned to other maintainers are picked up gracePeriod blocks after their block, failed requests are retried up to maxRetries times.
+func NewGatewayWatcher(chain nexus.ChainName, client rpc.Client, finality FinalityStrategy, querier GatewayQuerier, maintainers MaintainerQuerier, store BlockStore,
+	broadcaster broadcast.Broadcaster, validator sdk.ValAddress, sender sdk.AccAddress, lookback uint64, maxBlockRange uint64, gracePeriod uint64, maxRetries int, logger tmLog.Logger) *GatewayWatcher {
+	return &GatewayWatcher{
+		chain:         chain,
+		client:        client,
+		finality:      finality,
+		querier:       querier,
+		maintainers:   maintainers,
+		store:         store,
+		broadcaster:   broadcaster,
+		validator:     validator,
+		sender:        sender,
+		lookback:      lookback,
+		maxBlockRange: maxBlockRange,
+		gracePeriod:   gracePeriod,
+		maxRetries:    maxRetries,
+		logger:        logger.With("process", "gateway discovery", "chain", chain.String()),
+		pending:       make(map[common.Hash]pendingTx),
+	}
+}
+
+// Watch returns a job that scans the gateway for new txs in the given interval
+func (w *GatewayWatcher) Watch(interval time.Duration) jobs.Job {
+	return func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				if err := w.Scan(ctx); err != nil {
+					w.logger.Error(sdkerrors.Wrap(err, "failed to discover gateway txs").Error())
+				}
+			}
+		}
+	}
+}
+
+// Scan discovers the txs with gateway events in the next range of finalized blocks
+// and requests the confirmation of all pending txs with unknown events that are due
+func (w *GatewayWatcher) Scan(ctx context.Context) error {
+	if err := w.init(ctx); err != nil {
+		return err
+	}
+
+	var from uint64
+	if w.nextBlock != nil {
+		from = w.nextBlock.Uint64()
+	}
+
+	latestFinalized, err := LatestFinalizedBlock(ctx, w.client, w.finality, w.confHeight, from)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed getting the latest finalized block")
+	}
+
+	if w.nextBlock == nil {
+		w.nextBlock = w.startBlock(latestFinalized)
+	}
+
+	toBlock := new(big.Int).Add(w.nextBlock, new(big.Int).SetUint64(w.maxBlockRange-1))
+	if toBlock.Int64() > latestFinalized {
+		toBlock.SetInt64(latestFinalized)
+	}
+
+	if toBlock.Cmp(w.nextBlock) < 0 {
+		w.confirmPending(ctx, latestFinalized)
+		return nil
+	}
+
+	logs, err := w.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: w.nextBlock,
+		ToBlock:   toBlock,
+		Addresses: []common.Address{*w.gateway},
+		Topics:    [][]common.Hash{gatewayEventSigs},
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed getting gateway logs of blocks %s to %s", w.nextBlock.String(), toBlock.String())
+	}
+
+	maintainers, err := w.maintainers.ChainMaintainers(ctx, w.chain)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed getting the chain maintainers")
+	}
+
+	if !slices.Any(maintainers, func(maintainer sdk.ValAddress) bool { return maintainer.Equals(w.validator) }) {
+		w.logger.Debug("skipping discovered gateway txs because the validator is not a chain maintainer")
+	} else {
+		for _, log := range txLogs(logs) {
+			assigned, _ := assignee(maintainers, log.TxHash)
+			if assigned.Equals(w.validator) {
+				w.pending[log.TxHash] = pendingTx{blockNumber: log.BlockNumber, dueBlock: log.BlockNumber}
+				continue
+			}
+
+			w.logger.Debug(fmt.Sprintf("gateway tx %s is assigned to another chain maintainer", log.TxHash.Hex()))
+			w.pending[log.TxHash] = pendingTx{blockNumber: log.BlockNumber, dueBlock: log.BlockNumber + w.gracePeriod}
+		}
+	}
+
+	w.nextBlock = new(big.Int).Add(toBlock, big.NewInt(1))
+	w.confirmPending(ctx, latestFinalized)
+
+	// resume at the earliest pending tx after a restart, so pending txs are discovered again
+	if err := w.store.SetState(w.resumeBlock()); err != nil {
+		w.logger.Error(sdkerrors.Wrapf(err, "failed to store the next block %s to scan", w.nextBlock.String()).Error())
+	}
+
+	return nil
+}
+
+// confirmPending requests the confirmation of all pending txs that are due at the given latest finalized block.
+// A failed request is retried in the following scans until it has failed more than maxRetries times.
+func (w *GatewayWatcher) confirmPending(ctx context.Context, latestFinalized int64) {
+	var due []common.Hash
+	for txID, tx := range w.pending {
+		if int64(tx.dueBlock) <= latestFinalized {
+			due = append(due, txID)
+		}
+	}
+
+	// request confirmations in the order of the txs' blocks
+	sort.Slice(due, func(i, j int) bool {
+		if w.pending[due[i]].blockNumber != w.pending[due[j]].blockNumber {
+			return w.pending[due[i]].blockNumber < w.pending[due[j]].blockNumber
+		}
+		return bytes.Compare(due[i].Bytes(), due[j].Bytes()) < 0
+	})
+
+	for _, txID := range due {
+		err := w.confirm(ctx, txID)
+		if err == nil {
+			delete(w.pending, txID)
+			continue
+		}
+
+		tx := w.pending[txID]
+		tx.failures++
+		if tx.failures > w.maxRetries {
+			w.logger.Error(sdkerrors.Wrapf(err, "giving up on requesting the confirmation of gateway tx %s after %d attempts", txID.Hex(), tx.failures).Error())
+			delete(w.pending, txID)
+			continue
+		}
+
+		w.logger.Error(sdkerrors.Wrapf(err, "failed to request the confirmation of gateway tx %s, retrying in the next scan", txID.Hex()).Error())
+		w.pending[txID] = tx
+	}
+}
+
+// resumeBlock returns the block to resume scanning at after a restart
+func (w *GatewayWatcher) resumeBlock() int64 {
+	resume := w.nextBlock.Int64()
+	for _, tx := range w.pending {
+		if int64(tx.blockNumber) < resume {
+			resume = int64(tx.blockNumber)
+		}
+	}
+
+	return resume
+}
+
+// startBlock returns the stored next block to scan, or the block the given number of lookback blocks before the latest finalized block if none is stored
+func (w *GatewayWatcher) startBlock(latestFinalized int64) *big.Int {
+	stored, err := w.store.GetState()
+	if err == nil {
+		w.logger.Info(fmt.Sprintf("resuming gateway discovery at stored block %d", stored))
+		return big.NewInt(stored)
+	}
+
+	w.logger.Info(fmt.Sprintf("no stored block to resume gateway discovery, scanning the last %d finalized blocks: %s", w.lookback, err.Error()))
+
+	start := big.NewInt(latestFinalized + 1 - int64(w.lookback))
+	if start.Sign() < 0 {
+		start.SetInt64(0)
+	}
+
+	return start
+}
+
+// init queries the gateway address and the confirmation height of the chain until they are available
+func (w *GatewayWatcher) init(ctx context.Context) error {
+	if w.gateway != nil {
+		return nil
+	}
+
+	gatewayRes, err := w.querier.GatewayAddress(ctx, &types.GatewayAddressRequest{Chain: w.chain.String()})
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed getting the gateway address")
+	}
+
+	confHeightRes, err := w.querier.ConfirmationHeight(ctx, &types.ConfirmationHeightRequest{Chain: w.chain.String()})
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed getting the confirmation height")
+	}
+
+	gateway := common.HexToAddress(gatewayRes.Address)
+	w.gateway = &gateway
+	w.confHeight = confHeightRes.Height
+
+	return nil
+}
+
+// confirm requests the confirmation of the given tx unless all of its gateway events are already known to the evm module
+func (w *GatewayWatcher) confirm(ctx context.Context, txID common.Hash) error {
+	known, err := w.eventsKnown(ctx, txID)
+	if err != nil {
+		return err
+	}
+
+	if known {
+		w.logger.Debug(fmt.Sprintf("gateway events of tx %s are already known", txID.Hex()))
+		return nil
+	}
+
+	w.logger.Info(fmt.Sprintf("requesting confirmation of gateway tx %s", txID.Hex()))
+	if _, err := w.broadcaster.Broadcast(ctx, types.NewConfirmGatewayTxRequest(w.sender, w.chain.String(), types.Hash(txID))); err != nil {
+		return sdkerrors.Wrapf(err, "failed requesting confirmation of gateway tx %s", txID.Hex())
+	}
+
+	return nil
+}
+
+// eventsKnown returns true if all gateway events of the given tx have been confirmed already
+func (w *GatewayWatcher) eventsKnown(ctx context.Context, txID common.Hash) (bool, error) {
+	receipt, err := w.client.TransactionReceipt(ctx, txID)
+	if err != nil {
+		return false, sdkerrors.Wrapf(err, "failed getting transaction receipt %s", txID.Hex())
+	}
+
+	// event IDs refer to the index of the log in the receipt, not in the block
+	for i, log := range receipt.Logs {
+		if log.Address != *w.gateway || len(log.Topics) == 0 || !slices.Any(gatewayEventSigs, func(sig common.Hash) bool { return sig == log.Topics[0] }) {
+			continue
+		}
+
+		eventID := types.Event{TxID: types.Hash(txID), Index: uint64(i)}.GetID()
+		_, err := w.querier.Event(ctx, &types.EventRequest{Chain: w.chain.String(), EventId: string(eventID)})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			return false, nil
+		case err != nil:
+			return false, sdkerrors.Wrapf(err, "failed getting event %s", eventID)
+		}
+	}
+
+	return true, nil
+}
+
+// assignee returns the chain maintainer that is responsible for requesting the confirmation of the given tx.
+// All maintainers derive the same assignee from the tx hash, so each tx is submitted once instead of once per maintainer.
+func assignee(maintainers []sdk.ValAddress, txID common.Hash) (sdk.ValAddress, bool) {
+	if len(maintainers) == 0 {
+		return nil, false
+	}
+
+	sorted := append([]sdk.ValAddress{}, maintainers...)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+
+	hash := sha256.Sum256(txID.Bytes())
+	return sorted[binary.BigEndian.Uint64(hash[:8])%uint64(len(sorted))], true
+}
+
+// txLogs returns the first log of each distinct tx of the given logs in order of their appearance, skipping logs that were removed by a reorg
+func txLogs(logs []geth.Log) []geth.Log {
+	seen := make(map[common.Hash]bool)
+
+	var txLogs []geth.Log
+	for _, log := range logs {
+		if log.Removed || seen[log.TxHash] {
+			continue
+		}
+
+		seen[log.TxHash] = true
+		txLogs = append(txLogs, log)
+	}
+
+	return txLogs
+}
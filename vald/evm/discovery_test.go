@@ -0,0 +1,272 @@
+package evm_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	broadcastmock "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	"github.com/axelarnetwork/axelar-core/vald/evm/mock"
+	rpcmock "github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func TestGatewayWatcher_Scan(t *testing.T) {
+	chain := nexus.ChainName("Ethereum")
+	gateway := common.BytesToAddress(rand.Bytes(common.AddressLength))
+	confHeight := uint64(10)
+	latest := uint64(100)
+
+	var (
+		client      *rpcmock.ClientMock
+		querier     *mock.GatewayQuerierMock
+		maintainers *mock.MaintainerQuerierMock
+		store       *mock.BlockStoreMock
+		broadcaster *broadcastmock.BroadcasterMock
+		validator   sdk.ValAddress
+		watcher     *evm.GatewayWatcher
+		logs        []geth.Log
+		receipts    map[common.Hash]*geth.Receipt
+		known       map[string]bool
+	)
+
+	randomHash := func() common.Hash { return common.BytesToHash(rand.Bytes(common.HashLength)) }
+
+	// addTx adds a tx to the given block with an unrelated log and a ContractCall log of the gateway
+	addTx := func(blockNumber uint64) common.Hash {
+		txID := randomHash()
+		gatewayLog := geth.Log{Address: gateway, Topics: []common.Hash{evm.ContractCallSig}, TxHash: txID, BlockNumber: blockNumber, Index: uint(rand.PosI64())}
+		logs = append(logs, gatewayLog)
+		receipts[txID] = &geth.Receipt{TxHash: txID, Logs: []*geth.Log{{Address: common.BytesToAddress(rand.Bytes(common.AddressLength))}, &gatewayLog}}
+
+		return txID
+	}
+
+	setup := func() {
+		logs = nil
+		receipts = make(map[common.Hash]*geth.Receipt)
+		known = make(map[string]bool)
+		latest = 100
+
+		client = &rpcmock.ClientMock{
+			BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil },
+			FilterLogsFunc: func(_ context.Context, q ethereum.FilterQuery) ([]geth.Log, error) {
+				var filtered []geth.Log
+				for _, l := range logs {
+					if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+						filtered = append(filtered, l)
+					}
+				}
+				return filtered, nil
+			},
+			TransactionReceiptFunc: func(_ context.Context, txID common.Hash) (*geth.Receipt, error) {
+				return receipts[txID], nil
+			},
+		}
+		querier = &mock.GatewayQuerierMock{
+			GatewayAddressFunc: func(context.Context, *types.GatewayAddressRequest, ...grpc.CallOption) (*types.GatewayAddressResponse, error) {
+				return &types.GatewayAddressResponse{Address: gateway.Hex()}, nil
+			},
+			ConfirmationHeightFunc: func(context.Context, *types.ConfirmationHeightRequest, ...grpc.CallOption) (*types.ConfirmationHeightResponse, error) {
+				return &types.ConfirmationHeightResponse{Height: confHeight}, nil
+			},
+			EventFunc: func(_ context.Context, req *types.EventRequest, _ ...grpc.CallOption) (*types.EventResponse, error) {
+				if !known[req.EventId] {
+					return nil, status.Error(codes.NotFound, "not found")
+				}
+				return &types.EventResponse{}, nil
+			},
+		}
+		validator = rand.ValAddr()
+		maintainers = &mock.MaintainerQuerierMock{
+			ChainMaintainersFunc: func(context.Context, nexus.ChainName) ([]sdk.ValAddress, error) {
+				return []sdk.ValAddress{validator}, nil
+			},
+		}
+		store = &mock.BlockStoreMock{
+			GetStateFunc: func() (int64, error) { return 0, fmt.Errorf("not found") },
+			SetStateFunc: func(int64) error { return nil },
+		}
+		broadcaster = &broadcastmock.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+		watcher = evm.NewGatewayWatcher(chain, client, evm.ConfirmationHeightFinality{}, querier, maintainers, store, broadcaster, validator, rand.AccAddr(), 50, 1000, 100, 2, log.TestingLogger())
+	}
+
+	confirmedTxs := func() []types.Hash {
+		var txIDs []types.Hash
+		for _, call := range broadcaster.BroadcastCalls() {
+			for _, msg := range call.Msgs {
+				txIDs = append(txIDs, msg.(*types.ConfirmGatewayTxRequest).TxID)
+			}
+		}
+		return txIDs
+	}
+
+	t.Run("should request confirmation of finalized txs with unknown events", func(t *testing.T) {
+		setup()
+		unknownTx := addTx(60)
+		knownTx := addTx(70)
+		known[string(types.Event{TxID: types.Hash(knownTx), Index: 1}.GetID())] = true
+		addTx(latest - confHeight + 2) // not final yet
+		addTx(40)                      // before the lookback
+
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Equal(t, []types.Hash{types.Hash(unknownTx)}, confirmedTxs())
+		assert.Equal(t, big.NewInt(42), client.FilterLogsCalls()[0].Q.FromBlock)
+		assert.Equal(t, big.NewInt(91), client.FilterLogsCalls()[0].Q.ToBlock)
+		assert.Equal(t, []common.Address{gateway}, client.FilterLogsCalls()[0].Q.Addresses)
+	})
+
+	t.Run("should continue with the next finalized blocks", func(t *testing.T) {
+		setup()
+		assert.NoError(t, watcher.Scan(context.Background()))
+
+		// no new finalized blocks
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Len(t, client.FilterLogsCalls(), 1)
+
+		pendingTx := addTx(latest - confHeight + 2)
+		latest += 5
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Len(t, client.FilterLogsCalls(), 2)
+		assert.Equal(t, big.NewInt(92), client.FilterLogsCalls()[1].Q.FromBlock)
+		assert.Equal(t, []types.Hash{types.Hash(pendingTx)}, confirmedTxs())
+	})
+
+	t.Run("should retry failed confirmation requests in the next scans without scanning the same blocks again", func(t *testing.T) {
+		setup()
+		firstTx := addTx(60)
+		secondTx := addTx(70)
+
+		broadcaster.BroadcastFunc = func(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+			if msgs[0].(*types.ConfirmGatewayTxRequest).TxID == types.Hash(secondTx) {
+				return nil, fmt.Errorf("failed")
+			}
+			return &sdk.TxResponse{}, nil
+		}
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Equal(t, []types.Hash{types.Hash(firstTx), types.Hash(secondTx)}, confirmedTxs())
+		// resume at the failed tx after a restart
+		assert.EqualValues(t, 70, store.SetStateCalls()[0].NextBlock)
+
+		for i := 0; i < 3; i++ {
+			latest += 5
+			assert.NoError(t, watcher.Scan(context.Background()))
+		}
+
+		// the failed tx is given up after the max retries
+		assert.Equal(t, []types.Hash{types.Hash(firstTx), types.Hash(secondTx), types.Hash(secondTx), types.Hash(secondTx)}, confirmedTxs())
+		assert.Equal(t, big.NewInt(92), client.FilterLogsCalls()[1].Q.FromBlock)
+		assert.EqualValues(t, 107, store.SetStateCalls()[len(store.SetStateCalls())-1].NextBlock)
+	})
+
+	t.Run("should store the next block to scan and resume at the stored block", func(t *testing.T) {
+		setup()
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Len(t, store.SetStateCalls(), 1)
+		assert.EqualValues(t, 92, store.SetStateCalls()[0].NextBlock)
+
+		setup()
+		missedTx := addTx(20)
+		store.GetStateFunc = func() (int64, error) { return 15, nil }
+
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Equal(t, big.NewInt(15), client.FilterLogsCalls()[0].Q.FromBlock)
+		assert.Equal(t, []types.Hash{types.Hash(missedTx)}, confirmedTxs())
+	})
+
+	t.Run("should only request confirmation of the txs assigned to the validator", func(t *testing.T) {
+		setup()
+		var txIDs []types.Hash
+		for i := 0; i < 20; i++ {
+			txIDs = append(txIDs, types.Hash(addTx(uint64(50+i))))
+		}
+
+		other := rand.ValAddr()
+		maintainers.ChainMaintainersFunc = func(context.Context, nexus.ChainName) ([]sdk.ValAddress, error) {
+			return []sdk.ValAddress{validator, other}, nil
+		}
+		assert.NoError(t, watcher.Scan(context.Background()))
+		ownTxs := confirmedTxs()
+
+		broadcaster = &broadcastmock.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+		otherWatcher := evm.NewGatewayWatcher(chain, client, evm.ConfirmationHeightFinality{}, querier, maintainers, store, broadcaster, other, rand.AccAddr(), 50, 1000, 100, 2, log.TestingLogger())
+		assert.NoError(t, otherWatcher.Scan(context.Background()))
+		otherTxs := confirmedTxs()
+
+		assert.NotEmpty(t, ownTxs)
+		assert.NotEmpty(t, otherTxs)
+		assert.ElementsMatch(t, txIDs, append(ownTxs, otherTxs...))
+	})
+
+	t.Run("should request confirmation of txs assigned to other maintainers with unknown events after the grace period", func(t *testing.T) {
+		setup()
+		var txIDs []types.Hash
+		for i := 0; i < 20; i++ {
+			txIDs = append(txIDs, types.Hash(addTx(uint64(50+i))))
+		}
+
+		maintainers.ChainMaintainersFunc = func(context.Context, nexus.ChainName) ([]sdk.ValAddress, error) {
+			return []sdk.ValAddress{validator, rand.ValAddr()}, nil
+		}
+		assert.NoError(t, watcher.Scan(context.Background()))
+		ownTxs := confirmedTxs()
+
+		var otherTxs []types.Hash
+		for _, txID := range txIDs {
+			if !slices.Any(ownTxs, func(own types.Hash) bool { return own == txID }) {
+				otherTxs = append(otherTxs, txID)
+			}
+		}
+		assert.NotEmpty(t, ownTxs)
+		assert.NotEmpty(t, otherTxs)
+
+		// the other maintainer confirmed one of its txs in time
+		known[string(types.Event{TxID: otherTxs[0], Index: 1}.GetID())] = true
+
+		latest += 50
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Equal(t, ownTxs, confirmedTxs())
+
+		latest += 50
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Equal(t, append(ownTxs, otherTxs[1:]...), confirmedTxs())
+	})
+
+	t.Run("should not request confirmations if the validator is not a chain maintainer", func(t *testing.T) {
+		setup()
+		addTx(60)
+		maintainers.ChainMaintainersFunc = func(context.Context, nexus.ChainName) ([]sdk.ValAddress, error) { return nil, nil }
+
+		assert.NoError(t, watcher.Scan(context.Background()))
+		assert.Empty(t, confirmedTxs())
+	})
+
+	t.Run("should not scan before the gateway is set", func(t *testing.T) {
+		setup()
+		querier.GatewayAddressFunc = func(context.Context, *types.GatewayAddressRequest, ...grpc.CallOption) (*types.GatewayAddressResponse, error) {
+			return nil, fmt.Errorf("gateway not set")
+		}
+
+		assert.Error(t, watcher.Scan(context.Background()))
+		assert.Len(t, client.FilterLogsCalls(), 0)
+	})
+}
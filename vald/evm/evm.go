@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/armon/go-metrics"
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum"
@@ -19,11 +23,15 @@ import (
 	tmLog "github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	"github.com/axelarnetwork/axelar-core/vald/chains"
 	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
 	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/jobs"
 	"github.com/axelarnetwork/utils/slices"
 )
 
@@ -42,45 +50,198 @@ type Mgr struct {
 	cliCtx      sdkClient.Context
 	logger      tmLog.Logger
 	rpcs        map[string]rpc.Client
+	finalities  map[string]FinalityStrategy
+	rpcsLock    sync.RWMutex
 	broadcaster broadcast.Broadcaster
 	cdc         *codec.LegacyAmino
 	validator   sdk.ValAddress
+	auditLog    audit.Log
+	jobs        []jobs.Job
+	chainLoader func(chain nexus.ChainName)
 }
 
+var _ chains.ChainMgr = &Mgr{}
+
 // NewMgr returns a new Mgr instance
 func NewMgr(rpcs map[string]rpc.Client, cliCtx sdkClient.Context, broadcaster broadcast.Broadcaster, logger tmLog.Logger, cdc *codec.LegacyAmino, valAddr sdk.ValAddress) *Mgr {
 	return &Mgr{
 		rpcs:        rpcs,
+		finalities:  make(map[string]FinalityStrategy),
 		cliCtx:      cliCtx,
 		broadcaster: broadcaster,
 		logger:      logger.With("listener", "evm"),
 		cdc:         cdc,
 		validator:   valAddr,
+		auditLog:    audit.NoOp{},
+	}
+}
+
+// Module implements chains.ChainMgr
+func (mgr *Mgr) Module() string {
+	return types.ModuleName
+}
+
+// Subscriptions implements chains.ChainMgr
+func (mgr *Mgr) Subscriptions() []chains.Subscription {
+	return []chains.Subscription{
+		chains.Subscribe(mgr.ProcessNewChain),
+		chains.SubscribeWithJournal(func(e *types.ConfirmDepositStarted) string { return chains.PollKey(e.PollID) }, mgr.ProcessDepositConfirmation),
+		chains.SubscribeWithJournal(func(e *types.ConfirmTokenStarted) string { return chains.PollKey(e.PollID) }, mgr.ProcessTokenConfirmation),
+		chains.SubscribeWithJournal(func(e *types.ConfirmKeyTransferStarted) string { return chains.PollKey(e.PollID) }, mgr.ProcessTransferKeyConfirmation),
+		chains.SubscribeWithJournal(func(e *types.ConfirmGatewayTxStarted) string { return chains.PollKey(e.PollID) }, mgr.ProcessGatewayTxConfirmation),
+	}
+}
+
+// AddJobs adds background processes that run while the manager is started
+func (mgr *Mgr) AddJobs(js ...jobs.Job) {
+	mgr.jobs = append(mgr.jobs, js...)
+}
+
+// Start implements chains.ChainMgr
+func (mgr *Mgr) Start(ctx context.Context) error {
+	jobMgr := jobs.NewMgr(ctx)
+	jobMgr.AddJobs(mgr.jobs...)
+	<-jobMgr.Done()
+
+	if err, ok := <-jobMgr.Errs(); ok {
+		return err
+	}
+
+	return nil
+}
+
+// Stop implements chains.ChainMgr. It closes the connections of all RPC clients.
+func (mgr *Mgr) Stop() {
+	mgr.rpcsLock.RLock()
+	defer mgr.rpcsLock.RUnlock()
+
+	for _, client := range mgr.rpcs {
+		client.Close()
+	}
+}
+
+// SetAuditLog sets the log that records all votes cast by the manager
+func (mgr *Mgr) SetAuditLog(auditLog audit.Log) {
+	mgr.auditLog = auditLog
+}
+
+// SetChainLoader sets the function that connects to the RPC endpoints configured for a chain,
+// so vald can start voting on chains as soon as they are added to the network
+func (mgr *Mgr) SetChainLoader(loader func(chain nexus.ChainName)) {
+	mgr.chainLoader = loader
+}
+
+// AddRPC registers the RPC client for the given chain so vald starts voting on it.
+// Returns false if the chain already has a registered client.
+func (mgr *Mgr) AddRPC(chain nexus.ChainName, client rpc.Client) bool {
+	mgr.rpcsLock.Lock()
+	defer mgr.rpcsLock.Unlock()
+
+	key := strings.ToLower(chain.String())
+	if _, ok := mgr.rpcs[key]; ok {
+		return false
+	}
+
+	mgr.rpcs[key] = client
+	return true
+}
+
+// HasRPC returns true if an RPC client is registered for the given chain
+func (mgr *Mgr) HasRPC(chain nexus.ChainName) bool {
+	_, ok := mgr.getRPC(chain)
+	return ok
+}
+
+// SetFinalityStrategy sets the strategy that decides if blocks of the given chain are final.
+// Chains without a strategy use the default strategy for their RPC client.
+func (mgr *Mgr) SetFinalityStrategy(chain nexus.ChainName, finality FinalityStrategy) {
+	mgr.rpcsLock.Lock()
+	defer mgr.rpcsLock.Unlock()
+
+	if mgr.finalities == nil {
+		mgr.finalities = make(map[string]FinalityStrategy)
+	}
+
+	mgr.finalities[strings.ToLower(chain.String())] = NewCachedFinality(finality)
+}
+
+// RPC returns the RPC client and finality strategy registered for the given chain
+func (mgr *Mgr) RPC(chain nexus.ChainName) (rpc.Client, FinalityStrategy, bool) {
+	client, ok := mgr.getRPC(chain)
+	if !ok {
+		return nil, nil, false
 	}
+
+	return client, mgr.getFinalityStrategy(chain, client), true
 }
 
-// ProcessNewChain notifies the operator that vald needs to be restarted/udpated for a new chain
-func (mgr Mgr) ProcessNewChain(event *types.ChainAdded) (err error) {
-	mgr.logger.Info(fmt.Sprintf("VALD needs to be updated and restarted for new chain %s", event.Chain.String()))
+func (mgr *Mgr) getFinalityStrategy(chain nexus.ChainName, client rpc.Client) FinalityStrategy {
+	mgr.rpcsLock.RLock()
+	finality, ok := mgr.finalities[strings.ToLower(chain.String())]
+	mgr.rpcsLock.RUnlock()
+
+	if ok {
+		return finality
+	}
+
+	mgr.rpcsLock.Lock()
+	defer mgr.rpcsLock.Unlock()
+
+	if mgr.finalities == nil {
+		mgr.finalities = make(map[string]FinalityStrategy)
+	}
+
+	// the default strategy is stored, so its cache is shared by all polls of the chain
+	if finality, ok = mgr.finalities[strings.ToLower(chain.String())]; !ok {
+		finality = NewCachedFinality(DefaultFinalityStrategy(client))
+		mgr.finalities[strings.ToLower(chain.String())] = finality
+	}
+
+	return finality
+}
+
+func (mgr *Mgr) getRPC(chain nexus.ChainName) (rpc.Client, bool) {
+	mgr.rpcsLock.RLock()
+	defer mgr.rpcsLock.RUnlock()
+
+	client, ok := mgr.rpcs[strings.ToLower(chain.String())]
+	return client, ok
+}
+
+// ProcessNewChain connects to the RPC endpoints configured for a new chain and notifies the operator whether vald is able to vote on it
+func (mgr *Mgr) ProcessNewChain(event *types.ChainAdded) (err error) {
+	if mgr.HasRPC(event.Chain) {
+		mgr.logger.Info(fmt.Sprintf("RPC connection for new chain %s is already established", event.Chain.String()))
+		return nil
+	}
+
+	if mgr.chainLoader != nil {
+		mgr.chainLoader(event.Chain)
+	}
+
+	if mgr.HasRPC(event.Chain) {
+		mgr.logger.Info(fmt.Sprintf("established RPC connection for new chain %s", event.Chain.String()))
+		return nil
+	}
+
+	mgr.logger.Info(fmt.Sprintf("no RPC connection for new chain %s, add the chain to the vald config to start voting on it", event.Chain.String()))
 	return nil
 }
 
 // ProcessDepositConfirmation votes on the correctness of an EVM chain token deposit
-func (mgr Mgr) ProcessDepositConfirmation(event *types.ConfirmDepositStarted) error {
+func (mgr *Mgr) ProcessDepositConfirmation(event *types.ConfirmDepositStarted) error {
 	if !slices.Any(event.Participants, func(v sdk.ValAddress) bool { return v.Equals(mgr.validator) }) {
 		mgr.logger.Debug("ignoring deposit confirmation poll: not a participant", "pollID", event.PollID)
 		return nil
 	}
 
-	txReceipt, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	txReceipt, servedBy, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger.Info(fmt.Sprintf("broadcasting empty vote for poll %s", event.PollID.String()))
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain)))
-
-		return err
+		return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, nil, servedBy)
 	}
 
 	var events []types.Event
@@ -119,27 +280,23 @@ func (mgr Mgr) ProcessDepositConfirmation(event *types.ConfirmDepositStarted) er
 	}
 
 	mgr.logger.Info(fmt.Sprintf("broadcasting vote %v for poll %s", events, event.PollID.String()))
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain, events...)))
-
-	return err
+	return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, txReceipt, servedBy, events...)
 }
 
 // ProcessTokenConfirmation votes on the correctness of an EVM chain token deployment
-func (mgr Mgr) ProcessTokenConfirmation(event *types.ConfirmTokenStarted) error {
+func (mgr *Mgr) ProcessTokenConfirmation(event *types.ConfirmTokenStarted) error {
 	if !slices.Any(event.Participants, func(v sdk.ValAddress) bool { return v.Equals(mgr.validator) }) {
 		mgr.logger.Debug("ignoring token confirmation poll: not a participant", "pollID", event.PollID)
 		return nil
 	}
 
-	txReceipt, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	txReceipt, servedBy, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger.Info(fmt.Sprintf("broadcasting empty vote for poll %s", event.PollID.String()))
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain)))
-
-		return err
+		return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, nil, servedBy)
 	}
 
 	var events []types.Event
@@ -179,27 +336,23 @@ func (mgr Mgr) ProcessTokenConfirmation(event *types.ConfirmTokenStarted) error
 	}
 
 	mgr.logger.Info(fmt.Sprintf("broadcasting vote %v for poll %s", events, event.PollID.String()))
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain, events...)))
-
-	return err
+	return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, txReceipt, servedBy, events...)
 }
 
 // ProcessTransferKeyConfirmation votes on the correctness of an EVM chain key transfer
-func (mgr Mgr) ProcessTransferKeyConfirmation(event *types.ConfirmKeyTransferStarted) error {
+func (mgr *Mgr) ProcessTransferKeyConfirmation(event *types.ConfirmKeyTransferStarted) error {
 	if !slices.Any(event.Participants, func(v sdk.ValAddress) bool { return v.Equals(mgr.validator) }) {
 		mgr.logger.Debug("ignoring key transfer confirmation poll: not a participant", "pollID", event.PollID)
 		return nil
 	}
 
-	txReceipt, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	txReceipt, servedBy, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger.Info(fmt.Sprintf("broadcasting empty vote for poll %s", event.PollID.String()))
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain)))
-
-		return err
+		return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, nil, servedBy)
 	}
 
 	var events []types.Event
@@ -237,27 +390,23 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(event *types.ConfirmKeyTransferSta
 	}
 
 	mgr.logger.Info(fmt.Sprintf("broadcasting vote %v for poll %s", events, event.PollID.String()))
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain, events...)))
-
-	return err
+	return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, txReceipt, servedBy, events...)
 }
 
 // ProcessGatewayTxConfirmation votes on the correctness of an EVM chain gateway's transactions
-func (mgr Mgr) ProcessGatewayTxConfirmation(event *types.ConfirmGatewayTxStarted) error {
+func (mgr *Mgr) ProcessGatewayTxConfirmation(event *types.ConfirmGatewayTxStarted) error {
 	if !slices.Any(event.Participants, func(v sdk.ValAddress) bool { return v.Equals(mgr.validator) }) {
 		mgr.logger.Debug("ignoring gateway tx confirmation poll: not a participant", "pollID", event.PollID)
 		return nil
 	}
 
-	txReceipt, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
+	txReceipt, servedBy, err := mgr.getTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
 		return err
 	}
 	if txReceipt == nil {
 		mgr.logger.Info(fmt.Sprintf("broadcasting empty vote for poll %s", event.PollID.String()))
-		_, err := mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain)))
-
-		return err
+		return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, nil, servedBy)
 	}
 
 	var events []types.Event
@@ -331,9 +480,34 @@ func (mgr Mgr) ProcessGatewayTxConfirmation(event *types.ConfirmGatewayTxStarted
 	}
 
 	mgr.logger.Info(fmt.Sprintf("broadcasting vote %v for poll %s", events, event.PollID.String()))
-	_, err = mgr.broadcaster.Broadcast(context.TODO(), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, event.PollID, types.NewVoteEvents(event.Chain, events...)))
+	return mgr.castVote(event.PollParticipants, event.Chain, event.TxID, txReceipt, servedBy, events...)
+}
+
+// castVote broadcasts the vote for the given poll and records it in the audit log.
+// The receipt is nil if the vote is empty because the tx could not be found or is not final yet.
+// Only the endpoints that served the receipt are recorded, with credentials redacted.
+func (mgr *Mgr) castVote(poll vote.PollParticipants, chain nexus.ChainName, txID types.Hash, receipt *geth.Receipt, servedBy []string, events ...types.Event) error {
+	_, err := mgr.broadcaster.Broadcast(broadcast.WithExpiry(context.TODO(), poll.ExpiresAt), voteTypes.NewVoteRequest(mgr.cliCtx.FromAddress, poll.PollID, types.NewVoteEvents(chain, events...)))
+	if err != nil {
+		return err
+	}
 
-	return err
+	record := audit.Vote{
+		PollID:       poll.PollID,
+		Chain:        chain,
+		TxID:         txID.Hex(),
+		Events:       events,
+		RPCEndpoints: servedBy,
+	}
+	if receipt != nil {
+		record.BlockHash = receipt.BlockHash.Hex()
+	}
+
+	if err := mgr.auditLog.RecordVote(record); err != nil {
+		mgr.logger.Error(sdkerrors.Wrapf(err, "failed to record vote for poll %s in the audit log", poll.PollID.String()).Error())
+	}
+
+	return nil
 }
 
 func decodeEventTokenSent(log *geth.Log) (types.EventTokenSent, error) {
@@ -434,76 +608,112 @@ func decodeEventContractCallWithToken(log *geth.Log) (types.EventContractCallWit
 	}, nil
 }
 
-func getLatestFinalizedBlockNumber(client rpc.Client, confHeight uint64) (*big.Int, error) {
-	switch client := client.(type) {
-	case rpc.MoonbeamClient:
-		finalizedBlockHash, err := client.ChainGetFinalizedHead(context.Background())
-		if err != nil {
-			return nil, err
-		}
-
-		header, err := client.ChainGetHeader(context.Background(), finalizedBlockHash)
-		if err != nil {
-			return nil, err
-		}
-
-		return header.Number.ToInt(), nil
-	case rpc.Eth2Client:
-		finalizedHeader, err := client.FinalizedHeader(context.Background())
-		if err != nil {
-			return nil, err
-		}
-
-		return finalizedHeader.Number, nil
-	case rpc.Client:
-		blockNumber, err := client.BlockNumber(context.Background())
-		if err != nil {
-			return nil, err
-		}
-
-		return big.NewInt(int64(blockNumber - confHeight + 1)), nil
-	default:
-		panic(fmt.Errorf("unsupported type of rpc client %T", client))
-	}
-}
-
-func (mgr Mgr) getTxReceiptIfFinalized(chain nexus.ChainName, txID common.Hash, confHeight uint64) (*geth.Receipt, error) {
-	client, ok := mgr.rpcs[strings.ToLower(chain.String())]
+// getTxReceiptIfFinalized returns the receipt of the given tx if it is final, and the redacted URLs of the endpoints that served it
+func (mgr *Mgr) getTxReceiptIfFinalized(chain nexus.ChainName, txID common.Hash, confHeight uint64) (*geth.Receipt, []string, error) {
+	client, ok := mgr.getRPC(chain)
 	if !ok {
-		return nil, fmt.Errorf("rpc client not found for chain %s", chain.String())
+		return nil, nil, fmt.Errorf("rpc client not found for chain %s", chain.String())
 	}
 
-	txReceipt, err := client.TransactionReceipt(context.Background(), txID)
+	finality := mgr.getFinalityStrategy(chain, client)
+	ctx, txReceipt, servedBy, err := mgr.getTxReceipt(chain, client, finality, txID)
 	if err == ethereum.NotFound {
 		mgr.logger.Debug(fmt.Sprintf("transaction receipt %s not found", txID.Hex()))
-		return nil, nil
+		return nil, servedBy, nil
 	}
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed getting transaction receipt")
+		return nil, nil, sdkerrors.Wrap(err, "failed getting transaction receipt")
 	}
 
-	latestFinalizedBlockNumber, err := getLatestFinalizedBlockNumber(client, confHeight)
+	start := time.Now()
+	isFinalized, err := finality.IsFinalized(ctx, client, txReceipt.BlockNumber, confHeight)
+	measureRPCLatency(chain, "finality", start)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed getting latest finalized block number")
+		return nil, nil, sdkerrors.Wrap(err, "failed checking block finality")
 	}
 
-	if latestFinalizedBlockNumber.Cmp(txReceipt.BlockNumber) < 0 {
+	if !isFinalized {
 		mgr.logger.Debug(fmt.Sprintf("transaction %s in block %s not finalized", txID.Hex(), txReceipt.BlockNumber.String()))
-		return nil, nil
+		return nil, servedBy, nil
 	}
 
+	// the receipt might have been returned for a block that got reorged out before it was finalized.
+	// Compare the hash reported by the endpoint, re-hashing the header locally does not work for chains with non-standard headers
+	start = time.Now()
+	blockHash, err := client.BlockHashByNumber(ctx, txReceipt.BlockNumber)
+	measureRPCLatency(chain, "eth_getBlockByNumber", start)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed getting block hash")
+	}
+
+	if blockHash != txReceipt.BlockHash {
+		mgr.logger.Info(fmt.Sprintf("transaction %s was reorged out of block %s, receipt block hash %s does not match canonical block hash %s",
+			txID.Hex(), txReceipt.BlockNumber.String(), txReceipt.BlockHash.Hex(), blockHash.Hex()))
+		return nil, servedBy, nil
+	}
+
+	start = time.Now()
 	block, err := client.BlockByNumber(context.Background(), txReceipt.BlockNumber)
+	measureRPCLatency(chain, "eth_getBlockByNumber", start)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed getting block")
+		return nil, nil, sdkerrors.Wrap(err, "failed getting block")
 	}
 
 	txFound := slices.Any(block.Body().Transactions, func(tx *geth.Transaction) bool { return bytes.Equal(tx.Hash().Bytes(), txReceipt.TxHash.Bytes()) })
 	if !txFound {
 		mgr.logger.Debug(fmt.Sprintf("transaction %s not found in block %s", txID.Hex(), txReceipt.BlockNumber.String()))
-		return nil, nil
+		return nil, servedBy, nil
+	}
+
+	return txReceipt, servedBy, nil
+}
+
+// getTxReceipt fetches the receipt of the given tx. If the client supports it, the data the finality strategy needs
+// is fetched in the same request and the returned context provides it.
+// It also returns the redacted URLs of the endpoints that served the receipt
+func (mgr *Mgr) getTxReceipt(chain nexus.ChainName, client rpc.Client, finality FinalityStrategy, txID common.Hash) (context.Context, *geth.Receipt, []string, error) {
+	ctx := context.Background()
+	receiptCtx, servedBy := rpc.WithServedBy(ctx)
+
+	if cached, ok := finality.(*CachedFinality); ok {
+		finality = cached.finality
 	}
 
-	return txReceipt, nil
+	start := time.Now()
+	switch finality.(type) {
+	case ConfirmationHeightFinality:
+		if client, ok := client.(rpc.ReceiptBlockNumberClient); ok {
+			txReceipt, blockNumber, err := client.ReceiptAndBlockNumber(receiptCtx, txID)
+			measureRPCLatency(chain, "batch", start)
+			if err != nil {
+				return ctx, nil, servedBy.URLs(), err
+			}
+
+			return withLatestBlockNumber(ctx, blockNumber), txReceipt, servedBy.URLs(), nil
+		}
+	case FinalizedTagFinality:
+		if client, ok := client.(rpc.ReceiptFinalizedHeaderClient); ok {
+			txReceipt, header, err := client.ReceiptAndFinalizedHeader(receiptCtx, txID)
+			measureRPCLatency(chain, "batch", start)
+			if err != nil {
+				return ctx, nil, servedBy.URLs(), err
+			}
+
+			return withFinalizedHeader(ctx, header), txReceipt, servedBy.URLs(), nil
+		}
+	}
+
+	txReceipt, err := client.TransactionReceipt(receiptCtx, txID)
+	measureRPCLatency(chain, "eth_getTransactionReceipt", start)
+
+	return ctx, txReceipt, servedBy.URLs(), err
+}
+
+func measureRPCLatency(chain nexus.ChainName, method string, start time.Time) {
+	metrics.MeasureSinceWithLabels([]string{"vald", "evm", "rpc", "latency"}, start, []metrics.Label{
+		telemetry.NewLabel("chain", chain.String()),
+		telemetry.NewLabel("method", method),
+	})
 }
 
 func decodeERC20TransferEvent(log *geth.Log) (types.EventTransfer, error) {
@@ -0,0 +1,325 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum"
+	geth "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+)
+
+// FinalityStrategy decides if a block of an EVM chain is final
+type FinalityStrategy interface {
+	// IsFinalized returns true if the block with the given number is final.
+	// The confirmation height is the number of blocks the chain is configured to wait for on axelar.
+	IsFinalized(ctx context.Context, client rpc.Client, blockNumber *big.Int, confHeight uint64) (bool, error)
+}
+
+// latestFinalizedStrategy is implemented by strategies that consider all blocks up to some block number final,
+// so the latest finalized block number can be shared between finality checks
+type latestFinalizedStrategy interface {
+	FinalityStrategy
+	latestFinalized(ctx context.Context, client rpc.Client, confHeight uint64) (*big.Int, error)
+}
+
+type latestBlockNumberKey struct{}
+
+// withLatestBlockNumber returns a context that provides the latest block number,
+// so it does not need to be queried again if it has already been fetched together with other data
+func withLatestBlockNumber(ctx context.Context, blockNumber uint64) context.Context {
+	return context.WithValue(ctx, latestBlockNumberKey{}, blockNumber)
+}
+
+type finalizedHeaderKey struct{}
+
+// withFinalizedHeader returns a context that provides the header of the latest finalized block,
+// so it does not need to be queried again if it has already been fetched together with other data
+func withFinalizedHeader(ctx context.Context, header *geth.Header) context.Context {
+	return context.WithValue(ctx, finalizedHeaderKey{}, header)
+}
+
+// ConfirmationHeightFinality considers a block final once it has been confirmed by the given number of blocks
+type ConfirmationHeightFinality struct{}
+
+// IsFinalized implements FinalityStrategy
+func (f ConfirmationHeightFinality) IsFinalized(ctx context.Context, client rpc.Client, blockNumber *big.Int, confHeight uint64) (bool, error) {
+	latestFinalized, err := f.latestFinalized(ctx, client, confHeight)
+	if err != nil {
+		return false, err
+	}
+
+	return latestFinalized.Cmp(blockNumber) >= 0, nil
+}
+
+func (ConfirmationHeightFinality) latestFinalized(ctx context.Context, client rpc.Client, confHeight uint64) (*big.Int, error) {
+	latest, ok := ctx.Value(latestBlockNumberKey{}).(uint64)
+	if !ok {
+		var err error
+		if latest, err = client.BlockNumber(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	latestFinalized := new(big.Int).SetUint64(latest)
+	latestFinalized.Sub(latestFinalized, new(big.Int).SetUint64(confHeight))
+	latestFinalized.Add(latestFinalized, big.NewInt(1))
+
+	return latestFinalized, nil
+}
+
+// FinalizedTagFinality considers a block final if it is not newer than the block with the "finalized" tag
+type FinalizedTagFinality struct{}
+
+// IsFinalized implements FinalityStrategy
+func (f FinalizedTagFinality) IsFinalized(ctx context.Context, client rpc.Client, blockNumber *big.Int, confHeight uint64) (bool, error) {
+	latestFinalized, err := f.latestFinalized(ctx, client, confHeight)
+	if err != nil {
+		return false, err
+	}
+
+	return latestFinalized.Cmp(blockNumber) >= 0, nil
+}
+
+func (FinalizedTagFinality) latestFinalized(ctx context.Context, client rpc.Client, _ uint64) (*big.Int, error) {
+	if header, ok := ctx.Value(finalizedHeaderKey{}).(*geth.Header); ok {
+		return header.Number, nil
+	}
+
+	eth2Client, ok := client.(rpc.Eth2Client)
+	if !ok {
+		return nil, fmt.Errorf("rpc client of type %T does not support the finalized tag", client)
+	}
+
+	header, err := eth2Client.FinalizedHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.Number, nil
+}
+
+// MoonbeamFinality considers a block final if it is not newer than moonbeam's finalized head
+type MoonbeamFinality struct{}
+
+// IsFinalized implements FinalityStrategy
+func (f MoonbeamFinality) IsFinalized(ctx context.Context, client rpc.Client, blockNumber *big.Int, confHeight uint64) (bool, error) {
+	latestFinalized, err := f.latestFinalized(ctx, client, confHeight)
+	if err != nil {
+		return false, err
+	}
+
+	return latestFinalized.Cmp(blockNumber) >= 0, nil
+}
+
+func (MoonbeamFinality) latestFinalized(ctx context.Context, client rpc.Client, _ uint64) (*big.Int, error) {
+	moonbeamClient, ok := client.(rpc.MoonbeamClient)
+	if !ok {
+		return nil, fmt.Errorf("rpc client of type %T does not support moonbeam finality", client)
+	}
+
+	finalizedBlockHash, err := moonbeamClient.ChainGetFinalizedHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := moonbeamClient.ChainGetHeader(ctx, finalizedBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.Number.ToInt(), nil
+}
+
+// RollupFinality considers a block of an L2 rollup final once the L1 block it originates from is final on the L1 chain.
+// The rollup's JSON-RPC reports the L1 origin of its blocks, the finality of that L1 block is checked against the configured L1 client.
+type RollupFinality struct {
+	l1         rpc.Client
+	l1Finality FinalityStrategy
+}
+
+// NewRollupFinality returns a new RollupFinality instance that checks the finality of L1 blocks with the given client and strategy
+func NewRollupFinality(l1 rpc.Client, l1Finality FinalityStrategy) RollupFinality {
+	return RollupFinality{l1: l1, l1Finality: l1Finality}
+}
+
+// IsFinalized implements FinalityStrategy
+func (f RollupFinality) IsFinalized(ctx context.Context, client rpc.Client, blockNumber *big.Int, _ uint64) (bool, error) {
+	rollupClient, ok := client.(rpc.RollupClient)
+	if !ok {
+		return false, fmt.Errorf("rpc client of type %T does not support rollup finality", client)
+	}
+
+	l1BlockNumber, err := rollupClient.L1BlockNumber(ctx, blockNumber)
+	switch {
+	case err == ethereum.NotFound:
+		return false, nil
+	case err != nil:
+		return false, sdkerrors.Wrapf(err, "failed getting the L1 block of rollup block %s", blockNumber.String())
+	}
+
+	// the confirmation height is the number of L2 blocks to wait for and has no meaning for the finality of L1 blocks
+	isFinalized, err := f.l1Finality.IsFinalized(ctx, f.l1, l1BlockNumber, 0)
+	if err != nil {
+		return false, sdkerrors.Wrapf(err, "failed checking the finality of L1 block %s", l1BlockNumber.String())
+	}
+
+	return isFinalized, nil
+}
+
+// DefaultFinalityStrategy returns the strategy for the most reliable finality RPC the given client supports
+func DefaultFinalityStrategy(client rpc.Client) FinalityStrategy {
+	switch client.(type) {
+	case rpc.MoonbeamClient:
+		return MoonbeamFinality{}
+	case rpc.Eth2Client:
+		return FinalizedTagFinality{}
+	default:
+		return ConfirmationHeightFinality{}
+	}
+}
+
+// LatestFinalizedBlock returns the number of the latest finalized block, or from-1 if the given block is not final yet.
+// Strategies that cannot determine the latest finalized block directly are searched between the given block and the latest block.
+func LatestFinalizedBlock(ctx context.Context, client rpc.Client, finality FinalityStrategy, confHeight uint64, from uint64) (int64, error) {
+	if cached, ok := finality.(*CachedFinality); ok {
+		finality = cached.finality
+	}
+
+	if finality, ok := finality.(latestFinalizedStrategy); ok {
+		latestFinalized, err := finality.latestFinalized(ctx, client, confHeight)
+		if err != nil {
+			return 0, err
+		}
+
+		return latestFinalized.Int64(), nil
+	}
+
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// finality is monotonic, so the latest finalized block can be found with a binary search
+	lo, hi := int64(from)-1, int64(latest)
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		isFinalized, err := finality.IsFinalized(ctx, client, big.NewInt(mid), confHeight)
+		if err != nil {
+			return 0, err
+		}
+
+		if isFinalized {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return lo, nil
+}
+
+// CachedFinality shares the latest finalized block number between concurrent finality checks of the same chain.
+// Only blocks that are known to be final are answered from the cache, blocks above the cached latest finalized block are always checked again.
+// Concurrent checks with the same confirmation height wait for a single query instead of sending the same query.
+// Strategies that cannot determine a latest finalized block number are not cached.
+type CachedFinality struct {
+	finality FinalityStrategy
+
+	lock     sync.Mutex
+	cache    map[uint64]*big.Int
+	inFlight map[uint64]*finalityQuery
+}
+
+// finalityQuery is a query of the latest finalized block that concurrent finality checks wait for
+type finalityQuery struct {
+	done            chan struct{}
+	latestFinalized *big.Int
+	err             error
+}
+
+// NewCachedFinality returns a new CachedFinality instance for the given strategy
+func NewCachedFinality(finality FinalityStrategy) *CachedFinality {
+	return &CachedFinality{
+		finality: finality,
+		cache:    make(map[uint64]*big.Int),
+		inFlight: make(map[uint64]*finalityQuery),
+	}
+}
+
+// IsFinalized implements FinalityStrategy
+func (f *CachedFinality) IsFinalized(ctx context.Context, client rpc.Client, blockNumber *big.Int, confHeight uint64) (bool, error) {
+	finality, ok := f.finality.(latestFinalizedStrategy)
+	if !ok {
+		return f.finality.IsFinalized(ctx, client, blockNumber, confHeight)
+	}
+
+	for {
+		f.lock.Lock()
+
+		// blocks cannot become non-final again, so a block at or below a known finalized block is final
+		if cached, ok := f.cache[confHeight]; ok && cached.Cmp(blockNumber) >= 0 {
+			f.lock.Unlock()
+			return true, nil
+		}
+
+		query, ok := f.inFlight[confHeight]
+		if !ok {
+			query = &finalityQuery{done: make(chan struct{})}
+			f.inFlight[confHeight] = query
+			f.lock.Unlock()
+
+			// the lock is not held while querying, so checks with other confirmation heights or of cached blocks are not blocked
+			latestFinalized, err := finality.latestFinalized(ctx, client, confHeight)
+			return f.finishQuery(query, confHeight, latestFinalized, err, blockNumber)
+		}
+
+		f.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-query.done:
+		}
+
+		// a query that failed because of the context of another check is not a result for this check
+		if errors.Is(query.err, context.Canceled) || errors.Is(query.err, context.DeadlineExceeded) {
+			continue
+		}
+
+		if query.err != nil {
+			return false, query.err
+		}
+
+		return query.latestFinalized.Cmp(blockNumber) >= 0, nil
+	}
+}
+
+// finishQuery updates the cache with the result of the given query and releases the checks waiting for it
+func (f *CachedFinality) finishQuery(query *finalityQuery, confHeight uint64, latestFinalized *big.Int, err error, blockNumber *big.Int) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	defer close(query.done)
+
+	delete(f.inFlight, confHeight)
+
+	if err != nil {
+		query.err = err
+		return false, err
+	}
+
+	// a lagging endpoint must not lower the cached latest finalized block
+	if cached, ok := f.cache[confHeight]; ok && cached.Cmp(latestFinalized) > 0 {
+		query.latestFinalized = cached
+	} else {
+		f.cache[confHeight] = latestFinalized
+		query.latestFinalized = latestFinalized
+	}
+
+	return query.latestFinalized.Cmp(blockNumber) >= 0, nil
+}
@@ -0,0 +1,371 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	evmRpc "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+)
+
+func TestConfirmationHeightFinality(t *testing.T) {
+	latest := uint64(rand.I64Between(1000, 10000))
+	confHeight := uint64(rand.I64Between(1, 100))
+	client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil }}
+	latestFinalized := int64(latest - confHeight + 1)
+
+	isFinalized, err := ConfirmationHeightFinality{}.IsFinalized(context.Background(), client, big.NewInt(latestFinalized), confHeight)
+	assert.NoError(t, err)
+	assert.True(t, isFinalized)
+
+	isFinalized, err = ConfirmationHeightFinality{}.IsFinalized(context.Background(), client, big.NewInt(latestFinalized+1), confHeight)
+	assert.NoError(t, err)
+	assert.False(t, isFinalized)
+
+	t.Run("should not be finalized if the chain is shorter than the confirmation height", func(t *testing.T) {
+		client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return 1, nil }}
+
+		isFinalized, err := ConfirmationHeightFinality{}.IsFinalized(context.Background(), client, big.NewInt(0), 10)
+		assert.NoError(t, err)
+		assert.False(t, isFinalized)
+	})
+
+	t.Run("should fail if the block number cannot be retrieved", func(t *testing.T) {
+		client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return 0, fmt.Errorf("error") }}
+
+		_, err := ConfirmationHeightFinality{}.IsFinalized(context.Background(), client, big.NewInt(0), confHeight)
+		assert.Error(t, err)
+	})
+}
+
+func TestFinalizedTagFinality(t *testing.T) {
+	finalized := rand.I64Between(1000, 10000)
+	client := &mock.Eth2ClientMock{FinalizedHeaderFunc: func(context.Context) (*geth.Header, error) {
+		return &geth.Header{Number: big.NewInt(finalized)}, nil
+	}}
+
+	isFinalized, err := FinalizedTagFinality{}.IsFinalized(context.Background(), client, big.NewInt(finalized), 0)
+	assert.NoError(t, err)
+	assert.True(t, isFinalized)
+
+	isFinalized, err = FinalizedTagFinality{}.IsFinalized(context.Background(), client, big.NewInt(finalized+1), 0)
+	assert.NoError(t, err)
+	assert.False(t, isFinalized)
+
+	_, err = FinalizedTagFinality{}.IsFinalized(context.Background(), &mock.ClientMock{}, big.NewInt(finalized), 0)
+	assert.Error(t, err)
+}
+
+func TestMoonbeamFinality(t *testing.T) {
+	finalizedHash := common.BytesToHash(rand.Bytes(common.HashLength))
+	finalized := rand.I64Between(1000, 10000)
+	client := &mock.MoonbeamClientMock{
+		ChainGetFinalizedHeadFunc: func(context.Context) (common.Hash, error) { return finalizedHash, nil },
+		ChainGetHeaderFunc: func(_ context.Context, hash common.Hash) (*evmRpc.MoonbeamHeader, error) {
+			if hash != finalizedHash {
+				return nil, fmt.Errorf("not found")
+			}
+
+			return &evmRpc.MoonbeamHeader{Number: (*hexutil.Big)(big.NewInt(finalized))}, nil
+		},
+	}
+
+	isFinalized, err := MoonbeamFinality{}.IsFinalized(context.Background(), client, big.NewInt(finalized), 0)
+	assert.NoError(t, err)
+	assert.True(t, isFinalized)
+
+	isFinalized, err = MoonbeamFinality{}.IsFinalized(context.Background(), client, big.NewInt(finalized+1), 0)
+	assert.NoError(t, err)
+	assert.False(t, isFinalized)
+
+	_, err = MoonbeamFinality{}.IsFinalized(context.Background(), &mock.Eth2ClientMock{}, big.NewInt(finalized), 0)
+	assert.Error(t, err)
+}
+
+// rollupClientMock is a rollup JSON-RPC client that reports the L1 origin of its blocks
+type rollupClientMock struct {
+	*mock.ClientMock
+	*mock.RollupClientMock
+}
+
+func TestRollupFinality(t *testing.T) {
+	l1Finalized := rand.I64Between(1000, 10000)
+	l2Block := big.NewInt(rand.PosI64())
+	l1 := &mock.Eth2ClientMock{FinalizedHeaderFunc: func(context.Context) (*geth.Header, error) {
+		return &geth.Header{Number: big.NewInt(l1Finalized)}, nil
+	}}
+	finality := NewRollupFinality(l1, FinalizedTagFinality{})
+
+	l1Origin := l1Finalized
+	client := rollupClientMock{
+		ClientMock: &mock.ClientMock{},
+		RollupClientMock: &mock.RollupClientMock{L1BlockNumberFunc: func(_ context.Context, number *big.Int) (*big.Int, error) {
+			if number.Cmp(l2Block) != 0 {
+				return nil, ethereum.NotFound
+			}
+
+			return big.NewInt(l1Origin), nil
+		}},
+	}
+
+	t.Run("should be finalized if the L1 block is final", func(t *testing.T) {
+		// the confirmation height of the rollup must not affect the finality of its L1 blocks
+		isFinalized, err := finality.IsFinalized(context.Background(), client, l2Block, uint64(rand.PosI64()))
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+		assert.Equal(t, l2Block, client.L1BlockNumberCalls()[0].Number)
+		assert.Len(t, l1.FinalizedHeaderCalls(), 1)
+	})
+
+	t.Run("should not be finalized if the L1 block is not final", func(t *testing.T) {
+		l1Origin = l1Finalized + 1
+		defer func() { l1Origin = l1Finalized }()
+
+		isFinalized, err := finality.IsFinalized(context.Background(), client, l2Block, 0)
+		assert.NoError(t, err)
+		assert.False(t, isFinalized)
+	})
+
+	t.Run("should not be finalized if the rollup block does not exist", func(t *testing.T) {
+		isFinalized, err := finality.IsFinalized(context.Background(), client, new(big.Int).Add(l2Block, big.NewInt(1)), 0)
+		assert.NoError(t, err)
+		assert.False(t, isFinalized)
+	})
+
+	t.Run("should fail if the L1 finality cannot be checked", func(t *testing.T) {
+		l1 := &mock.Eth2ClientMock{FinalizedHeaderFunc: func(context.Context) (*geth.Header, error) { return nil, fmt.Errorf("error") }}
+
+		_, err := NewRollupFinality(l1, FinalizedTagFinality{}).IsFinalized(context.Background(), client, l2Block, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the L1 block of the rollup block cannot be retrieved", func(t *testing.T) {
+		client := rollupClientMock{
+			ClientMock: &mock.ClientMock{},
+			RollupClientMock: &mock.RollupClientMock{L1BlockNumberFunc: func(context.Context, *big.Int) (*big.Int, error) {
+				return nil, fmt.Errorf("error")
+			}},
+		}
+
+		_, err := finality.IsFinalized(context.Background(), client, l2Block, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the rollup client does not report L1 blocks", func(t *testing.T) {
+		_, err := finality.IsFinalized(context.Background(), &mock.ClientMock{}, l2Block, 0)
+		assert.Error(t, err)
+	})
+}
+
+// searchOnlyFinality considers all blocks up to the given block number final, but cannot report that block number directly
+type searchOnlyFinality struct {
+	latestFinalized int64
+	calls           *int
+}
+
+func (f searchOnlyFinality) IsFinalized(_ context.Context, _ evmRpc.Client, blockNumber *big.Int, _ uint64) (bool, error) {
+	*f.calls++
+	return blockNumber.Int64() <= f.latestFinalized, nil
+}
+
+func TestDefaultFinalityStrategy(t *testing.T) {
+	assert.IsType(t, MoonbeamFinality{}, DefaultFinalityStrategy(&mock.MoonbeamClientMock{}))
+	assert.IsType(t, FinalizedTagFinality{}, DefaultFinalityStrategy(&mock.Eth2ClientMock{}))
+	assert.IsType(t, ConfirmationHeightFinality{}, DefaultFinalityStrategy(&mock.ClientMock{}))
+}
+
+func TestCachedFinality(t *testing.T) {
+	latest := uint64(rand.I64Between(1000, 10000))
+	confHeight := uint64(rand.I64Between(1, 100))
+	latestFinalized := int64(latest - confHeight + 1)
+
+	t.Run("should share the latest finalized block number between finality checks", func(t *testing.T) {
+		client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil }}
+		finality := NewCachedFinality(ConfirmationHeightFinality{})
+
+		for _, blockNumber := range []int64{latestFinalized, latestFinalized - 1, latestFinalized - 2} {
+			isFinalized, err := finality.IsFinalized(context.Background(), client, big.NewInt(blockNumber), confHeight)
+			assert.NoError(t, err)
+			assert.True(t, isFinalized)
+		}
+
+		assert.Len(t, client.BlockNumberCalls(), 1)
+	})
+
+	t.Run("should not cache that a block is not final", func(t *testing.T) {
+		latest := latest
+		client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil }}
+		finality := NewCachedFinality(ConfirmationHeightFinality{})
+
+		isFinalized, err := finality.IsFinalized(context.Background(), client, big.NewInt(latestFinalized+1), confHeight)
+		assert.NoError(t, err)
+		assert.False(t, isFinalized)
+
+		latest++
+		isFinalized, err = finality.IsFinalized(context.Background(), client, big.NewInt(latestFinalized+1), confHeight)
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+		assert.Len(t, client.BlockNumberCalls(), 2)
+
+		// blocks known to be final do not need another query
+		isFinalized, err = finality.IsFinalized(context.Background(), client, big.NewInt(latestFinalized), confHeight)
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+		assert.Len(t, client.BlockNumberCalls(), 2)
+	})
+
+	t.Run("should not lower the cached latest finalized block", func(t *testing.T) {
+		client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil }}
+		finality := NewCachedFinality(ConfirmationHeightFinality{})
+
+		isFinalized, err := finality.IsFinalized(context.Background(), client, big.NewInt(latestFinalized), confHeight)
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+
+		// a lagging endpoint must not make known final blocks look pending
+		isFinalized, err = finality.IsFinalized(withLatestBlockNumber(context.Background(), latest-10), client, big.NewInt(latestFinalized+1), confHeight)
+		assert.NoError(t, err)
+		assert.False(t, isFinalized)
+
+		isFinalized, err = finality.IsFinalized(context.Background(), client, big.NewInt(latestFinalized), confHeight)
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+		assert.Len(t, client.BlockNumberCalls(), 1)
+	})
+
+	t.Run("should let concurrent checks wait for a single query without blocking other checks", func(t *testing.T) {
+		release := make(chan struct{})
+		client := &mock.ClientMock{BlockNumberFunc: func(ctx context.Context) (uint64, error) {
+			<-release
+			return latest, nil
+		}}
+		finality := NewCachedFinality(ConfirmationHeightFinality{})
+
+		results := make(chan bool, 2)
+		check := func() {
+			isFinalized, err := finality.IsFinalized(context.Background(), client, big.NewInt(latestFinalized), confHeight)
+			assert.NoError(t, err)
+			results <- isFinalized
+		}
+
+		go check()
+		assert.Eventually(t, func() bool { return len(client.BlockNumberCalls()) == 1 }, time.Second, time.Millisecond)
+		go check()
+
+		// checks with another confirmation height or with a cancelled context do not wait for the pending query
+		isFinalized, err := finality.IsFinalized(withLatestBlockNumber(context.Background(), latest), client, big.NewInt(latestFinalized-1), confHeight+1)
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = finality.IsFinalized(ctx, client, big.NewInt(latestFinalized), confHeight)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		close(release)
+		assert.True(t, <-results)
+		assert.True(t, <-results)
+		assert.Len(t, client.BlockNumberCalls(), 1)
+	})
+
+	t.Run("should query again if the pending query failed because of the context of another check", func(t *testing.T) {
+		release := make(chan struct{})
+		client := &mock.ClientMock{BlockNumberFunc: func(ctx context.Context) (uint64, error) {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-release:
+				return latest, nil
+			}
+		}}
+		finality := NewCachedFinality(ConfirmationHeightFinality{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan error, 1)
+		go func() {
+			_, err := finality.IsFinalized(ctx, client, big.NewInt(latestFinalized), confHeight)
+			cancelled <- err
+		}()
+		assert.Eventually(t, func() bool { return len(client.BlockNumberCalls()) == 1 }, time.Second, time.Millisecond)
+
+		results := make(chan bool, 1)
+		go func() {
+			isFinalized, err := finality.IsFinalized(context.Background(), client, big.NewInt(latestFinalized), confHeight)
+			assert.NoError(t, err)
+			results <- isFinalized
+		}()
+
+		cancel()
+		assert.ErrorIs(t, <-cancelled, context.Canceled)
+		close(release)
+		assert.True(t, <-results)
+	})
+
+	t.Run("should use the latest block number from the context", func(t *testing.T) {
+		client := &mock.ClientMock{}
+		finality := NewCachedFinality(ConfirmationHeightFinality{})
+
+		isFinalized, err := finality.IsFinalized(withLatestBlockNumber(context.Background(), latest), client, big.NewInt(latestFinalized), confHeight)
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+	})
+
+	t.Run("should use the finalized header from the context", func(t *testing.T) {
+		client := &mock.Eth2ClientMock{}
+		finality := NewCachedFinality(FinalizedTagFinality{})
+
+		isFinalized, err := finality.IsFinalized(withFinalizedHeader(context.Background(), &geth.Header{Number: big.NewInt(latestFinalized)}), client, big.NewInt(latestFinalized), confHeight)
+		assert.NoError(t, err)
+		assert.True(t, isFinalized)
+		assert.Len(t, client.FinalizedHeaderCalls(), 0)
+	})
+
+	t.Run("should not cache strategies without a latest finalized block", func(t *testing.T) {
+		var calls int
+		finality := NewCachedFinality(searchOnlyFinality{latestFinalized: latestFinalized, calls: &calls})
+
+		_, err := finality.IsFinalized(context.Background(), &mock.ClientMock{}, big.NewInt(rand.PosI64()), confHeight)
+		assert.NoError(t, err)
+		_, err = finality.IsFinalized(context.Background(), &mock.ClientMock{}, big.NewInt(rand.PosI64()), confHeight)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestLatestFinalizedBlock(t *testing.T) {
+	latest := uint64(rand.I64Between(1000, 10000))
+	confHeight := uint64(rand.I64Between(1, 100))
+	latestFinalized := int64(latest - confHeight + 1)
+
+	t.Run("should get the latest finalized block from the strategy", func(t *testing.T) {
+		client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil }}
+
+		actual, err := LatestFinalizedBlock(context.Background(), client, NewCachedFinality(ConfirmationHeightFinality{}), confHeight, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, latestFinalized, actual)
+	})
+
+	t.Run("should search for the latest finalized block if the strategy cannot determine it", func(t *testing.T) {
+		client := &mock.ClientMock{BlockNumberFunc: func(context.Context) (uint64, error) { return latest, nil }}
+		var calls int
+		finality := searchOnlyFinality{latestFinalized: latestFinalized, calls: &calls}
+
+		actual, err := LatestFinalizedBlock(context.Background(), client, finality, confHeight, uint64(rand.I64Between(0, latestFinalized)))
+		assert.NoError(t, err)
+		assert.Equal(t, latestFinalized, actual)
+
+		actual, err = LatestFinalizedBlock(context.Background(), client, finality, confHeight, uint64(latestFinalized+1))
+		assert.NoError(t, err)
+		assert.Equal(t, latestFinalized, actual)
+	})
+}
@@ -0,0 +1,377 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// FailoverConfig determines when an endpoint is considered unhealthy
+type FailoverConfig struct {
+	// CallTimeout is the time after which a call to an endpoint is aborted and the next endpoint is tried
+	CallTimeout time.Duration
+	// MaxConsecutiveFailures is the number of failed calls in a row after which an endpoint gets ejected
+	MaxConsecutiveFailures int
+	// EjectionPeriod is the duration for which an ejected endpoint is skipped
+	EjectionPeriod time.Duration
+}
+
+// DefaultFailoverConfig returns a configuration populated with default values
+func DefaultFailoverConfig() FailoverConfig {
+	return FailoverConfig{
+		CallTimeout:            10 * time.Second,
+		MaxConsecutiveFailures: 3,
+		EjectionPeriod:         time.Minute,
+	}
+}
+
+// the weight of the latest call in the moving averages of latency and error rate
+const healthSmoothingFactor = 0.2
+
+// EndpointHealth contains the health statistics of an endpoint
+type EndpointHealth struct {
+	URL                 string
+	Latency             time.Duration // exponential moving average of successful call latencies
+	ErrorRate           float64       // exponential moving average of failed calls
+	ConsecutiveFailures int
+	EjectedUntil        time.Time
+}
+
+// IsEjected returns true if the endpoint is ejected at the given time
+func (h EndpointHealth) IsEjected(now time.Time) bool {
+	return now.Before(h.EjectedUntil)
+}
+
+// FailoverClient sends each call to the endpoint with the highest priority that is not ejected
+// and falls back to the next endpoint if the call fails or times out
+type FailoverClient struct {
+	chain     string
+	endpoints []Endpoint
+	config    FailoverConfig
+	logger    log.Logger
+
+	healthLock sync.RWMutex
+	health     []EndpointHealth
+}
+
+// FailoverEth2Client is a FailoverClient for endpoints that implement Eth2Client
+type FailoverEth2Client struct {
+	*FailoverClient
+}
+
+// FailoverMoonbeamClient is a FailoverClient for endpoints that implement MoonbeamClient
+type FailoverMoonbeamClient struct {
+	*FailoverClient
+}
+
+// NewFailoverClient returns a client that fails over between the given endpoints of the given chain, which are sorted by descending priority.
+// The returned client implements the same finality RPCs as the given endpoints, so all endpoints must be of the same kind.
+func NewFailoverClient(chain string, endpoints []Endpoint, config FailoverConfig, logger log.Logger) (Client, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints given")
+	}
+
+	if config.CallTimeout <= 0 || config.MaxConsecutiveFailures <= 0 || config.EjectionPeriod < 0 {
+		return nil, fmt.Errorf("invalid failover config")
+	}
+
+	health := make([]EndpointHealth, len(endpoints))
+	for i, endpoint := range endpoints {
+		health[i] = EndpointHealth{URL: endpoint.URL}
+	}
+
+	client := &FailoverClient{
+		chain:     chain,
+		endpoints: endpoints,
+		config:    config,
+		logger:    logger,
+		health:    health,
+	}
+
+	switch clientKind(endpoints) {
+	case moonbeamKind:
+		return &FailoverMoonbeamClient{client}, nil
+	case eth2Kind:
+		return &FailoverEth2Client{client}, nil
+	case ethKind:
+		return client, nil
+	default:
+		return nil, fmt.Errorf("all endpoints of a chain must support the same finality RPCs")
+	}
+}
+
+// Health returns the current health statistics of all endpoints
+func (c *FailoverClient) Health() []EndpointHealth {
+	c.healthLock.RLock()
+	defer c.healthLock.RUnlock()
+
+	return append([]EndpointHealth{}, c.health...)
+}
+
+// BlockNumber returns the most recent block number
+func (c *FailoverClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return callWithFailover(ctx, c, "eth_blockNumber",
+		func(ctx context.Context, client Client) (uint64, error) { return client.BlockNumber(ctx) },
+	)
+}
+
+// TransactionByHash returns the transaction with the given hash
+func (c *FailoverClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	type result struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+
+	res, err := callWithFailover(ctx, c, "eth_getTransactionByHash",
+		func(ctx context.Context, client Client) (result, error) {
+			tx, isPending, err := client.TransactionByHash(ctx, hash)
+			return result{tx: tx, isPending: isPending}, err
+		},
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.tx, res.isPending, nil
+}
+
+// TransactionReceipt returns the receipt of the given transaction
+func (c *FailoverClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return callWithFailover(ctx, c, "eth_getTransactionReceipt",
+		func(ctx context.Context, client Client) (*types.Receipt, error) {
+			return client.TransactionReceipt(ctx, txHash)
+		},
+	)
+}
+
+// BlockByNumber returns the block at the given height
+func (c *FailoverClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return callWithFailover(ctx, c, "eth_getBlockByNumber",
+		func(ctx context.Context, client Client) (*types.Block, error) {
+			return client.BlockByNumber(ctx, number)
+		},
+	)
+}
+
+// BlockHashByNumber returns the hash of the canonical block at the given height
+func (c *FailoverClient) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	return callWithFailover(ctx, c, "eth_getBlockByNumber",
+		func(ctx context.Context, client Client) (common.Hash, error) {
+			return client.BlockHashByNumber(ctx, number)
+		},
+	)
+}
+
+// L1BlockNumber returns the number of the L1 block the given L2 block originates from
+func (c *FailoverClient) L1BlockNumber(ctx context.Context, number *big.Int) (*big.Int, error) {
+	return callWithFailover(ctx, c, "eth_getBlockByNumber",
+		func(ctx context.Context, client Client) (*big.Int, error) {
+			return l1BlockNumber(ctx, client, number)
+		},
+	)
+}
+
+// FilterLogs returns the logs matching the given query
+func (c *FailoverClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return callWithFailover(ctx, c, "eth_getLogs",
+		func(ctx context.Context, client Client) ([]types.Log, error) {
+			return client.FilterLogs(ctx, q)
+		},
+	)
+}
+
+// Close closes the connections to all endpoints
+func (c *FailoverClient) Close() {
+	for _, endpoint := range c.endpoints {
+		endpoint.Client.Close()
+	}
+}
+
+// ReceiptAndBlockNumber returns the receipt of the given transaction and the latest block number of the same endpoint
+func (c *FailoverClient) ReceiptAndBlockNumber(ctx context.Context, txHash common.Hash) (*types.Receipt, uint64, error) {
+	type result struct {
+		receipt     *types.Receipt
+		blockNumber uint64
+	}
+
+	res, err := callWithFailover(ctx, c, "batch(eth_getTransactionReceipt, eth_blockNumber)",
+		func(ctx context.Context, client Client) (result, error) {
+			receipt, blockNumber, err := receiptAndBlockNumber(ctx, client, txHash)
+			return result{receipt: receipt, blockNumber: blockNumber}, err
+		},
+	)
+
+	return res.receipt, res.blockNumber, err
+}
+
+// ReceiptAndFinalizedHeader returns the receipt of the given transaction and the latest finalized header of the same endpoint
+func (c *FailoverEth2Client) ReceiptAndFinalizedHeader(ctx context.Context, txHash common.Hash) (*types.Receipt, *types.Header, error) {
+	type result struct {
+		receipt *types.Receipt
+		header  *types.Header
+	}
+
+	res, err := callWithFailover(ctx, c.FailoverClient, "batch(eth_getTransactionReceipt, eth_getBlockByNumber(finalized))",
+		func(ctx context.Context, client Client) (result, error) {
+			receipt, header, err := receiptAndFinalizedHeader(ctx, client.(Eth2Client), txHash)
+			return result{receipt: receipt, header: header}, err
+		},
+	)
+
+	return res.receipt, res.header, err
+}
+
+// FinalizedHeader returns the header of the most recent finalized block
+func (c *FailoverEth2Client) FinalizedHeader(ctx context.Context) (*types.Header, error) {
+	return callWithFailover(ctx, c.FailoverClient, "eth_getBlockByNumber(finalized)",
+		func(ctx context.Context, client Client) (*types.Header, error) {
+			return client.(Eth2Client).FinalizedHeader(ctx)
+		},
+	)
+}
+
+// ChainGetFinalizedHead returns the hash of the latest finalized block
+func (c *FailoverMoonbeamClient) ChainGetFinalizedHead(ctx context.Context) (common.Hash, error) {
+	return callWithFailover(ctx, c.FailoverClient, "chain_getFinalizedHead",
+		func(ctx context.Context, client Client) (common.Hash, error) {
+			return client.(MoonbeamClient).ChainGetFinalizedHead(ctx)
+		},
+	)
+}
+
+// ChainGetHeader returns the moonbeam block header of the given hash
+func (c *FailoverMoonbeamClient) ChainGetHeader(ctx context.Context, hash common.Hash) (*MoonbeamHeader, error) {
+	return callWithFailover(ctx, c.FailoverClient, "chain_getHeader",
+		func(ctx context.Context, client Client) (*MoonbeamHeader, error) {
+			return client.(MoonbeamClient).ChainGetHeader(ctx, hash)
+		},
+	)
+}
+
+// callOrder returns the endpoint indices to try, healthy endpoints by priority first followed by ejected ones,
+// so calls are still attempted if all endpoints are ejected
+func (c *FailoverClient) callOrder(now time.Time) []int {
+	c.healthLock.RLock()
+	defer c.healthLock.RUnlock()
+
+	var healthy, ejected []int
+	for i, health := range c.health {
+		if health.IsEjected(now) {
+			ejected = append(ejected, i)
+			continue
+		}
+
+		healthy = append(healthy, i)
+	}
+
+	return append(healthy, ejected...)
+}
+
+func (c *FailoverClient) recordSuccess(i int, latency time.Duration) {
+	c.healthLock.Lock()
+	defer c.healthLock.Unlock()
+
+	health := &c.health[i]
+	if health.Latency == 0 {
+		health.Latency = latency
+	} else {
+		health.Latency = time.Duration(healthSmoothingFactor*float64(latency) + (1-healthSmoothingFactor)*float64(health.Latency))
+	}
+	health.ErrorRate = (1 - healthSmoothingFactor) * health.ErrorRate
+	health.ConsecutiveFailures = 0
+
+	if !health.EjectedUntil.IsZero() {
+		c.logger.Info(fmt.Sprintf("EVM JSON-RPC endpoint %s recovered", RedactURL(health.URL)))
+	}
+	health.EjectedUntil = time.Time{}
+
+	c.reportHealth(*health, time.Now())
+}
+
+func (c *FailoverClient) recordFailure(i int, now time.Time, err error) {
+	c.healthLock.Lock()
+	defer c.healthLock.Unlock()
+
+	health := &c.health[i]
+	health.ErrorRate = healthSmoothingFactor + (1-healthSmoothingFactor)*health.ErrorRate
+	health.ConsecutiveFailures++
+
+	if health.ConsecutiveFailures >= c.config.MaxConsecutiveFailures && !health.IsEjected(now) {
+		health.EjectedUntil = now.Add(c.config.EjectionPeriod)
+		c.logger.Error(fmt.Sprintf("ejecting EVM JSON-RPC endpoint %s for %s", RedactURL(health.URL), c.config.EjectionPeriod),
+			"consecutive_failures", health.ConsecutiveFailures,
+			"error_rate", health.ErrorRate,
+			"error", err.Error(),
+		)
+	}
+
+	c.reportHealth(*health, now)
+}
+
+// reportHealth exposes the health statistics of an endpoint as metrics
+func (c *FailoverClient) reportHealth(health EndpointHealth, now time.Time) {
+	labels := []metrics.Label{
+		telemetry.NewLabel("chain", c.chain),
+		telemetry.NewLabel("endpoint", RedactURL(health.URL)),
+	}
+
+	ejected := float32(0)
+	if health.IsEjected(now) {
+		ejected = 1
+	}
+
+	telemetry.SetGaugeWithLabels([]string{"vald", "evm", "rpc", "endpoint", "latency_ms"}, float32(health.Latency.Milliseconds()), labels)
+	telemetry.SetGaugeWithLabels([]string{"vald", "evm", "rpc", "endpoint", "error_rate"}, float32(health.ErrorRate), labels)
+	telemetry.SetGaugeWithLabels([]string{"vald", "evm", "rpc", "endpoint", "ejected"}, ejected, labels)
+}
+
+// RedactURL returns the scheme and host of the given URL only, because paths, query strings and user info often contain API keys
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "<redacted>"
+	}
+
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+}
+
+// callWithFailover tries the endpoints in order until one returns a result.
+// ethereum.NotFound counts as a result, so it is returned without trying other endpoints.
+func callWithFailover[T any](ctx context.Context, c *FailoverClient, method string, query func(ctx context.Context, client Client) (T, error)) (T, error) {
+	var zero T
+	var err error
+
+	for _, i := range c.callOrder(time.Now()) {
+		if ctx.Err() != nil {
+			return zero, ctx.Err()
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, c.config.CallTimeout)
+		start := time.Now()
+		var result T
+		result, err = query(withoutServedBy(callCtx), c.endpoints[i].Client)
+		cancel()
+
+		if err == nil || err == ethereum.NotFound {
+			c.recordSuccess(i, time.Since(start))
+			RecordServedBy(ctx, c.endpoints[i].URL)
+			return result, err
+		}
+
+		c.recordFailure(i, time.Now(), err)
+		c.logger.Debug(sdkerrors.Wrapf(err, "call %s to EVM JSON-RPC endpoint %s failed", method, RedactURL(c.endpoints[i].URL)).Error())
+	}
+
+	return zero, sdkerrors.Wrapf(err, "call %s failed on all %d endpoints", method, len(c.endpoints))
+}
@@ -5,32 +5,40 @@ package mock
 
 import (
 	"context"
-	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	evmrpc "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	ethereumrpc "github.com/ethereum/go-ethereum/rpc"
 	"math/big"
 	"sync"
 )
 
-// Ensure, that ClientMock does implement rpc.Client.
+// Ensure, that ClientMock does implement evmrpc.Client.
 // If this is not the case, regenerate this file with moq.
-var _ rpc.Client = &ClientMock{}
+var _ evmrpc.Client = &ClientMock{}
 
-// ClientMock is a mock implementation of rpc.Client.
+// ClientMock is a mock implementation of evmrpc.Client.
 //
 // 	func TestSomethingThatUsesClient(t *testing.T) {
 //
-// 		// make and configure a mocked rpc.Client
+// 		// make and configure a mocked evmrpc.Client
 // 		mockedClient := &ClientMock{
 // 			BlockByNumberFunc: func(ctx context.Context, number *big.Int) (*types.Block, error) {
 // 				panic("mock out the BlockByNumber method")
 // 			},
+// 			BlockHashByNumberFunc: func(ctx context.Context, number *big.Int) (common.Hash, error) {
+// 				panic("mock out the BlockHashByNumber method")
+// 			},
 // 			BlockNumberFunc: func(ctx context.Context) (uint64, error) {
 // 				panic("mock out the BlockNumber method")
 // 			},
 // 			CloseFunc: func()  {
 // 				panic("mock out the Close method")
 // 			},
+// 			FilterLogsFunc: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+// 				panic("mock out the FilterLogs method")
+// 			},
 // 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 // 				panic("mock out the TransactionByHash method")
 // 			},
@@ -39,7 +47,7 @@ var _ rpc.Client = &ClientMock{}
 // 			},
 // 		}
 //
-// 		// use mockedClient in code that requires rpc.Client
+// 		// use mockedClient in code that requires evmrpc.Client
 // 		// and then make assertions.
 //
 // 	}
@@ -47,12 +55,18 @@ type ClientMock struct {
 	// BlockByNumberFunc mocks the BlockByNumber method.
 	BlockByNumberFunc func(ctx context.Context, number *big.Int) (*types.Block, error)
 
+	// BlockHashByNumberFunc mocks the BlockHashByNumber method.
+	BlockHashByNumberFunc func(ctx context.Context, number *big.Int) (common.Hash, error)
+
 	// BlockNumberFunc mocks the BlockNumber method.
 	BlockNumberFunc func(ctx context.Context) (uint64, error)
 
 	// CloseFunc mocks the Close method.
 	CloseFunc func()
 
+	// FilterLogsFunc mocks the FilterLogs method.
+	FilterLogsFunc func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+
 	// TransactionByHashFunc mocks the TransactionByHash method.
 	TransactionByHashFunc func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 
@@ -68,6 +82,13 @@ type ClientMock struct {
 			// Number is the number argument value.
 			Number *big.Int
 		}
+		// BlockHashByNumber holds details about calls to the BlockHashByNumber method.
+		BlockHashByNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
 		// BlockNumber holds details about calls to the BlockNumber method.
 		BlockNumber []struct {
 			// Ctx is the ctx argument value.
@@ -76,6 +97,13 @@ type ClientMock struct {
 		// Close holds details about calls to the Close method.
 		Close []struct {
 		}
+		// FilterLogs holds details about calls to the FilterLogs method.
+		FilterLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Q is the q argument value.
+			Q ethereum.FilterQuery
+		}
 		// TransactionByHash holds details about calls to the TransactionByHash method.
 		TransactionByHash []struct {
 			// Ctx is the ctx argument value.
@@ -92,8 +120,10 @@ type ClientMock struct {
 		}
 	}
 	lockBlockByNumber      sync.RWMutex
+	lockBlockHashByNumber  sync.RWMutex
 	lockBlockNumber        sync.RWMutex
 	lockClose              sync.RWMutex
+	lockFilterLogs         sync.RWMutex
 	lockTransactionByHash  sync.RWMutex
 	lockTransactionReceipt sync.RWMutex
 }
@@ -133,6 +163,41 @@ func (mock *ClientMock) BlockByNumberCalls() []struct {
 	return calls
 }
 
+// BlockHashByNumber calls BlockHashByNumberFunc.
+func (mock *ClientMock) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	if mock.BlockHashByNumberFunc == nil {
+		panic("ClientMock.BlockHashByNumberFunc: method is nil but Client.BlockHashByNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockBlockHashByNumber.Lock()
+	mock.calls.BlockHashByNumber = append(mock.calls.BlockHashByNumber, callInfo)
+	mock.lockBlockHashByNumber.Unlock()
+	return mock.BlockHashByNumberFunc(ctx, number)
+}
+
+// BlockHashByNumberCalls gets all the calls that were made to BlockHashByNumber.
+// Check the length with:
+//     len(mockedClient.BlockHashByNumberCalls())
+func (mock *ClientMock) BlockHashByNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockBlockHashByNumber.RLock()
+	calls = mock.calls.BlockHashByNumber
+	mock.lockBlockHashByNumber.RUnlock()
+	return calls
+}
+
 // BlockNumber calls BlockNumberFunc.
 func (mock *ClientMock) BlockNumber(ctx context.Context) (uint64, error) {
 	if mock.BlockNumberFunc == nil {
@@ -190,6 +255,41 @@ func (mock *ClientMock) CloseCalls() []struct {
 	return calls
 }
 
+// FilterLogs calls FilterLogsFunc.
+func (mock *ClientMock) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if mock.FilterLogsFunc == nil {
+		panic("ClientMock.FilterLogsFunc: method is nil but Client.FilterLogs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}{
+		Ctx: ctx,
+		Q:   q,
+	}
+	mock.lockFilterLogs.Lock()
+	mock.calls.FilterLogs = append(mock.calls.FilterLogs, callInfo)
+	mock.lockFilterLogs.Unlock()
+	return mock.FilterLogsFunc(ctx, q)
+}
+
+// FilterLogsCalls gets all the calls that were made to FilterLogs.
+// Check the length with:
+//     len(mockedClient.FilterLogsCalls())
+func (mock *ClientMock) FilterLogsCalls() []struct {
+	Ctx context.Context
+	Q   ethereum.FilterQuery
+} {
+	var calls []struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}
+	mock.lockFilterLogs.RLock()
+	calls = mock.calls.FilterLogs
+	mock.lockFilterLogs.RUnlock()
+	return calls
+}
+
 // TransactionByHash calls TransactionByHashFunc.
 func (mock *ClientMock) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 	if mock.TransactionByHashFunc == nil {
@@ -260,31 +360,37 @@ func (mock *ClientMock) TransactionReceiptCalls() []struct {
 	return calls
 }
 
-// Ensure, that MoonbeamClientMock does implement rpc.MoonbeamClient.
+// Ensure, that MoonbeamClientMock does implement evmrpc.MoonbeamClient.
 // If this is not the case, regenerate this file with moq.
-var _ rpc.MoonbeamClient = &MoonbeamClientMock{}
+var _ evmrpc.MoonbeamClient = &MoonbeamClientMock{}
 
-// MoonbeamClientMock is a mock implementation of rpc.MoonbeamClient.
+// MoonbeamClientMock is a mock implementation of evmrpc.MoonbeamClient.
 //
 // 	func TestSomethingThatUsesMoonbeamClient(t *testing.T) {
 //
-// 		// make and configure a mocked rpc.MoonbeamClient
+// 		// make and configure a mocked evmrpc.MoonbeamClient
 // 		mockedMoonbeamClient := &MoonbeamClientMock{
 // 			BlockByNumberFunc: func(ctx context.Context, number *big.Int) (*types.Block, error) {
 // 				panic("mock out the BlockByNumber method")
 // 			},
+// 			BlockHashByNumberFunc: func(ctx context.Context, number *big.Int) (common.Hash, error) {
+// 				panic("mock out the BlockHashByNumber method")
+// 			},
 // 			BlockNumberFunc: func(ctx context.Context) (uint64, error) {
 // 				panic("mock out the BlockNumber method")
 // 			},
 // 			ChainGetFinalizedHeadFunc: func(ctx context.Context) (common.Hash, error) {
 // 				panic("mock out the ChainGetFinalizedHead method")
 // 			},
-// 			ChainGetHeaderFunc: func(ctx context.Context, hash common.Hash) (*rpc.MoonbeamHeader, error) {
+// 			ChainGetHeaderFunc: func(ctx context.Context, hash common.Hash) (*evmrpc.MoonbeamHeader, error) {
 // 				panic("mock out the ChainGetHeader method")
 // 			},
 // 			CloseFunc: func()  {
 // 				panic("mock out the Close method")
 // 			},
+// 			FilterLogsFunc: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+// 				panic("mock out the FilterLogs method")
+// 			},
 // 			TransactionByHashFunc: func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 // 				panic("mock out the TransactionByHash method")
 // 			},
@@ -293,7 +399,7 @@ var _ rpc.MoonbeamClient = &MoonbeamClientMock{}
 // 			},
 // 		}
 //
-// 		// use mockedMoonbeamClient in code that requires rpc.MoonbeamClient
+// 		// use mockedMoonbeamClient in code that requires evmrpc.MoonbeamClient
 // 		// and then make assertions.
 //
 // 	}
@@ -301,6 +407,9 @@ type MoonbeamClientMock struct {
 	// BlockByNumberFunc mocks the BlockByNumber method.
 	BlockByNumberFunc func(ctx context.Context, number *big.Int) (*types.Block, error)
 
+	// BlockHashByNumberFunc mocks the BlockHashByNumber method.
+	BlockHashByNumberFunc func(ctx context.Context, number *big.Int) (common.Hash, error)
+
 	// BlockNumberFunc mocks the BlockNumber method.
 	BlockNumberFunc func(ctx context.Context) (uint64, error)
 
@@ -308,11 +417,14 @@ type MoonbeamClientMock struct {
 	ChainGetFinalizedHeadFunc func(ctx context.Context) (common.Hash, error)
 
 	// ChainGetHeaderFunc mocks the ChainGetHeader method.
-	ChainGetHeaderFunc func(ctx context.Context, hash common.Hash) (*rpc.MoonbeamHeader, error)
+	ChainGetHeaderFunc func(ctx context.Context, hash common.Hash) (*evmrpc.MoonbeamHeader, error)
 
 	// CloseFunc mocks the Close method.
 	CloseFunc func()
 
+	// FilterLogsFunc mocks the FilterLogs method.
+	FilterLogsFunc func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+
 	// TransactionByHashFunc mocks the TransactionByHash method.
 	TransactionByHashFunc func(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 
@@ -328,6 +440,13 @@ type MoonbeamClientMock struct {
 			// Number is the number argument value.
 			Number *big.Int
 		}
+		// BlockHashByNumber holds details about calls to the BlockHashByNumber method.
+		BlockHashByNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
 		// BlockNumber holds details about calls to the BlockNumber method.
 		BlockNumber []struct {
 			// Ctx is the ctx argument value.
@@ -348,6 +467,13 @@ type MoonbeamClientMock struct {
 		// Close holds details about calls to the Close method.
 		Close []struct {
 		}
+		// FilterLogs holds details about calls to the FilterLogs method.
+		FilterLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Q is the q argument value.
+			Q ethereum.FilterQuery
+		}
 		// TransactionByHash holds details about calls to the TransactionByHash method.
 		TransactionByHash []struct {
 			// Ctx is the ctx argument value.
@@ -364,10 +490,12 @@ type MoonbeamClientMock struct {
 		}
 	}
 	lockBlockByNumber         sync.RWMutex
+	lockBlockHashByNumber     sync.RWMutex
 	lockBlockNumber           sync.RWMutex
 	lockChainGetFinalizedHead sync.RWMutex
 	lockChainGetHeader        sync.RWMutex
 	lockClose                 sync.RWMutex
+	lockFilterLogs            sync.RWMutex
 	lockTransactionByHash     sync.RWMutex
 	lockTransactionReceipt    sync.RWMutex
 }
@@ -407,6 +535,41 @@ func (mock *MoonbeamClientMock) BlockByNumberCalls() []struct {
 	return calls
 }
 
+// BlockHashByNumber calls BlockHashByNumberFunc.
+func (mock *MoonbeamClientMock) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	if mock.BlockHashByNumberFunc == nil {
+		panic("MoonbeamClientMock.BlockHashByNumberFunc: method is nil but MoonbeamClient.BlockHashByNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockBlockHashByNumber.Lock()
+	mock.calls.BlockHashByNumber = append(mock.calls.BlockHashByNumber, callInfo)
+	mock.lockBlockHashByNumber.Unlock()
+	return mock.BlockHashByNumberFunc(ctx, number)
+}
+
+// BlockHashByNumberCalls gets all the calls that were made to BlockHashByNumber.
+// Check the length with:
+//     len(mockedMoonbeamClient.BlockHashByNumberCalls())
+func (mock *MoonbeamClientMock) BlockHashByNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockBlockHashByNumber.RLock()
+	calls = mock.calls.BlockHashByNumber
+	mock.lockBlockHashByNumber.RUnlock()
+	return calls
+}
+
 // BlockNumber calls BlockNumberFunc.
 func (mock *MoonbeamClientMock) BlockNumber(ctx context.Context) (uint64, error) {
 	if mock.BlockNumberFunc == nil {
@@ -470,7 +633,7 @@ func (mock *MoonbeamClientMock) ChainGetFinalizedHeadCalls() []struct {
 }
 
 // ChainGetHeader calls ChainGetHeaderFunc.
-func (mock *MoonbeamClientMock) ChainGetHeader(ctx context.Context, hash common.Hash) (*rpc.MoonbeamHeader, error) {
+func (mock *MoonbeamClientMock) ChainGetHeader(ctx context.Context, hash common.Hash) (*evmrpc.MoonbeamHeader, error) {
 	if mock.ChainGetHeaderFunc == nil {
 		panic("MoonbeamClientMock.ChainGetHeaderFunc: method is nil but MoonbeamClient.ChainGetHeader was just called")
 	}
@@ -530,6 +693,41 @@ func (mock *MoonbeamClientMock) CloseCalls() []struct {
 	return calls
 }
 
+// FilterLogs calls FilterLogsFunc.
+func (mock *MoonbeamClientMock) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if mock.FilterLogsFunc == nil {
+		panic("MoonbeamClientMock.FilterLogsFunc: method is nil but MoonbeamClient.FilterLogs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}{
+		Ctx: ctx,
+		Q:   q,
+	}
+	mock.lockFilterLogs.Lock()
+	mock.calls.FilterLogs = append(mock.calls.FilterLogs, callInfo)
+	mock.lockFilterLogs.Unlock()
+	return mock.FilterLogsFunc(ctx, q)
+}
+
+// FilterLogsCalls gets all the calls that were made to FilterLogs.
+// Check the length with:
+//     len(mockedMoonbeamClient.FilterLogsCalls())
+func (mock *MoonbeamClientMock) FilterLogsCalls() []struct {
+	Ctx context.Context
+	Q   ethereum.FilterQuery
+} {
+	var calls []struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}
+	mock.lockFilterLogs.RLock()
+	calls = mock.calls.FilterLogs
+	mock.lockFilterLogs.RUnlock()
+	return calls
+}
+
 // TransactionByHash calls TransactionByHashFunc.
 func (mock *MoonbeamClientMock) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
 	if mock.TransactionByHashFunc == nil {
@@ -600,25 +798,31 @@ func (mock *MoonbeamClientMock) TransactionReceiptCalls() []struct {
 	return calls
 }
 
-// Ensure, that Eth2ClientMock does implement rpc.Eth2Client.
+// Ensure, that Eth2ClientMock does implement evmrpc.Eth2Client.
 // If this is not the case, regenerate this file with moq.
-var _ rpc.Eth2Client = &Eth2ClientMock{}
+var _ evmrpc.Eth2Client = &Eth2ClientMock{}
 
-// Eth2ClientMock is a mock implementation of rpc.Eth2Client.
+// Eth2ClientMock is a mock implementation of evmrpc.Eth2Client.
 //
 // 	func TestSomethingThatUsesEth2Client(t *testing.T) {
 //
-// 		// make and configure a mocked rpc.Eth2Client
+// 		// make and configure a mocked evmrpc.Eth2Client
 // 		mockedEth2Client := &Eth2ClientMock{
 // 			BlockByNumberFunc: func(ctx context.Context, number *big.Int) (*types.Block, error) {
 // 				panic("mock out the BlockByNumber method")
 // 			},
+// 			BlockHashByNumberFunc: func(ctx context.Context, number *big.Int) (common.Hash, error) {
+// 				panic("mock out the BlockHashByNumber method")
+// 			},
 // 			BlockNumberFunc: func(ctx context.Context) (uint64, error) {
 // 				panic("mock out the BlockNumber method")
 // 			},
 // 			CloseFunc: func()  {
 // 				panic("mock out the Close method")
 // 			},
+// 			FilterLogsFunc: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+// 				panic("mock out the FilterLogs method")
+// 			},
 // 			FinalizedHeaderFunc: func(ctx context.Context) (*types.Header, error) {
 // 				panic("mock out the FinalizedHeader method")
 // 			},
@@ -630,7 +834,7 @@ var _ rpc.Eth2Client = &Eth2ClientMock{}
 // 			},
 // 		}
 //
-// 		// use mockedEth2Client in code that requires rpc.Eth2Client
+// 		// use mockedEth2Client in code that requires evmrpc.Eth2Client
 // 		// and then make assertions.
 //
 // 	}
@@ -638,12 +842,18 @@ type Eth2ClientMock struct {
 	// BlockByNumberFunc mocks the BlockByNumber method.
 	BlockByNumberFunc func(ctx context.Context, number *big.Int) (*types.Block, error)
 
+	// BlockHashByNumberFunc mocks the BlockHashByNumber method.
+	BlockHashByNumberFunc func(ctx context.Context, number *big.Int) (common.Hash, error)
+
 	// BlockNumberFunc mocks the BlockNumber method.
 	BlockNumberFunc func(ctx context.Context) (uint64, error)
 
 	// CloseFunc mocks the Close method.
 	CloseFunc func()
 
+	// FilterLogsFunc mocks the FilterLogs method.
+	FilterLogsFunc func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+
 	// FinalizedHeaderFunc mocks the FinalizedHeader method.
 	FinalizedHeaderFunc func(ctx context.Context) (*types.Header, error)
 
@@ -662,6 +872,13 @@ type Eth2ClientMock struct {
 			// Number is the number argument value.
 			Number *big.Int
 		}
+		// BlockHashByNumber holds details about calls to the BlockHashByNumber method.
+		BlockHashByNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
 		// BlockNumber holds details about calls to the BlockNumber method.
 		BlockNumber []struct {
 			// Ctx is the ctx argument value.
@@ -670,6 +887,13 @@ type Eth2ClientMock struct {
 		// Close holds details about calls to the Close method.
 		Close []struct {
 		}
+		// FilterLogs holds details about calls to the FilterLogs method.
+		FilterLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Q is the q argument value.
+			Q ethereum.FilterQuery
+		}
 		// FinalizedHeader holds details about calls to the FinalizedHeader method.
 		FinalizedHeader []struct {
 			// Ctx is the ctx argument value.
@@ -691,8 +915,10 @@ type Eth2ClientMock struct {
 		}
 	}
 	lockBlockByNumber      sync.RWMutex
+	lockBlockHashByNumber  sync.RWMutex
 	lockBlockNumber        sync.RWMutex
 	lockClose              sync.RWMutex
+	lockFilterLogs         sync.RWMutex
 	lockFinalizedHeader    sync.RWMutex
 	lockTransactionByHash  sync.RWMutex
 	lockTransactionReceipt sync.RWMutex
@@ -733,6 +959,41 @@ func (mock *Eth2ClientMock) BlockByNumberCalls() []struct {
 	return calls
 }
 
+// BlockHashByNumber calls BlockHashByNumberFunc.
+func (mock *Eth2ClientMock) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	if mock.BlockHashByNumberFunc == nil {
+		panic("Eth2ClientMock.BlockHashByNumberFunc: method is nil but Eth2Client.BlockHashByNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockBlockHashByNumber.Lock()
+	mock.calls.BlockHashByNumber = append(mock.calls.BlockHashByNumber, callInfo)
+	mock.lockBlockHashByNumber.Unlock()
+	return mock.BlockHashByNumberFunc(ctx, number)
+}
+
+// BlockHashByNumberCalls gets all the calls that were made to BlockHashByNumber.
+// Check the length with:
+//     len(mockedEth2Client.BlockHashByNumberCalls())
+func (mock *Eth2ClientMock) BlockHashByNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockBlockHashByNumber.RLock()
+	calls = mock.calls.BlockHashByNumber
+	mock.lockBlockHashByNumber.RUnlock()
+	return calls
+}
+
 // BlockNumber calls BlockNumberFunc.
 func (mock *Eth2ClientMock) BlockNumber(ctx context.Context) (uint64, error) {
 	if mock.BlockNumberFunc == nil {
@@ -790,6 +1051,41 @@ func (mock *Eth2ClientMock) CloseCalls() []struct {
 	return calls
 }
 
+// FilterLogs calls FilterLogsFunc.
+func (mock *Eth2ClientMock) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if mock.FilterLogsFunc == nil {
+		panic("Eth2ClientMock.FilterLogsFunc: method is nil but Eth2Client.FilterLogs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}{
+		Ctx: ctx,
+		Q:   q,
+	}
+	mock.lockFilterLogs.Lock()
+	mock.calls.FilterLogs = append(mock.calls.FilterLogs, callInfo)
+	mock.lockFilterLogs.Unlock()
+	return mock.FilterLogsFunc(ctx, q)
+}
+
+// FilterLogsCalls gets all the calls that were made to FilterLogs.
+// Check the length with:
+//     len(mockedEth2Client.FilterLogsCalls())
+func (mock *Eth2ClientMock) FilterLogsCalls() []struct {
+	Ctx context.Context
+	Q   ethereum.FilterQuery
+} {
+	var calls []struct {
+		Ctx context.Context
+		Q   ethereum.FilterQuery
+	}
+	mock.lockFilterLogs.RLock()
+	calls = mock.calls.FilterLogs
+	mock.lockFilterLogs.RUnlock()
+	return calls
+}
+
 // FinalizedHeader calls FinalizedHeaderFunc.
 func (mock *Eth2ClientMock) FinalizedHeader(ctx context.Context) (*types.Header, error) {
 	if mock.FinalizedHeaderFunc == nil {
@@ -890,3 +1186,287 @@ func (mock *Eth2ClientMock) TransactionReceiptCalls() []struct {
 	mock.lockTransactionReceipt.RUnlock()
 	return calls
 }
+
+// Ensure, that BatchClientMock does implement evmrpc.BatchClient.
+// If this is not the case, regenerate this file with moq.
+var _ evmrpc.BatchClient = &BatchClientMock{}
+
+// BatchClientMock is a mock implementation of evmrpc.BatchClient.
+//
+// 	func TestSomethingThatUsesBatchClient(t *testing.T) {
+//
+// 		// make and configure a mocked evmrpc.BatchClient
+// 		mockedBatchClient := &BatchClientMock{
+// 			BatchCallContextFunc: func(ctx context.Context, b []ethereumrpc.BatchElem) error {
+// 				panic("mock out the BatchCallContext method")
+// 			},
+// 		}
+//
+// 		// use mockedBatchClient in code that requires evmrpc.BatchClient
+// 		// and then make assertions.
+//
+// 	}
+type BatchClientMock struct {
+	// BatchCallContextFunc mocks the BatchCallContext method.
+	BatchCallContextFunc func(ctx context.Context, b []ethereumrpc.BatchElem) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// BatchCallContext holds details about calls to the BatchCallContext method.
+		BatchCallContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// B is the b argument value.
+			B []ethereumrpc.BatchElem
+		}
+	}
+	lockBatchCallContext sync.RWMutex
+}
+
+// BatchCallContext calls BatchCallContextFunc.
+func (mock *BatchClientMock) BatchCallContext(ctx context.Context, b []ethereumrpc.BatchElem) error {
+	if mock.BatchCallContextFunc == nil {
+		panic("BatchClientMock.BatchCallContextFunc: method is nil but BatchClient.BatchCallContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		B   []ethereumrpc.BatchElem
+	}{
+		Ctx: ctx,
+		B:   b,
+	}
+	mock.lockBatchCallContext.Lock()
+	mock.calls.BatchCallContext = append(mock.calls.BatchCallContext, callInfo)
+	mock.lockBatchCallContext.Unlock()
+	return mock.BatchCallContextFunc(ctx, b)
+}
+
+// BatchCallContextCalls gets all the calls that were made to BatchCallContext.
+// Check the length with:
+//     len(mockedBatchClient.BatchCallContextCalls())
+func (mock *BatchClientMock) BatchCallContextCalls() []struct {
+	Ctx context.Context
+	B   []ethereumrpc.BatchElem
+} {
+	var calls []struct {
+		Ctx context.Context
+		B   []ethereumrpc.BatchElem
+	}
+	mock.lockBatchCallContext.RLock()
+	calls = mock.calls.BatchCallContext
+	mock.lockBatchCallContext.RUnlock()
+	return calls
+}
+
+// Ensure, that ReceiptBlockNumberClientMock does implement evmrpc.ReceiptBlockNumberClient.
+// If this is not the case, regenerate this file with moq.
+var _ evmrpc.ReceiptBlockNumberClient = &ReceiptBlockNumberClientMock{}
+
+// ReceiptBlockNumberClientMock is a mock implementation of evmrpc.ReceiptBlockNumberClient.
+//
+// 	func TestSomethingThatUsesReceiptBlockNumberClient(t *testing.T) {
+//
+// 		// make and configure a mocked evmrpc.ReceiptBlockNumberClient
+// 		mockedReceiptBlockNumberClient := &ReceiptBlockNumberClientMock{
+// 			ReceiptAndBlockNumberFunc: func(ctx context.Context, txHash common.Hash) (*types.Receipt, uint64, error) {
+// 				panic("mock out the ReceiptAndBlockNumber method")
+// 			},
+// 		}
+//
+// 		// use mockedReceiptBlockNumberClient in code that requires evmrpc.ReceiptBlockNumberClient
+// 		// and then make assertions.
+//
+// 	}
+type ReceiptBlockNumberClientMock struct {
+	// ReceiptAndBlockNumberFunc mocks the ReceiptAndBlockNumber method.
+	ReceiptAndBlockNumberFunc func(ctx context.Context, txHash common.Hash) (*types.Receipt, uint64, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ReceiptAndBlockNumber holds details about calls to the ReceiptAndBlockNumber method.
+		ReceiptAndBlockNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash common.Hash
+		}
+	}
+	lockReceiptAndBlockNumber sync.RWMutex
+}
+
+// ReceiptAndBlockNumber calls ReceiptAndBlockNumberFunc.
+func (mock *ReceiptBlockNumberClientMock) ReceiptAndBlockNumber(ctx context.Context, txHash common.Hash) (*types.Receipt, uint64, error) {
+	if mock.ReceiptAndBlockNumberFunc == nil {
+		panic("ReceiptBlockNumberClientMock.ReceiptAndBlockNumberFunc: method is nil but ReceiptBlockNumberClient.ReceiptAndBlockNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockReceiptAndBlockNumber.Lock()
+	mock.calls.ReceiptAndBlockNumber = append(mock.calls.ReceiptAndBlockNumber, callInfo)
+	mock.lockReceiptAndBlockNumber.Unlock()
+	return mock.ReceiptAndBlockNumberFunc(ctx, txHash)
+}
+
+// ReceiptAndBlockNumberCalls gets all the calls that were made to ReceiptAndBlockNumber.
+// Check the length with:
+//     len(mockedReceiptBlockNumberClient.ReceiptAndBlockNumberCalls())
+func (mock *ReceiptBlockNumberClientMock) ReceiptAndBlockNumberCalls() []struct {
+	Ctx    context.Context
+	TxHash common.Hash
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}
+	mock.lockReceiptAndBlockNumber.RLock()
+	calls = mock.calls.ReceiptAndBlockNumber
+	mock.lockReceiptAndBlockNumber.RUnlock()
+	return calls
+}
+
+// Ensure, that ReceiptFinalizedHeaderClientMock does implement evmrpc.ReceiptFinalizedHeaderClient.
+// If this is not the case, regenerate this file with moq.
+var _ evmrpc.ReceiptFinalizedHeaderClient = &ReceiptFinalizedHeaderClientMock{}
+
+// ReceiptFinalizedHeaderClientMock is a mock implementation of evmrpc.ReceiptFinalizedHeaderClient.
+//
+// 	func TestSomethingThatUsesReceiptFinalizedHeaderClient(t *testing.T) {
+//
+// 		// make and configure a mocked evmrpc.ReceiptFinalizedHeaderClient
+// 		mockedReceiptFinalizedHeaderClient := &ReceiptFinalizedHeaderClientMock{
+// 			ReceiptAndFinalizedHeaderFunc: func(ctx context.Context, txHash common.Hash) (*types.Receipt, *types.Header, error) {
+// 				panic("mock out the ReceiptAndFinalizedHeader method")
+// 			},
+// 		}
+//
+// 		// use mockedReceiptFinalizedHeaderClient in code that requires evmrpc.ReceiptFinalizedHeaderClient
+// 		// and then make assertions.
+//
+// 	}
+type ReceiptFinalizedHeaderClientMock struct {
+	// ReceiptAndFinalizedHeaderFunc mocks the ReceiptAndFinalizedHeader method.
+	ReceiptAndFinalizedHeaderFunc func(ctx context.Context, txHash common.Hash) (*types.Receipt, *types.Header, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ReceiptAndFinalizedHeader holds details about calls to the ReceiptAndFinalizedHeader method.
+		ReceiptAndFinalizedHeader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash common.Hash
+		}
+	}
+	lockReceiptAndFinalizedHeader sync.RWMutex
+}
+
+// ReceiptAndFinalizedHeader calls ReceiptAndFinalizedHeaderFunc.
+func (mock *ReceiptFinalizedHeaderClientMock) ReceiptAndFinalizedHeader(ctx context.Context, txHash common.Hash) (*types.Receipt, *types.Header, error) {
+	if mock.ReceiptAndFinalizedHeaderFunc == nil {
+		panic("ReceiptFinalizedHeaderClientMock.ReceiptAndFinalizedHeaderFunc: method is nil but ReceiptFinalizedHeaderClient.ReceiptAndFinalizedHeader was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockReceiptAndFinalizedHeader.Lock()
+	mock.calls.ReceiptAndFinalizedHeader = append(mock.calls.ReceiptAndFinalizedHeader, callInfo)
+	mock.lockReceiptAndFinalizedHeader.Unlock()
+	return mock.ReceiptAndFinalizedHeaderFunc(ctx, txHash)
+}
+
+// ReceiptAndFinalizedHeaderCalls gets all the calls that were made to ReceiptAndFinalizedHeader.
+// Check the length with:
+//     len(mockedReceiptFinalizedHeaderClient.ReceiptAndFinalizedHeaderCalls())
+func (mock *ReceiptFinalizedHeaderClientMock) ReceiptAndFinalizedHeaderCalls() []struct {
+	Ctx    context.Context
+	TxHash common.Hash
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash common.Hash
+	}
+	mock.lockReceiptAndFinalizedHeader.RLock()
+	calls = mock.calls.ReceiptAndFinalizedHeader
+	mock.lockReceiptAndFinalizedHeader.RUnlock()
+	return calls
+}
+
+// Ensure, that RollupClientMock does implement evmrpc.RollupClient.
+// If this is not the case, regenerate this file with moq.
+var _ evmrpc.RollupClient = &RollupClientMock{}
+
+// RollupClientMock is a mock implementation of evmrpc.RollupClient.
+//
+// 	func TestSomethingThatUsesRollupClient(t *testing.T) {
+//
+// 		// make and configure a mocked evmrpc.RollupClient
+// 		mockedRollupClient := &RollupClientMock{
+// 			L1BlockNumberFunc: func(ctx context.Context, number *big.Int) (*big.Int, error) {
+// 				panic("mock out the L1BlockNumber method")
+// 			},
+// 		}
+//
+// 		// use mockedRollupClient in code that requires evmrpc.RollupClient
+// 		// and then make assertions.
+//
+// 	}
+type RollupClientMock struct {
+	// L1BlockNumberFunc mocks the L1BlockNumber method.
+	L1BlockNumberFunc func(ctx context.Context, number *big.Int) (*big.Int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// L1BlockNumber holds details about calls to the L1BlockNumber method.
+		L1BlockNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Number is the number argument value.
+			Number *big.Int
+		}
+	}
+	lockL1BlockNumber sync.RWMutex
+}
+
+// L1BlockNumber calls L1BlockNumberFunc.
+func (mock *RollupClientMock) L1BlockNumber(ctx context.Context, number *big.Int) (*big.Int, error) {
+	if mock.L1BlockNumberFunc == nil {
+		panic("RollupClientMock.L1BlockNumberFunc: method is nil but RollupClient.L1BlockNumber was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Number *big.Int
+	}{
+		Ctx:    ctx,
+		Number: number,
+	}
+	mock.lockL1BlockNumber.Lock()
+	mock.calls.L1BlockNumber = append(mock.calls.L1BlockNumber, callInfo)
+	mock.lockL1BlockNumber.Unlock()
+	return mock.L1BlockNumberFunc(ctx, number)
+}
+
+// L1BlockNumberCalls gets all the calls that were made to L1BlockNumber.
+// Check the length with:
+//     len(mockedRollupClient.L1BlockNumberCalls())
+func (mock *RollupClientMock) L1BlockNumberCalls() []struct {
+	Ctx    context.Context
+	Number *big.Int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Number *big.Int
+	}
+	mock.lockL1BlockNumber.RLock()
+	calls = mock.calls.L1BlockNumber
+	mock.lockL1BlockNumber.RUnlock()
+	return calls
+}
@@ -0,0 +1,453 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+const notFoundResult = "not found"
+
+// Endpoint is a JSON-RPC client together with the URL it is connected to
+type Endpoint struct {
+	URL    string
+	Client Client
+}
+
+// QuorumClient queries multiple endpoints of the same chain in parallel and only returns a result if a quorum of them agrees on it
+type QuorumClient struct {
+	endpoints []Endpoint
+	quorum    int
+	logger    log.Logger
+}
+
+// QuorumEth2Client is a QuorumClient for endpoints that implement Eth2Client
+type QuorumEth2Client struct {
+	*QuorumClient
+}
+
+// QuorumMoonbeamClient is a QuorumClient for endpoints that implement MoonbeamClient
+type QuorumMoonbeamClient struct {
+	*QuorumClient
+}
+
+// NewQuorumClient returns a client that requires the given quorum of endpoints to agree on every result.
+// The returned client implements the same finality RPCs as the given endpoints, so all endpoints must be of the same kind.
+func NewQuorumClient(endpoints []Endpoint, quorum int, logger log.Logger) (Client, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints given")
+	}
+
+	if quorum <= 0 || quorum > len(endpoints) {
+		return nil, fmt.Errorf("quorum must be between 1 and the number of endpoints %d, got %d", len(endpoints), quorum)
+	}
+
+	client := &QuorumClient{
+		endpoints: endpoints,
+		quorum:    quorum,
+		logger:    logger,
+	}
+
+	switch clientKind(endpoints) {
+	case moonbeamKind:
+		return &QuorumMoonbeamClient{client}, nil
+	case eth2Kind:
+		return &QuorumEth2Client{client}, nil
+	case ethKind:
+		return client, nil
+	default:
+		return nil, fmt.Errorf("all endpoints of a chain must support the same finality RPCs")
+	}
+}
+
+// BlockNumber returns the highest block number that a quorum of endpoints has reached
+func (c *QuorumClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return queryQuorumHighest(ctx, c, "eth_blockNumber",
+		func(ctx context.Context, client Client) (uint64, error) { return client.BlockNumber(ctx) },
+		func(number uint64) uint64 { return number },
+	)
+}
+
+// TransactionByHash returns the transaction with the given hash agreed on by a quorum of endpoints
+func (c *QuorumClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	type result struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+
+	res, err := queryQuorum(ctx, c, "eth_getTransactionByHash",
+		func(ctx context.Context, client Client) (result, error) {
+			tx, isPending, err := client.TransactionByHash(ctx, hash)
+			return result{tx: tx, isPending: isPending}, err
+		},
+		func(res result) string { return fmt.Sprintf("%s-%t", res.tx.Hash().Hex(), res.isPending) },
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.tx, res.isPending, nil
+}
+
+// TransactionReceipt returns the receipt of the given transaction agreed on by a quorum of endpoints
+func (c *QuorumClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return queryQuorum(ctx, c, "eth_getTransactionReceipt",
+		func(ctx context.Context, client Client) (*types.Receipt, error) {
+			return client.TransactionReceipt(ctx, txHash)
+		},
+		receiptKey,
+	)
+}
+
+// BlockByNumber returns the block at the given height agreed on by a quorum of endpoints
+func (c *QuorumClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return queryQuorum(ctx, c, "eth_getBlockByNumber",
+		func(ctx context.Context, client Client) (*types.Block, error) {
+			return client.BlockByNumber(ctx, number)
+		},
+		func(block *types.Block) string { return block.Hash().Hex() },
+	)
+}
+
+// BlockHashByNumber returns the hash of the canonical block at the given height agreed on by a quorum of endpoints
+func (c *QuorumClient) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	return queryQuorum(ctx, c, "eth_getBlockByNumber",
+		func(ctx context.Context, client Client) (common.Hash, error) {
+			return client.BlockHashByNumber(ctx, number)
+		},
+		func(hash common.Hash) string { return hash.Hex() },
+	)
+}
+
+// L1BlockNumber returns the number of the L1 block the given L2 block originates from agreed on by a quorum of endpoints
+func (c *QuorumClient) L1BlockNumber(ctx context.Context, number *big.Int) (*big.Int, error) {
+	return queryQuorum(ctx, c, "eth_getBlockByNumber",
+		func(ctx context.Context, client Client) (*big.Int, error) {
+			return l1BlockNumber(ctx, client, number)
+		},
+		func(l1Number *big.Int) string { return l1Number.String() },
+	)
+}
+
+// FilterLogs returns the logs matching the given query agreed on by a quorum of endpoints
+func (c *QuorumClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return queryQuorum(ctx, c, "eth_getLogs",
+		func(ctx context.Context, client Client) ([]types.Log, error) {
+			return client.FilterLogs(ctx, q)
+		},
+		logsKey,
+	)
+}
+
+// Close closes the connections to all endpoints
+func (c *QuorumClient) Close() {
+	for _, endpoint := range c.endpoints {
+		endpoint.Client.Close()
+	}
+}
+
+// ReceiptAndBlockNumber returns the receipt of the given transaction agreed on by a quorum of endpoints,
+// and the lowest latest block number reported by the endpoints of that quorum, so the whole quorum has reached it
+func (c *QuorumClient) ReceiptAndBlockNumber(ctx context.Context, txHash common.Hash) (*types.Receipt, uint64, error) {
+	type result struct {
+		receipt     *types.Receipt
+		blockNumber uint64
+	}
+
+	agreed, err := queryQuorumAgreed(ctx, c, "batch(eth_getTransactionReceipt, eth_blockNumber)",
+		func(ctx context.Context, client Client) (result, error) {
+			receipt, blockNumber, err := receiptAndBlockNumber(ctx, client, txHash)
+			return result{receipt: receipt, blockNumber: blockNumber}, err
+		},
+		func(res result) string { return receiptKey(res.receipt) },
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	lowest := agreed[0]
+	for _, res := range agreed[1:] {
+		if res.blockNumber < lowest.blockNumber {
+			lowest = res
+		}
+	}
+
+	return lowest.receipt, lowest.blockNumber, nil
+}
+
+// ReceiptAndFinalizedHeader returns the receipt of the given transaction agreed on by a quorum of endpoints,
+// and the lowest finalized header reported by the endpoints of that quorum, so the whole quorum has finalized it
+func (c *QuorumEth2Client) ReceiptAndFinalizedHeader(ctx context.Context, txHash common.Hash) (*types.Receipt, *types.Header, error) {
+	type result struct {
+		receipt *types.Receipt
+		header  *types.Header
+	}
+
+	agreed, err := queryQuorumAgreed(ctx, c.QuorumClient, "batch(eth_getTransactionReceipt, eth_getBlockByNumber(finalized))",
+		func(ctx context.Context, client Client) (result, error) {
+			receipt, header, err := receiptAndFinalizedHeader(ctx, client.(Eth2Client), txHash)
+			return result{receipt: receipt, header: header}, err
+		},
+		func(res result) string { return receiptKey(res.receipt) },
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lowest := agreed[0]
+	for _, res := range agreed[1:] {
+		if res.header.Number.Cmp(lowest.header.Number) < 0 {
+			lowest = res
+		}
+	}
+
+	return lowest.receipt, lowest.header, nil
+}
+
+// FinalizedHeader returns the header of the highest finalized block that a quorum of endpoints has reached
+func (c *QuorumEth2Client) FinalizedHeader(ctx context.Context) (*types.Header, error) {
+	return queryQuorumHighest(ctx, c.QuorumClient, "eth_getBlockByNumber(finalized)",
+		func(ctx context.Context, client Client) (*types.Header, error) {
+			return client.(Eth2Client).FinalizedHeader(ctx)
+		},
+		func(header *types.Header) uint64 { return header.Number.Uint64() },
+	)
+}
+
+// ChainGetFinalizedHead returns the hash of the latest finalized block agreed on by a quorum of endpoints
+func (c *QuorumMoonbeamClient) ChainGetFinalizedHead(ctx context.Context) (common.Hash, error) {
+	return queryQuorum(ctx, c.QuorumClient, "chain_getFinalizedHead",
+		func(ctx context.Context, client Client) (common.Hash, error) {
+			return client.(MoonbeamClient).ChainGetFinalizedHead(ctx)
+		},
+		func(hash common.Hash) string { return hash.Hex() },
+	)
+}
+
+// ChainGetHeader returns the moonbeam block header of the given hash agreed on by a quorum of endpoints
+func (c *QuorumMoonbeamClient) ChainGetHeader(ctx context.Context, hash common.Hash) (*MoonbeamHeader, error) {
+	return queryQuorum(ctx, c.QuorumClient, "chain_getHeader",
+		func(ctx context.Context, client Client) (*MoonbeamHeader, error) {
+			return client.(MoonbeamClient).ChainGetHeader(ctx, hash)
+		},
+		func(header *MoonbeamHeader) string {
+			bz, _ := json.Marshal(header)
+			return crypto.Keccak256Hash(bz).Hex()
+		},
+	)
+}
+
+// receiptKey only takes the fields into account that are relevant for voting,
+// so endpoints that differ in irrelevant or optional fields (e.g. gas usage) still agree
+func receiptKey(receipt *types.Receipt) string {
+	type log struct {
+		Address common.Address
+		Topics  []common.Hash
+		Data    []byte
+	}
+
+	logs := make([]log, len(receipt.Logs))
+	for i, l := range receipt.Logs {
+		logs[i] = log{Address: l.Address, Topics: l.Topics, Data: l.Data}
+	}
+
+	bz, _ := json.Marshal(struct {
+		TxHash      common.Hash
+		BlockHash   common.Hash
+		BlockNumber *big.Int
+		Status      uint64
+		Logs        []log
+	}{
+		TxHash:      receipt.TxHash,
+		BlockHash:   receipt.BlockHash,
+		BlockNumber: receipt.BlockNumber,
+		Status:      receipt.Status,
+		Logs:        logs,
+	})
+
+	return crypto.Keccak256Hash(bz).Hex()
+}
+
+// logsKey only takes the fields into account that identify the logs and their content
+func logsKey(logs []types.Log) string {
+	type log struct {
+		TxHash    common.Hash
+		BlockHash common.Hash
+		Index     uint
+		Address   common.Address
+		Topics    []common.Hash
+		Data      []byte
+	}
+
+	keys := make([]log, len(logs))
+	for i, l := range logs {
+		keys[i] = log{TxHash: l.TxHash, BlockHash: l.BlockHash, Index: l.Index, Address: l.Address, Topics: l.Topics, Data: l.Data}
+	}
+
+	bz, _ := json.Marshal(keys)
+	return crypto.Keccak256Hash(bz).Hex()
+}
+
+type quorumResponse[T any] struct {
+	url    string
+	result T
+	err    error
+}
+
+// queryQuorum sends the query to all endpoints in parallel and returns as soon as a quorum of endpoints returned the same result.
+// ethereum.NotFound counts as a result, so a quorum can agree that something does not exist.
+func queryQuorum[T any](ctx context.Context, c *QuorumClient, method string, query func(ctx context.Context, client Client) (T, error), key func(T) string) (T, error) {
+	agreed, err := queryQuorumAgreed(ctx, c, method, query, key)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return agreed[len(agreed)-1], nil
+}
+
+// queryQuorumAgreed works like queryQuorum, but returns the results of all endpoints of the quorum,
+// so fields that are not part of the key can be combined by the caller
+func queryQuorumAgreed[T any](ctx context.Context, c *QuorumClient, method string, query func(ctx context.Context, client Client) (T, error), key func(T) string) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so late responses do not block after the quorum has been reached
+	responses := make(chan quorumResponse[T], len(c.endpoints))
+	for _, endpoint := range c.endpoints {
+		go func(endpoint Endpoint) {
+			result, err := query(withoutServedBy(ctx), endpoint.Client)
+			responses <- quorumResponse[T]{url: endpoint.URL, result: result, err: err}
+		}(endpoint)
+	}
+
+	votes := make(map[string][]quorumResponse[T])
+	var failed []string
+
+	for received := 1; received <= len(c.endpoints); received++ {
+		response := <-responses
+
+		var k string
+		switch {
+		case response.err == ethereum.NotFound:
+			k = notFoundResult
+		case response.err != nil:
+			failed = append(failed, fmt.Sprintf("%s: %s", response.url, response.err.Error()))
+		default:
+			k = key(response.result)
+		}
+
+		if response.err == nil || response.err == ethereum.NotFound {
+			votes[k] = append(votes[k], response)
+
+			if len(votes[k]) >= c.quorum {
+				reportDisagreement(c.logger, method, votes, failed)
+				for _, agreed := range votes[k] {
+					RecordServedBy(ctx, agreed.url)
+				}
+
+				if k == notFoundResult {
+					return nil, ethereum.NotFound
+				}
+				return slices.Map(votes[k], func(response quorumResponse[T]) T { return response.result }), nil
+			}
+		}
+
+		if !quorumReachable(votes, len(c.endpoints)-received, c.quorum) {
+			break
+		}
+	}
+
+	reportDisagreement(c.logger, method, votes, failed)
+	return nil, fmt.Errorf("no quorum of %d out of %d endpoints reached for %s (errors: [%s])", c.quorum, len(c.endpoints), method, strings.Join(failed, ", "))
+}
+
+// queryQuorumHighest sends the query to all endpoints in parallel and returns the result with the highest number that at least a quorum of endpoints has reached,
+// i.e. the lowest result of the first quorum of endpoints to respond. Unlike queryQuorum it does not require exact agreement, so endpoints that lag behind by a few blocks still count towards the quorum.
+// Queries of the remaining endpoints are cancelled as soon as a quorum has responded, so slow endpoints do not hold up the result.
+func queryQuorumHighest[T any](ctx context.Context, c *QuorumClient, method string, query func(ctx context.Context, client Client) (T, error), number func(T) uint64) (T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so late responses do not block after the quorum has been reached
+	responses := make(chan quorumResponse[T], len(c.endpoints))
+	for _, endpoint := range c.endpoints {
+		go func(endpoint Endpoint) {
+			result, err := query(withoutServedBy(ctx), endpoint.Client)
+			responses <- quorumResponse[T]{url: endpoint.URL, result: result, err: err}
+		}(endpoint)
+	}
+
+	var results []T
+	var failed []string
+	for received := 1; received <= len(c.endpoints) && len(results) < c.quorum; received++ {
+		response := <-responses
+		if response.err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", response.url, response.err.Error()))
+
+			// the quorum cannot be reached anymore
+			if len(failed) > len(c.endpoints)-c.quorum {
+				break
+			}
+			continue
+		}
+
+		results = append(results, response.result)
+	}
+
+	if len(failed) > 0 {
+		c.logger.Error(fmt.Sprintf("EVM JSON-RPC endpoints failed on %s", method), "errors", strings.Join(failed, "; "))
+	}
+
+	if len(results) < c.quorum {
+		var zero T
+		return zero, fmt.Errorf("no quorum of %d out of %d endpoints reached for %s (errors: [%s])", c.quorum, len(c.endpoints), method, strings.Join(failed, ", "))
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return number(results[i]) > number(results[j]) })
+	return results[c.quorum-1], nil
+}
+
+func quorumReachable[T any](votes map[string][]quorumResponse[T], pending int, quorum int) bool {
+	for _, responses := range votes {
+		if len(responses)+pending >= quorum {
+			return true
+		}
+	}
+
+	return pending >= quorum
+}
+
+// reportDisagreement logs which endpoints returned which result if they did not all agree
+func reportDisagreement[T any](logger log.Logger, method string, votes map[string][]quorumResponse[T], failed []string) {
+	if len(votes) <= 1 && len(failed) == 0 {
+		return
+	}
+
+	var results []string
+	for k, responses := range votes {
+		var urls []string
+		for _, response := range responses {
+			urls = append(urls, response.url)
+		}
+
+		results = append(results, fmt.Sprintf("%s returned by [%s]", k, strings.Join(urls, ", ")))
+	}
+	sort.Strings(results)
+
+	logger.Error(fmt.Sprintf("EVM JSON-RPC endpoints disagree on %s", method),
+		"results", strings.Join(results, "; "),
+		"errors", strings.Join(failed, "; "),
+	)
+}
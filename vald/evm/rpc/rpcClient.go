@@ -2,16 +2,19 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	evmClient "github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
-//go:generate moq -out ./mock/rpcClient.go -pkg mock . Client MoonbeamClient Eth2Client
+//go:generate moq -out ./mock/rpcClient.go -pkg mock . Client MoonbeamClient Eth2Client BatchClient ReceiptBlockNumberClient ReceiptFinalizedHeaderClient RollupClient
 
 // Client provides calls to EVM JSON-RPC endpoints
 type Client interface {
@@ -19,9 +22,114 @@ type Client interface {
 	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
 	Close()
 }
 
+// BatchClient sends multiple JSON-RPC calls to an endpoint in a single request
+type BatchClient interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// ReceiptBlockNumberClient fetches the receipt of a transaction together with the latest block number,
+// so the finality of the receipt can be checked without another round trip
+type ReceiptBlockNumberClient interface {
+	ReceiptAndBlockNumber(ctx context.Context, txHash common.Hash) (*types.Receipt, uint64, error)
+}
+
+// ReceiptFinalizedHeaderClient fetches the receipt of a transaction together with the header of the latest finalized block,
+// so the finality of the receipt can be checked without another round trip
+type ReceiptFinalizedHeaderClient interface {
+	ReceiptAndFinalizedHeader(ctx context.Context, txHash common.Hash) (*types.Receipt, *types.Header, error)
+}
+
+// RollupClient provides calls to JSON-RPC endpoints of L2 rollups that report the L1 origin of their blocks
+type RollupClient interface {
+	L1BlockNumber(ctx context.Context, number *big.Int) (*big.Int, error)
+}
+
+// ClientImpl implements Client, BatchClient, ReceiptBlockNumberClient and RollupClient
+type ClientImpl struct {
+	*evmClient.Client
+	rpc *rpc.Client
+	url string
+}
+
+// TransactionReceipt returns the receipt of the given transaction
+func (c ClientImpl) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := c.Client.TransactionReceipt(ctx, txHash)
+	if err == nil || err == ethereum.NotFound {
+		RecordServedBy(ctx, c.url)
+	}
+
+	return receipt, err
+}
+
+// BatchCallContext sends all given calls in a single JSON-RPC batch request
+func (c ClientImpl) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	if err := c.rpc.BatchCallContext(ctx, b); err != nil {
+		return err
+	}
+
+	RecordServedBy(ctx, c.url)
+	return nil
+}
+
+// ReceiptAndBlockNumber returns the receipt of the given transaction and the latest block number with a single JSON-RPC batch request
+func (c ClientImpl) ReceiptAndBlockNumber(ctx context.Context, txHash common.Hash) (*types.Receipt, uint64, error) {
+	return TransactionReceiptAndBlockNumber(ctx, c, txHash)
+}
+
+// BlockHashByNumber returns the hash of the canonical block at the given height as reported by the endpoint.
+// Unlike the hash of the block returned by BlockByNumber, it is not recomputed from the header fields,
+// so it also matches the block hash in receipts of chains with non-standard headers
+func (c ClientImpl) BlockHashByNumber(ctx context.Context, number *big.Int) (common.Hash, error) {
+	var head *struct {
+		Hash common.Hash `json:"hash"`
+	}
+	if err := c.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", hexutil.EncodeBig(number), false); err != nil {
+		return common.Hash{}, err
+	}
+
+	if head == nil {
+		return common.Hash{}, ethereum.NotFound
+	}
+
+	return head.Hash, nil
+}
+
+// L1BlockNumber returns the number of the L1 block the L2 block at the given height originates from,
+// as reported in the l1BlockNumber field of the blocks of rollups like Arbitrum. Returns ethereum.NotFound if the block does not exist.
+func (c ClientImpl) L1BlockNumber(ctx context.Context, number *big.Int) (*big.Int, error) {
+	var head *struct {
+		L1BlockNumber *hexutil.Big `json:"l1BlockNumber"`
+	}
+	if err := c.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", hexutil.EncodeBig(number), false); err != nil {
+		return nil, err
+	}
+
+	if head == nil {
+		return nil, ethereum.NotFound
+	}
+
+	if head.L1BlockNumber == nil {
+		return nil, fmt.Errorf("block %s does not report the number of its L1 block", number.String())
+	}
+
+	return head.L1BlockNumber.ToInt(), nil
+}
+
+// l1BlockNumber returns the L1 origin of the given L2 block if the client supports it
+func l1BlockNumber(ctx context.Context, client Client, number *big.Int) (*big.Int, error) {
+	rollupClient, ok := client.(RollupClient)
+	if !ok {
+		return nil, fmt.Errorf("rpc client of type %T does not report the L1 origin of blocks", client)
+	}
+
+	return rollupClient.L1BlockNumber(ctx, number)
+}
+
 // Eth2Client provides calls to Ethereum JSON-RPC endpoints post the merge
 type Eth2Client interface {
 	Client
@@ -29,9 +137,9 @@ type Eth2Client interface {
 	FinalizedHeader(ctx context.Context) (*types.Header, error)
 }
 
-// Eth2ClientImpl implements Eth2Client
+// Eth2ClientImpl implements Eth2Client and ReceiptFinalizedHeaderClient
 type Eth2ClientImpl struct {
-	*evmClient.Client
+	ClientImpl
 	url string
 }
 
@@ -50,6 +158,12 @@ func (c Eth2ClientImpl) FinalizedHeader(ctx context.Context) (*types.Header, err
 	return head, nil
 }
 
+// ReceiptAndFinalizedHeader returns the receipt of the given transaction and the header of the most recent finalized block
+// with a single JSON-RPC batch request
+func (c Eth2ClientImpl) ReceiptAndFinalizedHeader(ctx context.Context, txHash common.Hash) (*types.Receipt, *types.Header, error) {
+	return TransactionReceiptAndFinalizedHeader(ctx, c, txHash)
+}
+
 // MoonbeamClient provides calls to Moonbeam JSON-RPC endpoints
 type MoonbeamClient interface {
 	Client
@@ -60,7 +174,7 @@ type MoonbeamClient interface {
 
 // MoonbeamClientImpl implements MoonbeamClient
 type MoonbeamClientImpl struct {
-	*evmClient.Client
+	ClientImpl
 	url string
 }
 
@@ -94,12 +208,47 @@ func (c MoonbeamClientImpl) ChainGetHeader(ctx context.Context, hash common.Hash
 	return &result, nil
 }
 
+type kind int
+
+const (
+	mixedKind kind = iota
+	ethKind
+	eth2Kind
+	moonbeamKind
+)
+
+// clientKind returns the kind of finality RPCs all clients of the given endpoints support,
+// or mixedKind if they differ
+func clientKind(endpoints []Endpoint) kind {
+	var moonbeamCount, eth2Count int
+	for _, endpoint := range endpoints {
+		switch endpoint.Client.(type) {
+		case MoonbeamClient:
+			moonbeamCount++
+		case Eth2Client:
+			eth2Count++
+		}
+	}
+
+	switch {
+	case moonbeamCount == len(endpoints):
+		return moonbeamKind
+	case eth2Count == len(endpoints):
+		return eth2Kind
+	case moonbeamCount == 0 && eth2Count == 0:
+		return ethKind
+	default:
+		return mixedKind
+	}
+}
+
 // NewClient returns an EVM rpc client
 func NewClient(url string) (Client, error) {
-	evmClient, err := evmClient.Dial(url)
+	client, err := dial(url)
 	if err != nil {
 		return nil, err
 	}
+	evmClient := client.Client
 
 	// validate that the given url implements standard ethereum JSON-RPC
 	if _, err := evmClient.BlockNumber(context.Background()); err != nil {
@@ -107,20 +256,131 @@ func NewClient(url string) (Client, error) {
 	}
 
 	moonbeamClient := &MoonbeamClientImpl{
-		Client: evmClient,
-		url:    url,
+		ClientImpl: client,
+		url:        url,
 	}
 	if _, err := moonbeamClient.ChainGetFinalizedHead(context.Background()); err == nil {
 		return moonbeamClient, nil
 	}
 
 	eth2Client := &Eth2ClientImpl{
-		Client: evmClient,
-		url:    url,
+		ClientImpl: client,
+		url:        url,
 	}
 	if _, err := eth2Client.FinalizedHeader(context.Background()); err == nil {
 		return eth2Client, nil
 	}
 
-	return evmClient, nil
+	return client, nil
+}
+
+func dial(url string) (ClientImpl, error) {
+	rpcClient, err := rpc.DialContext(context.Background(), url)
+	if err != nil {
+		return ClientImpl{}, err
+	}
+
+	return ClientImpl{Client: evmClient.NewClient(rpcClient), rpc: rpcClient, url: url}, nil
+}
+
+// TransactionReceiptAndBlockNumber returns the receipt of the given transaction and the latest block number
+// with a single JSON-RPC batch request. Returns ethereum.NotFound if the receipt does not exist.
+func TransactionReceiptAndBlockNumber(ctx context.Context, client BatchClient, txHash common.Hash) (*types.Receipt, uint64, error) {
+	var receipt *types.Receipt
+	var blockNumber hexutil.Uint64
+	batch := []rpc.BatchElem{
+		{Method: "eth_getTransactionReceipt", Args: []interface{}{txHash}, Result: &receipt},
+		{Method: "eth_blockNumber", Result: &blockNumber},
+	}
+
+	if err := client.BatchCallContext(ctx, batch); err != nil {
+		return nil, 0, err
+	}
+
+	if batch[1].Error != nil {
+		return nil, 0, batch[1].Error
+	}
+
+	if batch[0].Error != nil {
+		return nil, 0, batch[0].Error
+	}
+
+	if receipt == nil {
+		return nil, uint64(blockNumber), ethereum.NotFound
+	}
+
+	return receipt, uint64(blockNumber), nil
+}
+
+// TransactionReceiptAndFinalizedHeader returns the receipt of the given transaction and the header of the most recent finalized block
+// with a single JSON-RPC batch request. Returns ethereum.NotFound if the receipt does not exist.
+func TransactionReceiptAndFinalizedHeader(ctx context.Context, client BatchClient, txHash common.Hash) (*types.Receipt, *types.Header, error) {
+	var receipt *types.Receipt
+	var header *types.Header
+	batch := []rpc.BatchElem{
+		{Method: "eth_getTransactionReceipt", Args: []interface{}{txHash}, Result: &receipt},
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"finalized", false}, Result: &header},
+	}
+
+	if err := client.BatchCallContext(ctx, batch); err != nil {
+		return nil, nil, err
+	}
+
+	if batch[1].Error != nil {
+		return nil, nil, batch[1].Error
+	}
+
+	if header == nil {
+		return nil, nil, fmt.Errorf("finalized block not found")
+	}
+
+	if batch[0].Error != nil {
+		return nil, nil, batch[0].Error
+	}
+
+	if receipt == nil {
+		return nil, header, ethereum.NotFound
+	}
+
+	return receipt, header, nil
+}
+
+// receiptAndBlockNumber fetches the receipt and the latest block number in a single batch if the client supports it,
+// and with separate calls otherwise
+func receiptAndBlockNumber(ctx context.Context, client Client, txHash common.Hash) (*types.Receipt, uint64, error) {
+	if client, ok := client.(ReceiptBlockNumberClient); ok {
+		return client.ReceiptAndBlockNumber(ctx, txHash)
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	blockNumber, err := client.BlockNumber(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return receipt, blockNumber, nil
+}
+
+// receiptAndFinalizedHeader fetches the receipt and the latest finalized header in a single batch if the client supports it,
+// and with separate calls otherwise
+func receiptAndFinalizedHeader(ctx context.Context, client Eth2Client, txHash common.Hash) (*types.Receipt, *types.Header, error) {
+	if client, ok := client.(ReceiptFinalizedHeaderClient); ok {
+		return client.ReceiptAndFinalizedHeader(ctx, txHash)
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := client.FinalizedHeader(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return receipt, header, nil
 }
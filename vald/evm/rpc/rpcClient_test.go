@@ -0,0 +1,113 @@
+package rpc_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	geth "github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+)
+
+func TestTransactionReceiptAndBlockNumber(t *testing.T) {
+	txHash := common.BytesToHash(rand.Bytes(common.HashLength))
+	blockNumber := uint64(rand.I64Between(1000, 10000))
+
+	batchClient := func(receipt *geth.Receipt, receiptErr error) *mock.BatchClientMock {
+		return &mock.BatchClientMock{BatchCallContextFunc: func(_ context.Context, batch []ethrpc.BatchElem) error {
+			for i := range batch {
+				switch batch[i].Method {
+				case "eth_getTransactionReceipt":
+					*batch[i].Result.(**geth.Receipt) = receipt
+					batch[i].Error = receiptErr
+				case "eth_blockNumber":
+					*batch[i].Result.(*hexutil.Uint64) = hexutil.Uint64(blockNumber)
+				}
+			}
+
+			return nil
+		}}
+	}
+
+	t.Run("should fetch the receipt and the block number in a single batch", func(t *testing.T) {
+		receipt := &geth.Receipt{TxHash: txHash, BlockNumber: big.NewInt(rand.PosI64())}
+		client := batchClient(receipt, nil)
+
+		actualReceipt, actualBlockNumber, err := rpc.TransactionReceiptAndBlockNumber(context.Background(), client, txHash)
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, actualReceipt)
+		assert.Equal(t, blockNumber, actualBlockNumber)
+		assert.Len(t, client.BatchCallContextCalls(), 1)
+		assert.Len(t, client.BatchCallContextCalls()[0].B, 2)
+	})
+
+	t.Run("should return not found if the receipt does not exist", func(t *testing.T) {
+		_, _, err := rpc.TransactionReceiptAndBlockNumber(context.Background(), batchClient(nil, nil), txHash)
+		assert.ErrorIs(t, err, ethereum.NotFound)
+	})
+
+	t.Run("should return the error of a failed batch element", func(t *testing.T) {
+		_, _, err := rpc.TransactionReceiptAndBlockNumber(context.Background(), batchClient(nil, fmt.Errorf("error")), txHash)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ethereum.NotFound)
+	})
+
+	t.Run("should return the error of a failed batch", func(t *testing.T) {
+		client := &mock.BatchClientMock{BatchCallContextFunc: func(context.Context, []ethrpc.BatchElem) error { return fmt.Errorf("error") }}
+
+		_, _, err := rpc.TransactionReceiptAndBlockNumber(context.Background(), client, txHash)
+		assert.Error(t, err)
+	})
+}
+
+func TestTransactionReceiptAndFinalizedHeader(t *testing.T) {
+	txHash := common.BytesToHash(rand.Bytes(common.HashLength))
+	finalized := &geth.Header{Number: big.NewInt(rand.I64Between(1000, 10000)), Difficulty: big.NewInt(0)}
+
+	batchClient := func(receipt *geth.Receipt, header *geth.Header) *mock.BatchClientMock {
+		return &mock.BatchClientMock{BatchCallContextFunc: func(_ context.Context, batch []ethrpc.BatchElem) error {
+			for i := range batch {
+				switch batch[i].Method {
+				case "eth_getTransactionReceipt":
+					*batch[i].Result.(**geth.Receipt) = receipt
+				case "eth_getBlockByNumber":
+					*batch[i].Result.(**geth.Header) = header
+				}
+			}
+
+			return nil
+		}}
+	}
+
+	t.Run("should fetch the receipt and the finalized header in a single batch", func(t *testing.T) {
+		receipt := &geth.Receipt{TxHash: txHash, BlockNumber: big.NewInt(rand.PosI64())}
+		client := batchClient(receipt, finalized)
+
+		actualReceipt, actualHeader, err := rpc.TransactionReceiptAndFinalizedHeader(context.Background(), client, txHash)
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, actualReceipt)
+		assert.Equal(t, finalized, actualHeader)
+		assert.Len(t, client.BatchCallContextCalls(), 1)
+		assert.Equal(t, []interface{}{"finalized", false}, client.BatchCallContextCalls()[0].B[1].Args)
+	})
+
+	t.Run("should return not found if the receipt does not exist", func(t *testing.T) {
+		_, _, err := rpc.TransactionReceiptAndFinalizedHeader(context.Background(), batchClient(nil, finalized), txHash)
+		assert.ErrorIs(t, err, ethereum.NotFound)
+	})
+
+	t.Run("should fail if the finalized block does not exist", func(t *testing.T) {
+		_, _, err := rpc.TransactionReceiptAndFinalizedHeader(context.Background(), batchClient(&geth.Receipt{}, nil), txHash)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ethereum.NotFound)
+	})
+}
@@ -0,0 +1,50 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/axelarnetwork/utils/slices"
+)
+
+type servedByKey struct{}
+
+// ServedBy collects the endpoints that served the calls made with a context returned by WithServedBy
+type ServedBy struct {
+	lock sync.Mutex
+	urls []string
+}
+
+// WithServedBy returns a context that records which endpoints serve the calls made with it
+func WithServedBy(ctx context.Context) (context.Context, *ServedBy) {
+	servedBy := &ServedBy{}
+	return context.WithValue(ctx, servedByKey{}, servedBy), servedBy
+}
+
+// RecordServedBy records that the endpoint with the given URL served a call made with the given context.
+// It is a no-op if the context does not record endpoints
+func RecordServedBy(ctx context.Context, url string) {
+	servedBy, ok := ctx.Value(servedByKey{}).(*ServedBy)
+	if !ok || servedBy == nil {
+		return
+	}
+
+	servedBy.lock.Lock()
+	defer servedBy.lock.Unlock()
+
+	servedBy.urls = append(servedBy.urls, url)
+}
+
+// URLs returns the redacted URLs of the endpoints that served the recorded calls
+func (s *ServedBy) URLs() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return slices.Distinct(slices.Map(s.urls, RedactURL))
+}
+
+// withoutServedBy stops calls to the endpoints behind a multi-endpoint client from recording themselves,
+// so only the endpoints whose results the multi-endpoint client actually returns are recorded
+func withoutServedBy(ctx context.Context) context.Context {
+	return context.WithValue(ctx, servedByKey{}, (*ServedBy)(nil))
+}
@@ -3,9 +3,12 @@ package vald
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,11 +16,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 
 	"github.com/axelarnetwork/axelar-core/vald/config"
+	tofndgrpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
 	"github.com/axelarnetwork/axelar-core/vald/tss"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/snapshot/keeper"
 	snapshotTypes "github.com/axelarnetwork/axelar-core/x/snapshot/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
@@ -36,6 +44,10 @@ const (
 	flagOperatorAddr    = "operator-addr"
 	flagTofndHost       = "tofnd-host"
 	flagTofndPort       = "tofnd-port"
+
+	checkPassed  = "passed"
+	checkFailed  = "failed"
+	checkSkipped = "skipped"
 )
 
 // GetHealthCheckCommand returns the command to execute a node health check
@@ -53,11 +65,23 @@ func GetHealthCheckCommand() *cobra.Command {
 			}
 			serverCtx := server.GetServerContextFromCmd(cmd)
 
-			ok := execCheck(context.Background(), clientCtx, serverCtx, "tofnd", skipTofnd, checkTofnd) &&
-				execCheck(cmd.Context(), clientCtx, serverCtx, "broadcaster", skipBroadcaster, checkBroadcaster)
+			results := []checkResult{
+				execCheck(context.Background(), clientCtx, serverCtx, "tofnd", skipTofnd, checkTofnd),
+				execCheck(cmd.Context(), clientCtx, serverCtx, "broadcaster", skipBroadcaster, checkBroadcaster),
+				execCheck(cmd.Context(), clientCtx, serverCtx, "operator", skipOperator, checkOperator),
+			}
+
+			report := healthReport{Healthy: true, Checks: results}
+			for _, result := range results {
+				report.Healthy = report.Healthy && result.Status != checkFailed
+			}
+
+			if err := printHealthReport(clientCtx, report); err != nil {
+				return err
+			}
 
 			// enforce a non-zero exit code in case health checks fail without printing cobra output
-			if !ok {
+			if !report.Healthy {
 				os.Exit(1)
 			}
 
@@ -72,6 +96,8 @@ func GetHealthCheckCommand() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&skipTofnd, flagSkipTofnd, false, "skip tofnd check")
 	cmd.PersistentFlags().BoolVar(&skipBroadcaster, flagSkipBroadcaster, false, "skip broadcaster check")
 	cmd.PersistentFlags().BoolVar(&skipOperator, flagSkipOperator, false, "skip operator check")
+	cmd.PersistentFlags().String(flags.FlagFrom, "", "name or address of the broadcaster key (defaults to the broadcaster account in the vald config)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, "file", "select keyring's backend (os|file|kwallet|pass|test)")
 
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
@@ -79,20 +105,52 @@ func GetHealthCheckCommand() *cobra.Command {
 
 type checkCmd func(ctx context.Context, clientCtx client.Context, serverCtx *server.Context) error
 
-func execCheck(ctx context.Context, clientCtx client.Context, serverCtx *server.Context, name string, skip bool, check checkCmd) bool {
+// checkResult is the outcome of a single health check
+type checkResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// healthReport is the outcome of all health checks
+type healthReport struct {
+	Healthy bool          `json:"healthy"`
+	Checks  []checkResult `json:"checks"`
+}
+
+func execCheck(ctx context.Context, clientCtx client.Context, serverCtx *server.Context, name string, skip bool, check checkCmd) checkResult {
 	if skip {
-		fmt.Printf("%s check: skipped\n", name)
-		return true
+		return checkResult{Name: name, Status: checkSkipped}
 	}
 
-	err := check(ctx, clientCtx, serverCtx)
-	if err != nil {
-		fmt.Printf("%s check: failed (%s)\n", name, err.Error())
-		return false
+	if err := check(ctx, clientCtx, serverCtx); err != nil {
+		return checkResult{Name: name, Status: checkFailed, Error: err.Error()}
+	}
+
+	return checkResult{Name: name, Status: checkPassed}
+}
+
+func printHealthReport(clientCtx client.Context, report healthReport) error {
+	if clientCtx.OutputFormat == "json" {
+		bz, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(bz))
+		return nil
+	}
+
+	for _, result := range report.Checks {
+		switch result.Status {
+		case checkFailed:
+			fmt.Printf("%s check: %s (%s)\n", result.Name, result.Status, result.Error)
+		default:
+			fmt.Printf("%s check: %s\n", result.Name, result.Status)
+		}
 	}
 
-	fmt.Printf("%s check: passed\n", name)
-	return true
+	return nil
 }
 
 func checkTofnd(ctx context.Context, clientCtx client.Context, serverCtx *server.Context) error {
@@ -103,7 +161,12 @@ func checkTofnd(ctx context.Context, clientCtx client.Context, serverCtx *server
 
 	nopLogger := server.ZeroLogWrapper{Logger: zerolog.New(io.Discard)}
 
-	conn, err := tss.Connect(valdCfg.TssConfig.Host, valdCfg.TssConfig.Port, valdCfg.TssConfig.DialTimeout, nopLogger)
+	// validate the TLS settings separately, so a misconfiguration is not reported as an unreachable tofnd
+	if _, err := tofndgrpc.TransportOption(valdCfg.TssConfig); err != nil {
+		return fmt.Errorf("invalid tofnd TLS configuration: %s", err.Error())
+	}
+
+	conn, err := tss.Connect(valdCfg.TssConfig, nopLogger)
 	if err != nil {
 		return fmt.Errorf("failed to reach tofnd: %s", err.Error())
 	}
@@ -137,51 +200,217 @@ func checkTofnd(ctx context.Context, clientCtx client.Context, serverCtx *server
 }
 
 func checkBroadcaster(ctx context.Context, clientCtx client.Context, serverCtx *server.Context) error {
-	str := serverCtx.Viper.GetString(flagOperatorAddr)
-	if str == "" {
-		return fmt.Errorf("no operator address specified")
+	operator, err := getOperator(serverCtx)
+	if err != nil {
+		return err
 	}
-	operator, err := sdk.ValAddressFromBech32(str)
+
+	broadcaster, err := getActiveProxy(clientCtx, operator)
 	if err != nil {
 		return err
 	}
 
-	bz, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", snapshotTypes.QuerierRoute, keeper.QProxy, operator.String()))
+	queryClient := bankTypes.NewQueryClient(clientCtx)
+	params := bankTypes.NewQueryBalanceRequest(broadcaster, tokenDenom)
+
+	grpcCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := queryClient.Balance(grpcCtx, params)
 	if err != nil {
 		return err
 	}
 
-	reply := struct {
-		Address string `json:"address"`
-		Status  string `json:"status"`
-	}{}
-	if err := json.Unmarshal(bz, &reply); err != nil {
+	if res.Balance.Amount.LTE(sdk.NewInt(minBalance)) {
+		return fmt.Errorf("broadcaster does not have enough funds (minimum balance is %d%s)", minBalance, tokenDenom)
+	}
+
+	return nil
+}
+
+func checkOperator(ctx context.Context, clientCtx client.Context, serverCtx *server.Context) error {
+	operator, err := getOperator(serverCtx)
+	if err != nil {
 		return err
 	}
 
-	broadcaster, err := sdk.AccAddressFromBech32(reply.Address)
+	var errs []string
+	if err := checkProxyMatchesBroadcasterKey(clientCtx, serverCtx, operator); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if err := checkValidatorStatus(ctx, clientCtx, operator); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if err := checkEVMRPCs(ctx, clientCtx, serverCtx); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func checkProxyMatchesBroadcasterKey(clientCtx client.Context, serverCtx *server.Context, operator sdk.ValAddress) error {
+	proxy, err := getActiveProxy(clientCtx, operator)
 	if err != nil {
 		return err
 	}
 
-	if reply.Status != "active" {
-		return fmt.Errorf("broadcaster for operator %s not active", operator.String())
+	from := serverCtx.Viper.GetString(flags.FlagFrom)
+	if from == "" {
+		from = serverCtx.Viper.GetString("broadcast.broadcaster-account")
+	}
+	if from == "" {
+		return fmt.Errorf("no broadcaster key specified")
 	}
 
-	queryClient := bankTypes.NewQueryClient(clientCtx)
-	params := bankTypes.NewQueryBalanceRequest(broadcaster, tokenDenom)
+	if clientCtx.Keyring == nil {
+		return fmt.Errorf("keyring not available")
+	}
+
+	_, name, _, err := client.GetFromFields(clientCtx.Keyring, from, false)
+	if err != nil {
+		return fmt.Errorf("failed to read broadcaster key from keyring: %s", err.Error())
+	}
+
+	key, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return fmt.Errorf("failed to read broadcaster key from keyring: %s", err.Error())
+	}
 
+	if !key.GetAddress().Equals(proxy) {
+		return fmt.Errorf("registered broadcaster %s of operator %s does not match broadcaster key %s", proxy.String(), operator.String(), key.GetAddress().String())
+	}
+
+	return nil
+}
+
+func checkValidatorStatus(ctx context.Context, clientCtx client.Context, operator sdk.ValAddress) error {
 	grpcCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	res, err := queryClient.Balance(grpcCtx, params)
+	res, err := stakingTypes.NewQueryClient(clientCtx).Validator(grpcCtx, &stakingTypes.QueryValidatorRequest{ValidatorAddr: operator.String()})
+	if err != nil {
+		return fmt.Errorf("failed to query validator %s: %s", operator.String(), err.Error())
+	}
+
+	validator := res.Validator
+	if validator.IsJailed() {
+		return fmt.Errorf("validator %s is jailed", operator.String())
+	}
+
+	if !validator.IsBonded() {
+		return fmt.Errorf("validator %s is not bonded", operator.String())
+	}
+
+	consAddr, err := validator.GetConsAddr()
 	if err != nil {
 		return err
 	}
 
-	if res.Balance.Amount.LTE(sdk.NewInt(minBalance)) {
-		return fmt.Errorf("broadcaster does not have enough funds (minimum balance is %d%s)", minBalance, tokenDenom)
+	signingInfo, err := slashingTypes.NewQueryClient(clientCtx).SigningInfo(grpcCtx, &slashingTypes.QuerySigningInfoRequest{ConsAddress: consAddr.String()})
+	if err != nil {
+		return fmt.Errorf("failed to query signing info of validator %s: %s", operator.String(), err.Error())
+	}
+
+	if signingInfo.ValSigningInfo.Tombstoned {
+		return fmt.Errorf("validator %s is tombstoned", operator.String())
+	}
+
+	return nil
+}
+
+// checkEVMRPCs checks that all RPC endpoints of the EVM chains vald is configured to support are reachable and connected to the expected chain
+func checkEVMRPCs(ctx context.Context, clientCtx client.Context, serverCtx *server.Context) error {
+	valdCfg := config.DefaultValdConfig()
+	if err := serverCtx.Viper.Unmarshal(&valdCfg); err != nil {
+		panic(err)
+	}
+
+	var errs []string
+	for _, evmCfg := range valdCfg.EVMConfig {
+		if !evmCfg.WithBridge {
+			continue
+		}
+
+		res, err := evmTypes.NewQueryServiceClient(clientCtx).ChainID(ctx, &evmTypes.ChainIDRequest{Chain: evmCfg.Name})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("failed to query chain ID of chain %s: %s", evmCfg.Name, err.Error()))
+			continue
+		}
+
+		for _, url := range evmCfg.RPCAddrs() {
+			if err := checkEVMRPC(ctx, url, res.Id.BigInt()); err != nil {
+				errs = append(errs, fmt.Sprintf("chain %s: %s", evmCfg.Name, err.Error()))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func checkEVMRPC(ctx context.Context, url string, expectedChainID *big.Int) error {
+	rpcCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := ethclient.DialContext(rpcCtx, url)
+	if err != nil {
+		return fmt.Errorf("failed to reach rpc endpoint %s: %s", url, err.Error())
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(rpcCtx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID from rpc endpoint %s: %s", url, err.Error())
+	}
+
+	if chainID.Cmp(expectedChainID) != 0 {
+		return fmt.Errorf("rpc endpoint %s is connected to chain ID %s instead of %s", url, chainID.String(), expectedChainID.String())
 	}
 
 	return nil
 }
+
+func getOperator(serverCtx *server.Context) (sdk.ValAddress, error) {
+	str := serverCtx.Viper.GetString(flagOperatorAddr)
+	if str == "" {
+		return nil, fmt.Errorf("no operator address specified")
+	}
+
+	return sdk.ValAddressFromBech32(str)
+}
+
+// getActiveProxy returns the broadcaster registered for the given operator if it is active
+func getActiveProxy(clientCtx client.Context, operator sdk.ValAddress) (sdk.AccAddress, error) {
+	bz, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", snapshotTypes.QuerierRoute, keeper.QProxy, operator.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	reply := struct {
+		Address string `json:"address"`
+		Status  string `json:"status"`
+	}{}
+	if err := json.Unmarshal(bz, &reply); err != nil {
+		return nil, err
+	}
+
+	broadcaster, err := sdk.AccAddressFromBech32(reply.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if reply.Status != "active" {
+		return nil, fmt.Errorf("broadcaster for operator %s not active", operator.String())
+	}
+
+	return broadcaster, nil
+}
@@ -0,0 +1,175 @@
+package vald
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/axelarnetwork/axelar-core/vald/chains"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+// Outcome of processing a poll or signing session
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+var (
+	entryPrefix  = []byte("entry_")
+	heightPrefix = []byte("height_")
+)
+
+// JournalEntry is the recorded outcome of processing a poll or signing session
+type JournalEntry struct {
+	Outcome string    `json:"outcome"`
+	Error   string    `json:"error,omitempty"`
+	Height  int64     `json:"height"`
+	Time    time.Time `json:"time"`
+}
+
+// Journal persists the outcome of all handled polls, signing sessions, keygens and heartbeats,
+// so vald can skip work that has already been done when it re-processes events after a restart.
+// Vald only re-processes the block it has stored as its latest, so entries of older blocks can be pruned
+type Journal struct {
+	db dbm.DB
+}
+
+// NewJournal returns a new Journal instance
+func NewJournal(db dbm.DB) Journal {
+	return Journal{db: db}
+}
+
+// PollKey returns the journal key of the given poll
+func PollKey(pollID vote.PollID) string {
+	return chains.PollKey(pollID)
+}
+
+// SigningKey returns the journal key of the given signing session
+func SigningKey(sigID uint64) string {
+	return fmt.Sprintf("signing_%d", sigID)
+}
+
+// KeygenKey returns the journal key of the given keygen
+func KeygenKey(keyID string) string {
+	return fmt.Sprintf("keygen_%s", keyID)
+}
+
+// HeartbeatKey returns the journal key of the heartbeat at the given height
+func HeartbeatKey(height int64) string {
+	return fmt.Sprintf("heartbeat_%d", height)
+}
+
+// Get returns the recorded entry for the given key
+func (j Journal) Get(key string) (JournalEntry, bool, error) {
+	bz, err := j.db.Get(entryKey(key))
+	if err != nil {
+		return JournalEntry{}, false, sdkerrors.Wrapf(err, "could not read journal entry %s", key)
+	}
+
+	if bz == nil {
+		return JournalEntry{}, false, nil
+	}
+
+	var entry JournalEntry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return JournalEntry{}, false, sdkerrors.Wrapf(err, "journal entry %s is in unexpected format", key)
+	}
+
+	return entry, true, nil
+}
+
+// Record persists the outcome of processing the given key for an event at the given height
+func (j Journal) Record(key string, height int64, processErr error) error {
+	entry := JournalEntry{Outcome: OutcomeSuccess, Height: height, Time: time.Now().UTC()}
+	if processErr != nil {
+		entry.Outcome = OutcomeFailure
+		entry.Error = processErr.Error()
+	}
+
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(entryKey(key), bz); err != nil {
+		return err
+	}
+	if err := batch.Set(heightKey(height, key), []byte{}); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Prune deletes all entries of events below the given height
+func (j Journal) Prune(height int64) error {
+	iter, err := j.db.Iterator(heightPrefix, heightKey(height, ""))
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not iterate over journal entries")
+	}
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(heightPrefix)+8:]
+		if err := batch.Delete(entryKey(string(key))); err != nil {
+			return err
+		}
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+func entryKey(key string) []byte {
+	return append(append([]byte{}, entryPrefix...), key...)
+}
+
+// heightKey sorts entries by height, so all entries below a height can be found with a single iterator
+func heightKey(height int64, key string) []byte {
+	bz := append([]byte{}, heightPrefix...)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(height))
+
+	return append(bz, key...)
+}
+
+// WithJournal returns a processor that skips events that have already been processed successfully
+// and records the outcome of all other events. Failed events are processed again when they are re-delivered.
+func WithJournal[T any](journal Journal, key func(event T) string, height func(event T) int64, processor func(event T) error, logger log.Logger) func(event T) error {
+	return func(event T) error {
+		k := key(event)
+
+		entry, ok, err := journal.Get(k)
+		if err != nil {
+			return err
+		}
+
+		if ok && entry.Outcome == OutcomeSuccess {
+			logger.Debug(fmt.Sprintf("skipping %s, it has already been handled at %s", k, entry.Time.String()))
+			return nil
+		}
+
+		processErr := processor(event)
+		if err := journal.Record(k, height(event), processErr); err != nil {
+			logger.Error(sdkerrors.Wrapf(err, "failed to record outcome of %s", k).Error())
+		}
+
+		return processErr
+	}
+}
@@ -0,0 +1,127 @@
+package vald_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+)
+
+func TestJournal(t *testing.T) {
+	journal := vald.NewJournal(dbm.NewMemDB())
+	key := vald.PollKey(vote.PollID(rand.PosI64()))
+
+	_, ok, err := journal.Get(key)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	height := rand.I64Between(1, 1000)
+	assert.NoError(t, journal.Record(key, height, fmt.Errorf("error")))
+	entry, ok, err := journal.Get(key)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, vald.OutcomeFailure, entry.Outcome)
+	assert.Equal(t, "error", entry.Error)
+	assert.Equal(t, height, entry.Height)
+
+	assert.NoError(t, journal.Record(key, height, nil))
+	entry, ok, err = journal.Get(key)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, vald.OutcomeSuccess, entry.Outcome)
+}
+
+func TestJournal_Prune(t *testing.T) {
+	journal := vald.NewJournal(dbm.NewMemDB())
+	height := rand.I64Between(2, 1000)
+
+	keygen := vald.KeygenKey(rand.Str(10))
+	heartbeat := vald.HeartbeatKey(height)
+	signing := vald.SigningKey(uint64(rand.PosI64()))
+
+	assert.NoError(t, journal.Record(keygen, height-1, nil))
+	assert.NoError(t, journal.Record(heartbeat, height, nil))
+	assert.NoError(t, journal.Record(signing, height+1, nil))
+
+	assert.NoError(t, journal.Prune(height))
+
+	_, ok, err := journal.Get(keygen)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = journal.Get(heartbeat)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, ok, err = journal.Get(signing)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.NoError(t, journal.Prune(height+2))
+	_, ok, err = journal.Get(heartbeat)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = journal.Get(signing)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestWithJournal(t *testing.T) {
+	var (
+		journal   vald.Journal
+		processed []uint64
+		fail      bool
+		height    int64
+	)
+
+	processor := func(sigID uint64) error {
+		processed = append(processed, sigID)
+		if fail {
+			return fmt.Errorf("error")
+		}
+
+		return nil
+	}
+	setup := func() {
+		journal = vald.NewJournal(dbm.NewMemDB())
+		processed = nil
+		fail = false
+		height = rand.PosI64()
+	}
+
+	t.Run("should skip events that have been processed successfully", func(t *testing.T) {
+		setup()
+		process := vald.WithJournal(journal, vald.SigningKey, func(uint64) int64 { return height }, processor, log.TestingLogger())
+		sigID := uint64(rand.PosI64())
+
+		assert.NoError(t, process(sigID))
+		assert.NoError(t, process(sigID))
+		assert.Equal(t, []uint64{sigID}, processed)
+	})
+
+	t.Run("should process failed events again", func(t *testing.T) {
+		setup()
+		process := vald.WithJournal(journal, vald.SigningKey, func(uint64) int64 { return height }, processor, log.TestingLogger())
+		sigID := uint64(rand.PosI64())
+
+		fail = true
+		assert.Error(t, process(sigID))
+
+		fail = false
+		assert.NoError(t, process(sigID))
+		assert.NoError(t, process(sigID))
+		assert.Equal(t, []uint64{sigID, sigID}, processed)
+
+		entry, _, err := journal.Get(vald.SigningKey(sigID))
+		assert.NoError(t, err)
+		assert.Equal(t, vald.OutcomeSuccess, entry.Outcome)
+		assert.Equal(t, height, entry.Height)
+	})
+}
@@ -0,0 +1,60 @@
+package multisig
+
+import (
+	"context"
+	"sync"
+)
+
+// sessionExpiry cancels the contexts of keygen and signing sessions once the block at their expiry height has been seen
+type sessionExpiry struct {
+	lock     sync.Mutex
+	height   int64
+	sessions map[int64][]context.CancelFunc
+}
+
+func newSessionExpiry() *sessionExpiry {
+	return &sessionExpiry{sessions: make(map[int64][]context.CancelFunc)}
+}
+
+// withExpiry returns a context that is cancelled when the session expires at the given height.
+// Sessions without an expiry height are only bounded by the parent context.
+func (s *sessionExpiry) withExpiry(parent context.Context, expiresAt int64) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	if expiresAt <= 0 {
+		return ctx, cancel
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if expiresAt <= s.height {
+		cancel()
+		return ctx, cancel
+	}
+
+	s.sessions[expiresAt] = append(s.sessions[expiresAt], cancel)
+
+	return ctx, cancel
+}
+
+// setHeight cancels the contexts of all sessions that expire at or below the given height
+func (s *sessionExpiry) setHeight(height int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if height <= s.height {
+		return
+	}
+	s.height = height
+
+	for expiresAt, cancels := range s.sessions {
+		if expiresAt > height {
+			continue
+		}
+
+		for _, cancel := range cancels {
+			cancel()
+		}
+		delete(s.sessions, expiresAt)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 	"github.com/axelarnetwork/utils/slices"
 )
@@ -20,13 +21,13 @@ func (mgr *Mgr) ProcessKeygenStarted(event *types.KeygenStarted) error {
 	keyUID := fmt.Sprintf("%s_%d", event.GetKeyID().String(), 0)
 	partyUID := mgr.participant.String()
 
-	pubKey, err := mgr.generateKey(keyUID, partyUID)
+	pubKey, err := mgr.generateKey(keyUID, partyUID, event.ExpiresAt)
 	if err != nil {
 		return err
 	}
 
 	payloadHash := sha256.Sum256(mgr.ctx.FromAddress)
-	sig, err := mgr.sign(keyUID, payloadHash[:], partyUID, pubKey)
+	sig, err := mgr.sign(keyUID, payloadHash[:], partyUID, pubKey, event.ExpiresAt)
 	if err != nil {
 		return err
 	}
@@ -34,7 +35,7 @@ func (mgr *Mgr) ProcessKeygenStarted(event *types.KeygenStarted) error {
 	mgr.logger.Info(fmt.Sprintf("operator %s sending public key for multisig key %s", partyUID, keyUID))
 
 	msg := types.NewSubmitPubKeyRequest(mgr.ctx.FromAddress, event.GetKeyID(), pubKey, sig)
-	if _, err := mgr.broadcaster.Broadcast(context.Background(), msg); err != nil {
+	if _, err := mgr.broadcaster.Broadcast(broadcast.WithExpiry(context.Background(), event.ExpiresAt), msg); err != nil {
 		return sdkerrors.Wrap(err, "handler goroutine: failure to broadcast outgoing submit pub key message")
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
@@ -24,6 +25,8 @@ type Mgr struct {
 	logger      log.Logger
 	broadcaster broadcast.Broadcaster
 	timeout     time.Duration
+	auditLog    audit.Log
+	expiry      *sessionExpiry
 }
 
 // NewMgr is the constructor of mgr
@@ -35,15 +38,38 @@ func NewMgr(client Client, ctx sdkclient.Context, participant sdk.ValAddress, lo
 		logger:      logger,
 		broadcaster: broadcaster,
 		timeout:     timeout,
+		auditLog:    audit.NoOp{},
+		expiry:      newSessionExpiry(),
 	}
 }
 
+// SetAuditLog sets the log that records all signatures produced by the manager
+func (mgr *Mgr) SetAuditLog(auditLog audit.Log) {
+	mgr.auditLog = auditLog
+}
+
+// ProcessNewBlockHeight aborts all pending tofnd requests of keygen and signing sessions that have expired at the given height
+func (mgr *Mgr) ProcessNewBlockHeight(height int64) {
+	mgr.expiry.setHeight(height)
+}
+
 func (mgr Mgr) isParticipant(p sdk.ValAddress) bool {
 	return mgr.participant.Equals(p)
 }
 
-func (mgr Mgr) generateKey(keyUID string, partyUID string) (exported.PublicKey, error) {
-	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
+// requestCtx returns the context for tofnd requests of a session, which ends when the session expires or the request times out
+func (mgr Mgr) requestCtx(expiresAt int64) (context.Context, context.CancelFunc) {
+	timeoutCtx, cancelTimeout := context.WithTimeout(context.Background(), mgr.timeout)
+	ctx, cancel := mgr.expiry.withExpiry(timeoutCtx, expiresAt)
+
+	return ctx, func() {
+		cancel()
+		cancelTimeout()
+	}
+}
+
+func (mgr Mgr) generateKey(keyUID string, partyUID string, expiresAt int64) (exported.PublicKey, error) {
+	grpcCtx, cancel := mgr.requestCtx(expiresAt)
 	defer cancel()
 
 	res, err := mgr.client.Keygen(grpcCtx, &tofnd.KeygenRequest{
@@ -64,8 +90,8 @@ func (mgr Mgr) generateKey(keyUID string, partyUID string) (exported.PublicKey,
 	}
 }
 
-func (mgr Mgr) sign(keyUID string, payloadHash exported.Hash, partyUID string, pubKey []byte) (types.Signature, error) {
-	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
+func (mgr Mgr) sign(keyUID string, payloadHash exported.Hash, partyUID string, pubKey []byte, expiresAt int64) (types.Signature, error) {
+	grpcCtx, cancel := mgr.requestCtx(expiresAt)
 	defer cancel()
 
 	res, err := mgr.client.Sign(grpcCtx, &tofnd.SignRequest{
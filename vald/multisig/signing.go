@@ -2,10 +2,13 @@ package multisig
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 )
 
@@ -19,7 +22,7 @@ func (mgr *Mgr) ProcessSigningStarted(event *types.SigningStarted) error {
 	keyUID := fmt.Sprintf("%s_%d", event.GetKeyID().String(), 0)
 	partyUID := mgr.participant.String()
 
-	sig, err := mgr.sign(keyUID, event.GetPayloadHash(), partyUID, pubKey)
+	sig, err := mgr.sign(keyUID, event.GetPayloadHash(), partyUID, pubKey, event.ExpiresAt)
 	if err != nil {
 		return err
 	}
@@ -27,9 +30,18 @@ func (mgr *Mgr) ProcessSigningStarted(event *types.SigningStarted) error {
 	mgr.logger.Info(fmt.Sprintf("operator %s sending signature for signing %d", partyUID, event.GetSigID()))
 
 	msg := types.NewSubmitSignatureRequest(mgr.ctx.FromAddress, event.GetSigID(), sig)
-	if _, err := mgr.broadcaster.Broadcast(context.Background(), msg); err != nil {
+	if _, err := mgr.broadcaster.Broadcast(broadcast.WithExpiry(context.Background(), event.ExpiresAt), msg); err != nil {
 		return sdkerrors.Wrap(err, "handler goroutine: failure to broadcast outgoing submit signature message")
 	}
 
+	record := audit.Signature{
+		SigID:       event.GetSigID(),
+		KeyID:       event.GetKeyID(),
+		PayloadHash: hex.EncodeToString(event.GetPayloadHash()),
+	}
+	if err := mgr.auditLog.RecordSignature(record); err != nil {
+		mgr.logger.Error(sdkerrors.Wrapf(err, "failed to record signature for signing %d in the audit log", event.GetSigID()).Error())
+	}
+
 	return nil
 }
@@ -54,7 +54,7 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 	givenMgr.
 		When("is not part of the listed participants", func() {
 			key := typestestutils.Key()
-			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3))
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), rand.I64Between(10, 100))
 		}).
 		Then("should ignore", func(t *testing.T) {
 			err := mgr.ProcessSigningStarted(event)
@@ -69,7 +69,7 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 			privateKey = funcs.Must(btcec.NewPrivateKey(btcec.S256()))
 			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
 
-			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3))
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), rand.I64Between(10, 100))
 		}).
 		Then("should handle", func(t *testing.T) {
 			client.SignFunc = func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
@@ -96,4 +96,48 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 			assert.Len(t, broadcaster.BroadcastCalls()[0].Msgs, 1)
 		}).
 		Run(t)
+
+	givenMgr.
+		When("is part of the listed participants", func() {
+			key := typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey(btcec.S256()))
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), rand.I64Between(10, 100))
+		}).
+		When("the signing session expires while tofnd is unavailable", func() {
+			client.SignFunc = func(ctx context.Context, _ *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				mgr.ProcessNewBlockHeight(event.ExpiresAt)
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			}
+		}).
+		Then("should abort the request before the timeout", func(t *testing.T) {
+			err := mgr.ProcessSigningStarted(event)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}).
+		Run(t)
+
+	givenMgr.
+		When("is part of the listed participants", func() {
+			key := typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey(btcec.S256()))
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3), rand.I64Between(10, 100))
+		}).
+		When("the signing session has already expired", func() {
+			mgr.ProcessNewBlockHeight(event.ExpiresAt + 1)
+			client.SignFunc = func(ctx context.Context, _ *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return nil, ctx.Err()
+			}
+		}).
+		Then("should not sign", func(t *testing.T) {
+			err := mgr.ProcessSigningStarted(event)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Len(t, broadcaster.BroadcastCalls(), 0)
+		}).
+		Run(t)
 }
@@ -0,0 +1,286 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	"github.com/axelarnetwork/axelar-core/vald/chains"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/utils/jobs"
+)
+
+const (
+	flagReplayFrom = "from"
+	flagReplayTo   = "to"
+)
+
+// GetReplayCommand returns the command to re-process the events of a past block range
+func GetReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-replay",
+		Short: "Re-process the events of a past block range and write the msgs vald would have broadcast to a file",
+		Long: "Re-process the chain and multisig signing events of the given block range with the current vald configuration. " +
+			"Keygen events are skipped and nothing is broadcast, all msgs are written to the dry-run output file instead (default \"<home>/vald/replay-<from>-<to>.jsonl\").",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			logger := serverCtx.Logger.With("module", "vald")
+			v := serverCtx.Viper
+
+			if err := bindTofndFlags(v, cmd); err != nil {
+				return err
+			}
+			if err := v.BindPFlag("dry_run.output", cmd.PersistentFlags().Lookup("dry-run-output")); err != nil {
+				return err
+			}
+
+			from, err := cmd.Flags().GetInt64(flagReplayFrom)
+			if err != nil {
+				return err
+			}
+
+			to, err := cmd.Flags().GetInt64(flagReplayTo)
+			if err != nil {
+				return err
+			}
+
+			if from <= 0 || to < from {
+				return fmt.Errorf("invalid block range [%d, %d]", from, to)
+			}
+
+			cliCtx, err := sdkClient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			return runReplay(ctx, cliCtx, from, to, logger, v)
+		},
+	}
+	setPersistentFlags(cmd)
+	cmd.Flags().Int64(flagReplayFrom, 0, "first block height of the replay")
+	cmd.Flags().Int64(flagReplayTo, 0, "last block height of the replay")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func runReplay(ctx context.Context, cliCtx sdkClient.Context, from, to int64, logger log.Logger, viper *viper.Viper) error {
+	defer once.Do(cleanUp)
+
+	valdConf := config.DefaultValdConfig()
+	if err := viper.Unmarshal(&valdConf); err != nil {
+		panic(err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(viper.GetString("validator-addr"))
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid validator operator address")
+	}
+
+	// the msgs of a replay are never broadcast, so the broadcaster key is not needed, only its address
+	broadcaster, err := getActiveProxy(cliCtx, valAddr)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to find the broadcaster of the validator")
+	}
+	cliCtx = cliCtx.WithFromAddress(broadcaster)
+
+	// a replay must never broadcast msgs or generate keys, so it always runs in shadow mode and ignores keygen events
+	valdConf.DryRunConfig.Enabled = true
+	if valdConf.DryRunConfig.Output == "" {
+		valdConf.DryRunConfig.Output = filepath.Join(cliCtx.HomeDir, "vald", fmt.Sprintf("replay-%d-%d.jsonl", from, to))
+	}
+
+	cdc := app.MakeEncodingConfig().Amino
+	bc := createDryRunBroadcaster(cliCtx, valdConf.DryRunConfig, logger)
+	multisigMgr := createMultisigMgr(bc, cliCtx, valdConf, logger, valAddr)
+
+	// the background processes of the chain managers are never started, so they do not need a separate state folder
+	chainMgrs, err := chains.CreateAll(chains.Deps{
+		ClientCtx:        cliCtx,
+		Config:           valdConf,
+		Broadcaster:      bc,
+		ProxyBroadcaster: bc,
+		Validator:        valAddr,
+		Cdc:              cdc,
+		AuditLog:         audit.NoOp{},
+		StateDir:         filepath.Join(cliCtx.HomeDir, "vald", "shadow"),
+		Logger:           logger,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, chainMgr := range chainMgrs {
+			chainMgr.Stop()
+		}
+	}()
+
+	client := &blockResultTracker{BlockResultClient: createRobustClient(cliCtx)}
+	eventBus := createEventBus(client, newRangeNotifier(from, to), logger)
+
+	subscriptions := []chains.Subscription{chains.Subscribe(multisigMgr.ProcessSigningStarted)}
+	for _, chainMgr := range chainMgrs {
+		subscriptions = append(subscriptions, chainMgr.Subscriptions()...)
+	}
+
+	js := []jobs.Job{fetchReplayEvents(eventBus, client, to)}
+	for _, subscription := range subscriptions {
+		js = append(js, consumeInOrder(eventBus.Subscribe(subscription.Filter), subscription.Process, logger))
+	}
+
+	logger.Info(fmt.Sprintf("replaying blocks %d to %d", from, to))
+
+	mgr := jobs.NewMgr(ctx)
+	mgr.AddJobs(js...)
+	<-mgr.Done()
+
+	if err := <-mgr.Errs(); err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("replay of blocks %d to %d completed, msgs written to %s", from, to, valdConf.DryRunConfig.Output))
+	return nil
+}
+
+// errNoNewBlocks is the error the block source of the event bus reports when its block notifier stops
+const errNoNewBlocks = "cannot detect new blocks anymore"
+
+// fetchReplayEvents returns a job that publishes the events of all blocks up to the given height.
+// It only succeeds if the events of the last block have been published without error
+func fetchReplayEvents(eventBus *tmEvents.Bus, client *blockResultTracker, to int64) jobs.Job {
+	return func(ctx context.Context) error {
+		errs := eventBus.FetchEvents(ctx)
+
+		var err error
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case err = <-errs:
+		case <-eventBus.Done():
+			// the event bus pushes errors before it shuts down, so check for one before assuming that all blocks have been published
+			select {
+			case err = <-errs:
+			default:
+			}
+		}
+
+		// the block source might report that it cannot detect new blocks once the range notifier has sent the last height,
+		// any other error means that not all events have been published
+		if client.Latest() == to && (err == nil || err.Error() == errNoNewBlocks) {
+			return nil
+		}
+
+		if err == nil {
+			err = fmt.Errorf("event bus shut down")
+		}
+
+		return sdkerrors.Wrapf(err, "replay stopped after block %d", client.Latest())
+	}
+}
+
+// consumeInOrder processes the events of the subscription one after the other,
+// so all events have been processed when the job returns after the subscription is closed
+func consumeInOrder(sub <-chan tmEvents.ABCIEventWithHeight, process func(event tmEvents.ABCIEventWithHeight) error, logger log.Logger) jobs.Job {
+	return func(ctx context.Context) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case e, ok := <-sub:
+				if !ok {
+					return nil
+				}
+
+				if err := process(e); err != nil {
+					logger.Error(err.Error())
+				}
+			}
+		}
+	}
+}
+
+var _ tmEvents.BlockNotifier = &rangeNotifier{}
+
+// rangeNotifier notifies about all blocks in a fixed range of heights and then closes its channel
+type rangeNotifier struct {
+	from int64
+	to   int64
+	done chan struct{}
+}
+
+func newRangeNotifier(from, to int64) *rangeNotifier {
+	return &rangeNotifier{from: from, to: to, done: make(chan struct{})}
+}
+
+// BlockHeights returns a channel with all block heights of the range
+func (n *rangeNotifier) BlockHeights(ctx context.Context) (<-chan int64, <-chan error) {
+	heights := make(chan int64)
+	go func() {
+		defer close(n.done)
+		defer close(heights)
+
+		for height := n.from; height <= n.to; height++ {
+			select {
+			case heights <- height:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return heights, make(chan error)
+}
+
+// Done returns a channel that is closed when all block heights have been sent
+func (n *rangeNotifier) Done() <-chan struct{} {
+	return n.done
+}
+
+// blockResultTracker keeps track of the latest block that has been fetched successfully
+type blockResultTracker struct {
+	tmEvents.BlockResultClient
+
+	lock   sync.RWMutex
+	latest int64
+}
+
+// BlockResults returns the results of the block at the given height
+func (c *blockResultTracker) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	res, err := c.BlockResultClient.BlockResults(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.latest = res.Height
+
+	return res, nil
+}
+
+// Latest returns the height of the latest block that has been fetched successfully
+func (c *blockResultTracker) Latest() int64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.latest
+}
@@ -0,0 +1,131 @@
+package vald
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/tm-events/events/mock"
+)
+
+func TestReplayEventBus(t *testing.T) {
+	from := rand.I64Between(1, 1000)
+	to := from + rand.I64Between(1, 50)
+
+	t.Run("should publish the events of all blocks in the range", func(t *testing.T) {
+		client := &blockResultTracker{BlockResultClient: &mock.BlockResultClientMock{
+			BlockResultsFunc: func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+				return &coretypes.ResultBlockResults{Height: *height, BeginBlockEvents: []abci.Event{{Type: "event"}}}, nil
+			},
+		}}
+		bus := createEventBus(client, newRangeNotifier(from, to), log.TestingLogger())
+		sub := bus.Subscribe(func(tmEvents.ABCIEventWithHeight) bool { return true })
+
+		bus.FetchEvents(context.Background())
+		<-bus.Done()
+
+		var heights []int64
+		for e := range sub {
+			heights = append(heights, e.Height)
+		}
+
+		assert.Len(t, heights, int(to-from+1))
+		assert.Equal(t, from, heights[0])
+		assert.Equal(t, to, heights[len(heights)-1])
+		assert.Equal(t, to, client.Latest())
+	})
+
+	t.Run("should stop at the block that cannot be fetched", func(t *testing.T) {
+		client := &blockResultTracker{BlockResultClient: &mock.BlockResultClientMock{
+			BlockResultsFunc: func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+				if *height == to {
+					return nil, fmt.Errorf("error")
+				}
+				return &coretypes.ResultBlockResults{Height: *height}, nil
+			},
+		}}
+		bus := createEventBus(client, newRangeNotifier(from, to), log.TestingLogger())
+
+		bus.FetchEvents(context.Background())
+		<-bus.Done()
+		assert.Equal(t, to-1, client.Latest())
+	})
+}
+
+// failingNotifier notifies about all blocks in the range and reports an error once the last block has been fetched
+type failingNotifier struct {
+	*rangeNotifier
+	client *blockResultTracker
+}
+
+func (n failingNotifier) BlockHeights(ctx context.Context) (<-chan int64, <-chan error) {
+	heights := make(chan int64)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(n.done)
+
+		for height := n.from; height <= n.to; height++ {
+			select {
+			case heights <- height:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for n.client.Latest() != n.to {
+			time.Sleep(time.Millisecond)
+		}
+		errs <- fmt.Errorf("error")
+	}()
+
+	return heights, errs
+}
+
+func TestFetchReplayEvents(t *testing.T) {
+	from := rand.I64Between(1, 1000)
+	to := from + rand.I64Between(1, 50)
+	newClient := func() *blockResultTracker {
+		return &blockResultTracker{BlockResultClient: &mock.BlockResultClientMock{
+			BlockResultsFunc: func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+				return &coretypes.ResultBlockResults{Height: *height}, nil
+			},
+		}}
+	}
+
+	t.Run("should succeed once all blocks have been published", func(t *testing.T) {
+		client := newClient()
+		bus := createEventBus(client, newRangeNotifier(from, to), log.TestingLogger())
+
+		assert.NoError(t, fetchReplayEvents(bus, client, to)(context.Background()))
+	})
+
+	t.Run("should fail if the event bus reports an error after the last block", func(t *testing.T) {
+		client := newClient()
+		bus := createEventBus(client, failingNotifier{rangeNotifier: newRangeNotifier(from, to), client: client}, log.TestingLogger())
+
+		assert.Error(t, fetchReplayEvents(bus, client, to)(context.Background()))
+		assert.Equal(t, to, client.Latest())
+	})
+
+	t.Run("should fail if not all blocks have been fetched", func(t *testing.T) {
+		client := &blockResultTracker{BlockResultClient: &mock.BlockResultClientMock{
+			BlockResultsFunc: func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+				if *height == to {
+					return nil, fmt.Errorf("error")
+				}
+				return &coretypes.ResultBlockResults{Height: *height}, nil
+			},
+		}}
+		bus := createEventBus(client, newRangeNotifier(from, to), log.TestingLogger())
+
+		assert.Error(t, fetchReplayEvents(bus, client, to)(context.Background()))
+	})
+}
@@ -3,41 +3,47 @@ package vald
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
-	"strings"
+	"strconv"
 	"sync"
 	"syscall"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+	gogrpc "google.golang.org/grpc"
 
 	"github.com/axelarnetwork/axelar-core/app"
 	"github.com/axelarnetwork/axelar-core/cmd/axelard/cmd/utils"
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/audit"
+	"github.com/axelarnetwork/axelar-core/vald/chains"
 	"github.com/axelarnetwork/axelar-core/vald/config"
-	"github.com/axelarnetwork/axelar-core/vald/evm"
-	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	// register the chain managers of all supported chain modules
+	_ "github.com/axelarnetwork/axelar-core/vald/evm"
 	"github.com/axelarnetwork/axelar-core/vald/multisig"
 	grpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
 	"github.com/axelarnetwork/axelar-core/vald/tss"
-	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
-	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
 	tmEvents "github.com/axelarnetwork/tm-events/events"
 	"github.com/axelarnetwork/tm-events/pubsub"
@@ -52,6 +58,8 @@ const RW = 0600
 // RWX grants -rwx------ file permissions
 const RWX = 0700
 
+const flagShadow = "shadow"
+
 var once sync.Once
 var cleanupCommands []func()
 
@@ -73,13 +81,13 @@ func GetValdCommand() *cobra.Command {
 			logger := serverCtx.Logger.With("module", "vald")
 			v := serverCtx.Viper
 
-			if err := v.BindPFlag("tss.tofnd-host", cmd.PersistentFlags().Lookup("tofnd-host")); err != nil {
+			if err := bindTofndFlags(v, cmd); err != nil {
 				return err
 			}
-			if err := v.BindPFlag("tss.tofnd-port", cmd.PersistentFlags().Lookup("tofnd-port")); err != nil {
+			if err := v.BindPFlag("dry_run.enabled", cmd.Flags().Lookup(flagShadow)); err != nil {
 				return err
 			}
-			if err := v.BindPFlag("tss.tofnd-dial-timeout", cmd.PersistentFlags().Lookup("tofnd-dial-timeout")); err != nil {
+			if err := v.BindPFlag("dry_run.output", cmd.PersistentFlags().Lookup("dry-run-output")); err != nil {
 				return err
 			}
 
@@ -96,6 +104,7 @@ func GetValdCommand() *cobra.Command {
 	}
 	setPersistentFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagShadow, false, "run in shadow mode: process events without broadcasting, write the msgs that would have been broadcast to the dry-run output file instead")
 	values := map[string]string{
 		flags.FlagGasAdjustment: "4",
 	}
@@ -146,11 +155,38 @@ func runVald(ctx context.Context, cliCtx sdkClient.Context, txf tx.Factory, logg
 		}
 	}
 
-	fPath := filepath.Join(valdHome, "state.json")
+	if valdConf.MetricsConfig.Enabled {
+		startMetricsServer(valdConf.MetricsConfig.ListenAddr, logger)
+	}
+
+	// a shadow instance must not mark blocks and events as handled for a regular instance that shares the same home folder
+	stateFile := "state.json"
+	journalName := "journal"
+	stateDir := valdHome
+	if valdConf.DryRunConfig.Enabled {
+		stateFile = "shadow-state.json"
+		journalName = "shadow-journal"
+		stateDir = filepath.Join(valdHome, "shadow")
+		if err := os.MkdirAll(stateDir, RWX); err != nil {
+			return err
+		}
+	}
+
+	fPath := filepath.Join(valdHome, stateFile)
 	stateSource := NewRWFile(fPath)
 
+	journalDB, err := dbm.NewDB(journalName, dbm.GoLevelDBBackend, valdHome)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to open the event journal")
+	}
+	cleanupCommands = append(cleanupCommands, func() {
+		if err := journalDB.Close(); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to close the event journal").Error())
+		}
+	})
+
 	logger.Info("start listening to events")
-	listen(ctx, cliCtx, txf, valdConf, valAddr, stateSource, logger)
+	listen(ctx, cliCtx, txf, valdConf, valAddr, stateSource, stateDir, NewJournal(journalDB), logger)
 	logger.Info("shutting down")
 	return nil
 }
@@ -161,16 +197,56 @@ func cleanUp() {
 	}
 }
 
+// startMetricsServer exposes the prometheus metrics of the process at /metrics on the given address
+func startMetricsServer(listenAddr string, logger log.Logger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Addr: listenAddr, Handler: mux}
+
+	go func() {
+		logger.Info(fmt.Sprintf("serving metrics at http://%s/metrics", listenAddr))
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error(sdkerrors.Wrap(err, "metrics server failed").Error())
+		}
+	}()
+
+	cleanupCommands = append(cleanupCommands, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to shut down metrics server").Error())
+		}
+	})
+}
+
 func setPersistentFlags(cmd *cobra.Command) {
 	defaultConf := tssTypes.DefaultConfig()
 	cmd.PersistentFlags().String("tofnd-host", defaultConf.Host, "host name for tss daemon")
 	cmd.PersistentFlags().String("tofnd-port", defaultConf.Port, "port for tss daemon")
 	cmd.PersistentFlags().String("tofnd-dial-timeout", defaultConf.DialTimeout.String(), "dialup timeout to the tss daemon")
+	cmd.PersistentFlags().Bool("tofnd-tls", defaultConf.TLS, "use TLS for the connection to the tss daemon")
+	cmd.PersistentFlags().String("tofnd-tls-ca-cert", defaultConf.TLSCACert, "CA certificate to verify the tss daemon's certificate (defaults to the system's root CAs)")
+	cmd.PersistentFlags().String("tofnd-tls-client-cert", defaultConf.TLSClientCert, "client certificate for mutual TLS with the tss daemon")
+	cmd.PersistentFlags().String("tofnd-tls-client-key", defaultConf.TLSClientKey, "client key for mutual TLS with the tss daemon")
+	cmd.PersistentFlags().String("tofnd-tls-server-name", defaultConf.TLSServerName, "server name to verify the tss daemon's certificate (defaults to the tofnd host)")
 	cmd.PersistentFlags().String("validator-addr", "", "the address of the validator operator, i.e axelarvaloper1..")
+	cmd.PersistentFlags().String("dry-run-output", "", "JSON-lines file for the msgs of a dry run (default \"<home>/vald/dry-run.jsonl\")")
 	cmd.PersistentFlags().String(flags.FlagChainID, app.Name, "The network chain ID")
 }
 
-func listen(ctx context.Context, clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, stateSource ReadWriter, logger log.Logger) {
+// bindTofndFlags binds the tofnd connection flags to the tss config
+func bindTofndFlags(v *viper.Viper, cmd *cobra.Command) error {
+	for _, flag := range []string{"tofnd-host", "tofnd-port", "tofnd-dial-timeout", "tofnd-tls", "tofnd-tls-ca-cert", "tofnd-tls-client-cert", "tofnd-tls-client-key", "tofnd-tls-server-name"} {
+		if err := v.BindPFlag("tss."+flag, cmd.PersistentFlags().Lookup(flag)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listen(ctx context.Context, clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, stateSource ReadWriter, stateDir string, journal Journal, logger log.Logger) {
 	encCfg := app.MakeEncodingConfig()
 	cdc := encCfg.Amino
 	sender, err := clientCtx.Keyring.Key(clientCtx.From)
@@ -181,25 +257,42 @@ func listen(ctx context.Context, clientCtx sdkClient.Context, txf tx.Factory, ax
 		WithFromAddress(sender.GetAddress()).
 		WithFromName(sender.GetName())
 
-	bc := createRefundableBroadcaster(txf, clientCtx, axelarCfg, logger)
+	robustClient := createRobustClient(clientCtx)
 
-	robustClient := tendermint.NewRobustClient(func() (rpcclient.Client, error) {
-		cl, err := sdkClient.NewClientFromNode(clientCtx.NodeURI)
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to create a new client")
-		}
+	// proxyBC broadcasts msgs that are not refundable, so they must be signed by the proxy account itself
+	var bc, proxyBC broadcast.Broadcaster
+	if axelarCfg.DryRunConfig.Enabled {
+		bc = createDryRunBroadcaster(clientCtx, axelarCfg.DryRunConfig, logger)
+		proxyBC = bc
+	} else {
+		bc, proxyBC = createRefundableBroadcaster(txf, clientCtx, axelarCfg, robustClient, logger)
+	}
 
-		err = cl.Start()
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to start client")
-		}
-		return cl, nil
-	})
 	tssMgr := createTSSMgr(bc, clientCtx, axelarCfg, logger, valAddr.String(), cdc)
 
-	evmMgr := createEVMMgr(axelarCfg, clientCtx, bc, logger, cdc, valAddr)
 	multisigMgr := createMultisigMgr(bc, clientCtx, axelarCfg, logger, valAddr)
 
+	var auditLog audit.Log = audit.NoOp{}
+	if axelarCfg.AuditConfig.Enabled {
+		auditLog = createAuditLog(clientCtx, axelarCfg.AuditConfig, logger)
+		multisigMgr.SetAuditLog(auditLog)
+	}
+
+	chainMgrs, err := chains.CreateAll(chains.Deps{
+		ClientCtx:        clientCtx,
+		Config:           axelarCfg,
+		Broadcaster:      bc,
+		ProxyBroadcaster: proxyBC,
+		Validator:        valAddr,
+		Cdc:              cdc,
+		AuditLog:         auditLog,
+		StateDir:         stateDir,
+		Logger:           logger,
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	nodeHeight, err := waitTillNetworkSync(axelarCfg, robustClient, logger)
 	if err != nil {
 		panic(err)
@@ -211,7 +304,7 @@ func listen(ctx context.Context, clientCtx sdkClient.Context, txf tx.Factory, ax
 		panic(err)
 	}
 
-	eventBus := createEventBus(robustClient, startBlock, logger)
+	eventBus := createEventBus(robustClient, tmEvents.NewBlockNotifier(robustClient, logger).StartingAt(startBlock), logger)
 	subscribe := func(eventType, module, action string) <-chan tmEvents.ABCIEventWithHeight {
 		return eventBus.Subscribe(func(e tmEvents.ABCIEventWithHeight) bool {
 			event := tmEvents.Map(e)
@@ -231,11 +324,14 @@ func listen(ctx context.Context, clientCtx sdkClient.Context, txf tx.Factory, ax
 
 	heartbeat := subscribe(tssTypes.EventTypeHeartBeat, tssTypes.ModuleName, tssTypes.AttributeValueSend)
 
-	evmNewChain := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ChainAdded]())
-	evmDepConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmDepositStarted]())
-	evmTokConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmTokenStarted]())
-	evmTraConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmKeyTransferStarted]())
-	evmGatewayTxConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmGatewayTxStarted]())
+	var chainSubscriptions []chains.Subscription
+	var chainSubs []<-chan tmEvents.ABCIEventWithHeight
+	for _, chainMgr := range chainMgrs {
+		for _, subscription := range chainMgr.Subscriptions() {
+			chainSubscriptions = append(chainSubscriptions, subscription)
+			chainSubs = append(chainSubs, eventBus.Subscribe(subscription.Filter))
+		}
+	}
 
 	multisigKeygen := eventBus.Subscribe(tmEvents.Filter[*multisigTypes.KeygenStarted]())
 	multisigSigning := eventBus.Subscribe(tmEvents.Filter[*multisigTypes.SigningStarted]())
@@ -252,6 +348,10 @@ func listen(ctx context.Context, clientCtx sdkClient.Context, txf tx.Factory, ax
 		logger.Info("stopping subscribers...")
 		<-mgr.Done()
 		logger.Info("subscriptions stopped")
+
+		for _, chainMgr := range chainMgrs {
+			chainMgr.Stop()
+		}
 	})
 
 	fetchEvents := func(ctx context.Context) error {
@@ -265,29 +365,77 @@ func listen(ctx context.Context, clientCtx sdkClient.Context, txf tx.Factory, ax
 	}
 
 	processBlockHeader := func(event tmEvents.Event) error {
-		return stateStore.SetState(event.Height)
+		multisigMgr.ProcessNewBlockHeight(event.Height)
+
+		if err := stateStore.SetState(event.Height); err != nil {
+			return err
+		}
+
+		// vald only re-processes events starting at the stored height, so older journal entries are not needed anymore
+		if err := journal.Prune(event.Height); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to prune the event journal").Error())
+		}
+
+		telemetry.SetGauge(float32(event.Height), "vald", "block_height")
+		return nil
 	}
 
 	js := []jobs.Job{
 		fetchEvents,
 		createJob(blockHeaderSub, processBlockHeader, cancelEventCtx, logger),
-		createJob(heartbeat, tssMgr.ProcessHeartBeatEvent, cancelEventCtx, logger),
-		createJobTyped(evmNewChain, evmMgr.ProcessNewChain, cancelEventCtx, logger),
-		createJobTyped(evmDepConf, evmMgr.ProcessDepositConfirmation, cancelEventCtx, logger),
-		createJobTyped(evmTokConf, evmMgr.ProcessTokenConfirmation, cancelEventCtx, logger),
-		createJobTyped(evmTraConf, evmMgr.ProcessTransferKeyConfirmation, cancelEventCtx, logger),
-		createJobTyped(evmGatewayTxConf, evmMgr.ProcessGatewayTxConfirmation, cancelEventCtx, logger),
-		createJobTyped(multisigKeygen, multisigMgr.ProcessKeygenStarted, cancelEventCtx, logger),
-		createJobTyped(multisigSigning, multisigMgr.ProcessSigningStarted, cancelEventCtx, logger),
+		createJob(heartbeat, WithJournal(journal, func(e tmEvents.Event) string { return HeartbeatKey(e.Height) }, func(e tmEvents.Event) int64 { return e.Height }, tssMgr.ProcessHeartBeatEvent, logger), cancelEventCtx, logger),
+		createJournaledJobTyped(multisigKeygen, func(e *multisigTypes.KeygenStarted) string { return KeygenKey(e.KeyID.String()) }, multisigMgr.ProcessKeygenStarted, journal, cancelEventCtx, logger),
+		createJournaledJobTyped(multisigSigning, func(e *multisigTypes.SigningStarted) string { return SigningKey(e.SigID) }, multisigMgr.ProcessSigningStarted, journal, cancelEventCtx, logger),
+	}
+
+	for i, subscription := range chainSubscriptions {
+		js = append(js, createSubscriptionJob(chainSubs[i], subscription, journal, cancelEventCtx, logger))
+	}
+	for _, chainMgr := range chainMgrs {
+		js = append(js, chainMgr.Start)
 	}
 
 	mgr.AddJobs(js...)
 	<-mgr.Done()
 }
 
+// createSubscriptionJob returns a job that processes the events of a chain manager's subscription
+func createSubscriptionJob(sub <-chan tmEvents.ABCIEventWithHeight, subscription chains.Subscription, journal Journal, cancel context.CancelFunc, logger log.Logger) jobs.Job {
+	process := subscription.Process
+	if subscription.JournalKey != nil {
+		process = func(e tmEvents.ABCIEventWithHeight) error {
+			key, err := subscription.JournalKey(e)
+			if err != nil {
+				return err
+			}
+
+			return WithJournal(journal, func(tmEvents.ABCIEventWithHeight) string { return key }, eventHeight, subscription.Process, logger)(e)
+		}
+	}
+
+	return func(ctx context.Context) error {
+		processWithLog := func(e tmEvents.ABCIEventWithHeight) {
+			countEvent(subscription.EventType)
+			if err := process(e); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		consume := tmEvents.Consume(sub, processWithLog)
+		err := consume(ctx)
+		if err != nil {
+			cancel()
+			return err
+		}
+
+		return nil
+	}
+}
+
 func createJob(sub <-chan tmEvents.ABCIEventWithHeight, processor func(event tmEvents.Event) error, cancel context.CancelFunc, logger log.Logger) jobs.Job {
 	return func(ctx context.Context) error {
 		processWithLog := func(e tmEvents.ABCIEventWithHeight) {
+			countEvent(e.Event.Type)
 			err := processor(tmEvents.Map(e))
 			if err != nil {
 				logger.Error(err.Error())
@@ -303,11 +451,13 @@ func createJob(sub <-chan tmEvents.ABCIEventWithHeight, processor func(event tmE
 	}
 }
 
-func createJobTyped[T proto.Message](sub <-chan tmEvents.ABCIEventWithHeight, processor func(event T) error, cancel context.CancelFunc, logger log.Logger) jobs.Job {
+// createJournaledJobTyped returns a job that processes typed events and records the outcome in the journal under the given key
+func createJournaledJobTyped[T proto.Message](sub <-chan tmEvents.ABCIEventWithHeight, key func(event T) string, processor func(event T) error, journal Journal, cancel context.CancelFunc, logger log.Logger) jobs.Job {
 	return func(ctx context.Context) error {
 		processWithLog := func(e tmEvents.ABCIEventWithHeight) {
 			event := funcs.Must(sdk.ParseTypedEvent(e.Event)).(T)
-			err := processor(event)
+			countEvent(proto.MessageName(event))
+			err := WithJournal(journal, key, func(T) int64 { return e.Height }, processor, logger)(event)
 			if err != nil {
 				logger.Error(err.Error())
 			}
@@ -324,6 +474,14 @@ func createJobTyped[T proto.Message](sub <-chan tmEvents.ABCIEventWithHeight, pr
 	}
 }
 
+func eventHeight(e tmEvents.ABCIEventWithHeight) int64 {
+	return e.Height
+}
+
+func countEvent(eventType string) {
+	telemetry.IncrCounterWithLabels([]string{"vald", "events", "received"}, 1, []metrics.Label{telemetry.NewLabel("type", eventType)})
+}
+
 // Wait until the node has synced with the network and return the node height
 func waitTillNetworkSync(cfg config.ValdConfig, tmClient tmEvents.SyncInfoClient, logger log.Logger) (int64, error) {
 	for {
@@ -361,11 +519,9 @@ func getStartBlock(cfg config.ValdConfig, stateStore StateStore, nodeHeight int6
 
 	logger.Info(fmt.Sprintf("node is synced, node height: %d", nodeHeight))
 
+	// The block at the stored height might have only been processed partially by vald, so process it again.
+	// The journal ensures that polls, signing sessions, keygens and heartbeats that have already been handled are skipped.
 	startBlock := storedHeight
-	if startBlock != 0 {
-		// The block at the stored height might have already been processed by vald, so skip it
-		startBlock++
-	}
 
 	if startBlock != 0 && nodeHeight-startBlock > cfg.MaxBlocksBehindLatest {
 		logger.Info(fmt.Sprintf("stored block height %d is too old, starting from the latest block", startBlock))
@@ -375,90 +531,198 @@ func getStartBlock(cfg config.ValdConfig, stateStore StateStore, nodeHeight int6
 	return startBlock, nil
 }
 
-func createEventBus(client *tendermint.RobustClient, startBlock int64, logger log.Logger) *tmEvents.Bus {
-	notifier := tmEvents.NewBlockNotifier(client, logger).StartingAt(startBlock)
+func createRobustClient(clientCtx sdkClient.Context) *tendermint.RobustClient {
+	return tendermint.NewRobustClient(func() (rpcclient.Client, error) {
+		cl, err := sdkClient.NewClientFromNode(clientCtx.NodeURI)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create a new client")
+		}
+
+		err = cl.Start()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to start client")
+		}
+		return cl, nil
+	})
+}
+
+func createEventBus(client tmEvents.BlockResultClient, notifier tmEvents.BlockNotifier, logger log.Logger) *tmEvents.Bus {
 	return tmEvents.NewEventBus(tmEvents.NewBlockSource(client, notifier, logger), pubsub.NewBus[tmEvents.ABCIEventWithHeight](), logger)
 }
 
-func createRefundableBroadcaster(txf tx.Factory, ctx sdkClient.Context, axelarCfg config.ValdConfig, logger log.Logger) broadcast.Broadcaster {
+// createRefundableBroadcaster returns a broadcaster for refundable msgs that distributes them across all broadcaster accounts,
+// and a broadcaster for other msgs that shares the proxy account's sequence tracking with it
+func createRefundableBroadcaster(txf tx.Factory, ctx sdkClient.Context, axelarCfg config.ValdConfig, tmClient tmEvents.SyncInfoClient, logger log.Logger) (broadcast.Broadcaster, broadcast.Broadcaster) {
+	proxy := createAccountBroadcaster(txf, ctx, axelarCfg, false, logger)
+	pool := []broadcast.Broadcaster{proxy}
+	for _, account := range axelarCfg.BroadcastConfig.Accounts {
+		info, err := ctx.Keyring.Key(account)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "failed to read broadcaster account %s from keyring", account))
+		}
+
+		accountCtx := ctx.
+			WithFromAddress(info.GetAddress()).
+			WithFromName(info.GetName())
+		pool = append(pool, createAccountBroadcaster(txf, accountCtx, axelarCfg, true, logger.With("broadcaster", info.GetAddress().String())))
+	}
+	if len(pool) > 1 {
+		logger.Info(fmt.Sprintf("broadcasting with a pool of %d accounts", len(pool)))
+	}
+
+	broadcaster := broadcast.Pooled(pool...)
+	broadcaster = broadcast.WithRefund(broadcaster)
+	broadcaster = broadcast.WithMetrics(broadcaster)
+	if axelarCfg.BroadcastConfig.PersistentBacklog {
+		broadcaster = createPersistentBacklog(broadcaster, ctx, axelarCfg, tmClient, logger)
+	}
+	broadcaster = broadcast.SuppressExecutionErrs(broadcaster, logger)
+
+	return broadcaster, broadcast.SuppressExecutionErrs(broadcast.WithMetrics(proxy), logger)
+}
+
+// createAccountBroadcaster returns a broadcaster with its own sequence tracking for the account set in the given context.
+// Accounts other than the proxy execute the msgs on the proxy's behalf.
+func createAccountBroadcaster(txf tx.Factory, ctx sdkClient.Context, axelarCfg config.ValdConfig, onBehalfOfProxy bool, logger log.Logger) broadcast.Broadcaster {
 	broadcaster := broadcast.WithStateManager(ctx, txf, logger, broadcast.WithResponseTimeout(axelarCfg.BroadcastConfig.MaxTimeout))
+	if onBehalfOfProxy {
+		broadcaster = broadcast.WithAuthzExec(broadcaster, ctx.GetFromAddress())
+	}
+	if axelarCfg.BroadcastConfig.MaxGasPrice != "" {
+		broadcaster = createDynamicGasPrice(broadcaster, txf, axelarCfg.BroadcastConfig, logger)
+	}
 	broadcaster = broadcast.WithRetry(broadcaster, axelarCfg.MaxRetries, axelarCfg.MinSleepBeforeRetry, logger)
 	broadcaster = broadcast.Batched(broadcaster, axelarCfg.BatchThreshold, axelarCfg.BatchSizeLimit, logger)
-	broadcaster = broadcast.WithRefund(broadcaster)
-	broadcaster = broadcast.SuppressExecutionErrs(broadcaster, logger)
 
 	return broadcaster
 }
 
-func createMultisigMgr(broadcaster broadcast.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, logger log.Logger, valAddr sdk.ValAddress) *multisig.Mgr {
-	conn, err := grpc.Connect(axelarCfg.TssConfig.Host, axelarCfg.TssConfig.Port, axelarCfg.TssConfig.DialTimeout, logger)
+func createDynamicGasPrice(broadcaster broadcast.Broadcaster, txf tx.Factory, cfg config.BroadcastConfig, logger log.Logger) broadcast.Broadcaster {
+	maxGasPrice, err := sdk.ParseDecCoin(cfg.MaxGasPrice)
 	if err != nil {
-		panic(sdkerrors.Wrap(err, "failed to create multisig manager"))
+		panic(sdkerrors.Wrap(err, "invalid max gas price"))
+	}
+
+	minGasPrice := sdk.NewDecCoinFromDec(maxGasPrice.Denom, txf.GasPrices().AmountOf(maxGasPrice.Denom))
+	if !minGasPrice.IsPositive() || minGasPrice.Amount.GT(maxGasPrice.Amount) {
+		panic(fmt.Errorf("max gas price %s must be above the gas price %s", maxGasPrice, txf.GasPrices()))
 	}
-	logger.Debug("successful connection to tofnd gRPC server")
 
-	return multisig.NewMgr(tofnd.NewMultisigClient(conn), cliCtx, valAddr, logger, broadcaster, timeout)
+	increase := sdk.MustNewDecFromStr(strconv.FormatFloat(cfg.GasPriceIncrease, 'f', -1, 64))
+	decay := sdk.MustNewDecFromStr(strconv.FormatFloat(cfg.GasPriceDecay, 'f', -1, 64))
+	if increase.LTE(sdk.OneDec()) || !decay.IsPositive() || decay.GTE(sdk.OneDec()) {
+		panic(fmt.Errorf("gas price increase must be above 1 and gas price decay must be between 0 and 1"))
+	}
+
+	return broadcast.WithDynamicGasPrice(broadcaster, minGasPrice, maxGasPrice, increase, decay, logger)
 }
 
-func createTSSMgr(broadcaster broadcast.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, logger log.Logger, valAddr string, cdc *codec.LegacyAmino) *tss.Mgr {
-	create := func() (*tss.Mgr, error) {
-		conn, err := tss.Connect(axelarCfg.TssConfig.Host, axelarCfg.TssConfig.Port, axelarCfg.TssConfig.DialTimeout, logger)
+func createPersistentBacklog(broadcaster broadcast.Broadcaster, ctx sdkClient.Context, cfg config.ValdConfig, tmClient tmEvents.SyncInfoClient, logger log.Logger) broadcast.Broadcaster {
+	db, err := dbm.NewDB("broadcast_backlog", dbm.GoLevelDBBackend, filepath.Join(ctx.HomeDir, "vald"))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "failed to open the persistent broadcast backlog"))
+	}
+	cleanupCommands = append(cleanupCommands, func() {
+		if err := db.Close(); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to close the persistent broadcast backlog").Error())
+		}
+	})
+
+	latestHeight := func(ctx context.Context) (int64, error) {
+		syncInfo, err := tmClient.LatestSyncInfo(ctx)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
-		logger.Debug("successful connection to tofnd gRPC server")
 
-		// creates client to communicate with the external tofnd process service
-		multiSigClient := tofnd.NewMultisigClient(conn)
+		return syncInfo.LatestBlockHeight, nil
+	}
 
-		tssMgr := tss.NewMgr(multiSigClient, cliCtx, 2*time.Hour, valAddr, broadcaster, logger, cdc)
+	return broadcast.WithPersistentBacklog(broadcaster, db, ctx.Codec, latestHeight, cfg.BroadcastConfig.BacklogMaxAge, cfg.BatchSizeLimit, logger)
+}
 
-		return tssMgr, nil
+func createDryRunBroadcaster(ctx sdkClient.Context, cfg config.DryRunConfig, logger log.Logger) broadcast.Broadcaster {
+	output := cfg.Output
+	if output == "" {
+		output = filepath.Join(ctx.HomeDir, "vald", "dry-run.jsonl")
 	}
 
-	mgr, err := create()
+	f, err := os.OpenFile(output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, RW)
 	if err != nil {
-		panic(sdkerrors.Wrap(err, "failed to create tss manager"))
+		panic(sdkerrors.Wrap(err, "failed to open dry-run output file"))
 	}
+	cleanupCommands = append(cleanupCommands, func() {
+		if err := f.Close(); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to close dry-run output file").Error())
+		}
+	})
 
-	return mgr
+	logger.Info(fmt.Sprintf("dry run: msgs are written to %s instead of being broadcast", output))
+
+	return broadcast.WithMetrics(NewDryRunBroadcaster(f, ctx.Codec))
 }
 
-func createEVMMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broadcast.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino, valAddr sdk.ValAddress) *evm.Mgr {
-	rpcs := make(map[string]evmRPC.Client)
+func createAuditLog(ctx sdkClient.Context, cfg config.AuditConfig, logger log.Logger) audit.Log {
+	output := cfg.Output
+	if output == "" {
+		output = filepath.Join(ctx.HomeDir, "vald", "audit.jsonl")
+	}
 
-	for _, evmChainConf := range axelarCfg.EVMConfig {
-		if !evmChainConf.WithBridge {
-			logger.Debug(fmt.Sprintf("RPC connection is disabled for EVM chain %s. Skipping...", evmChainConf.Name))
-			continue
+	auditLog, err := audit.NewFileLog(output, cfg.MaxSize, cfg.MaxBackups)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "failed to create audit log"))
+	}
+	cleanupCommands = append(cleanupCommands, func() {
+		if err := auditLog.Close(); err != nil {
+			logger.Error(sdkerrors.Wrap(err, "failed to close audit log").Error())
 		}
+	})
 
-		if _, found := rpcs[strings.ToLower(evmChainConf.Name)]; found {
-			msg := fmt.Errorf("duplicate bridge configuration found for EVM chain %s", evmChainConf.Name)
-			logger.Error(msg.Error())
-			panic(msg)
-		}
+	logger.Info(fmt.Sprintf("recording votes and signatures in audit log %s", output))
 
-		rpc, err := evmRPC.NewClient(evmChainConf.RPCAddr)
+	return auditLog
+}
+
+func createMultisigMgr(broadcaster broadcast.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, logger log.Logger, valAddr sdk.ValAddress) *multisig.Mgr {
+	client, err := createTofndClient(axelarCfg, logger)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "failed to create multisig manager"))
+	}
+
+	return multisig.NewMgr(client, cliCtx, valAddr, logger, broadcaster, timeout)
+}
+
+// createTofndClient returns a tofnd client that reconnects to tofnd if the connection breaks
+func createTofndClient(axelarCfg config.ValdConfig, logger log.Logger) (*grpc.ManagedClient, error) {
+	dial := func() (*gogrpc.ClientConn, error) { return grpc.Connect(axelarCfg.TssConfig, logger) }
+
+	client, err := grpc.NewManagedClient(dial, grpc.DefaultManagedClientConfig(axelarCfg.TssConfig.DialTimeout), logger)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug("successful connection to tofnd gRPC server")
+
+	return client, nil
+}
+
+func createTSSMgr(broadcaster broadcast.Broadcaster, cliCtx client.Context, axelarCfg config.ValdConfig, logger log.Logger, valAddr string, cdc *codec.LegacyAmino) *tss.Mgr {
+	create := func() (*tss.Mgr, error) {
+		// creates client to communicate with the external tofnd process service
+		multiSigClient, err := createTofndClient(axelarCfg, logger)
 		if err != nil {
-			err = sdkerrors.Wrap(err, fmt.Sprintf("Failed to create an RPC connection for EVM chain %s. Verify your RPC config.", evmChainConf.Name))
-			logger.Error(err.Error())
-			panic(err)
+			return nil, err
 		}
-		logger.Debug(fmt.Sprintf("created JSON-RPC client of type %T", rpc),
-			"chain", evmChainConf.Name,
-			"url", evmChainConf.RPCAddr,
-		)
 
-		// clean up evmRPC connection on process shutdown
-		cleanupCommands = append(cleanupCommands, rpc.Close)
+		tssMgr := tss.NewMgr(multiSigClient, multisigTypes.NewQueryServiceClient(cliCtx), cliCtx, 2*time.Hour, valAddr, broadcaster, logger, cdc)
 
-		rpcs[strings.ToLower(evmChainConf.Name)] = rpc
-		logger.Info(fmt.Sprintf("Successfully connected to EVM bridge for chain %s", evmChainConf.Name))
+		return tssMgr, nil
 	}
 
-	evmMgr := evm.NewMgr(rpcs, cliCtx, b, logger, cdc, valAddr)
-	return evmMgr
+	mgr, err := create()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "failed to create tss manager"))
+	}
+
+	return mgr
 }
 
 // RWFile implements the ReadWriter interface for an underlying file
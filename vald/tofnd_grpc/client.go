@@ -2,20 +2,93 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"os"
 	"time"
 
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/libs/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
 )
 
 // Connect connects to tofnd gRPC Server
-func Connect(host string, port string, timeout time.Duration, logger log.Logger) (*grpc.ClientConn, error) {
-	serverAddr := fmt.Sprintf("%s:%s", host, port)
-	logger.Info(fmt.Sprintf("initiate connection to tofnd gRPC server: %s", serverAddr))
+func Connect(cfg tss.TssConfig, logger log.Logger) (*grpc.ClientConn, error) {
+	transport, err := TransportOption(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	serverAddr := fmt.Sprintf("%s:%s", cfg.Host, cfg.Port)
+	logger.Info(fmt.Sprintf("initiate connection to tofnd gRPC server: %s", serverAddr), "tls", cfg.TLS, "mutual_tls", cfg.TLS && cfg.TLSClientCert != "")
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
 	defer cancel()
 
-	return grpc.DialContext(ctx, serverAddr, grpc.WithInsecure(), grpc.WithBlock())
+	return grpc.DialContext(ctx, serverAddr, transport, grpc.WithBlock(), grpc.WithUnaryInterceptor(MeasureLatency))
+}
+
+// TransportOption returns the dial option that secures the connection to tofnd as configured
+func TransportOption(cfg tss.TssConfig) (grpc.DialOption, error) {
+	if !cfg.TLS {
+		if cfg.TLSCACert != "" || cfg.TLSClientCert != "" || cfg.TLSClientKey != "" {
+			return nil, fmt.Errorf("tofnd TLS certificates are configured, but TLS is disabled")
+		}
+
+		return grpc.WithInsecure(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.TLSServerName,
+	}
+
+	if cfg.TLSCACert != "" {
+		bz, err := os.ReadFile(cfg.TLSCACert)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to read tofnd CA certificate")
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("no valid certificate found in tofnd CA certificate file %s", cfg.TLSCACert)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	switch {
+	case cfg.TLSClientCert != "" && cfg.TLSClientKey != "":
+		cert, err := tls.LoadX509KeyPair(cfg.TLSClientCert, cfg.TLSClientKey)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to load tofnd client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case cfg.TLSClientCert != "" || cfg.TLSClientKey != "":
+		return nil, fmt.Errorf("mutual TLS with tofnd requires both a client certificate and key")
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// MeasureLatency is a gRPC interceptor that measures the latency of unary calls to tofnd
+func MeasureLatency(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	status := "success"
+	if err != nil {
+		status = "failure"
+	}
+	metrics.MeasureSinceWithLabels([]string{"vald", "tofnd", "latency"}, start, []metrics.Label{
+		telemetry.NewLabel("method", method),
+		telemetry.NewLabel("status", status),
+	})
+
+	return err
 }
@@ -0,0 +1,110 @@
+package grpc_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	tofndgrpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+)
+
+func TestTransportOption(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCert(t, dir)
+
+	t.Run("should connect insecurely when TLS is disabled", func(t *testing.T) {
+		_, err := tofndgrpc.TransportOption(tss.DefaultConfig())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail when certificates are set but TLS is disabled", func(t *testing.T) {
+		cfg := tss.DefaultConfig()
+		cfg.TLSCACert = certFile
+
+		_, err := tofndgrpc.TransportOption(cfg)
+		assert.Error(t, err)
+	})
+
+	t.Run("should use the system's root CAs when no CA certificate is set", func(t *testing.T) {
+		cfg := tss.DefaultConfig()
+		cfg.TLS = true
+
+		_, err := tofndgrpc.TransportOption(cfg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should load the CA certificate and client key pair", func(t *testing.T) {
+		cfg := tss.DefaultConfig()
+		cfg.TLS = true
+		cfg.TLSCACert = certFile
+		cfg.TLSClientCert = certFile
+		cfg.TLSClientKey = keyFile
+
+		_, err := tofndgrpc.TransportOption(cfg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail when the CA certificate does not exist", func(t *testing.T) {
+		cfg := tss.DefaultConfig()
+		cfg.TLS = true
+		cfg.TLSCACert = filepath.Join(dir, "missing.pem")
+
+		_, err := tofndgrpc.TransportOption(cfg)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail when the CA certificate is invalid", func(t *testing.T) {
+		cfg := tss.DefaultConfig()
+		cfg.TLS = true
+		cfg.TLSCACert = keyFile
+
+		_, err := tofndgrpc.TransportOption(cfg)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail when only the client certificate is set", func(t *testing.T) {
+		cfg := tss.DefaultConfig()
+		cfg.TLS = true
+		cfg.TLSClientCert = certFile
+
+		_, err := tofndgrpc.TransportOption(cfg)
+		assert.Error(t, err)
+	})
+}
+
+func writeSelfSignedCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "tofnd"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	assert.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0600))
+	assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	return certFile, keyFile
+}
@@ -0,0 +1,270 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils"
+)
+
+var _ tofnd.MultisigClient = &ManagedClient{}
+
+// Dialer establishes a new connection to tofnd
+type Dialer func() (*grpc.ClientConn, error)
+
+// ManagedClientConfig configures how the ManagedClient retries requests while tofnd is unavailable
+type ManagedClientConfig struct {
+	// BackOff computes the wait time between retries
+	BackOff utils.BackOff
+	// MaxBackOff caps the wait time between retries
+	MaxBackOff time.Duration
+	// MaxFailures is the number of consecutive failures after which the circuit opens, circuit breaking is disabled if it is not positive
+	MaxFailures int
+	// OpenPeriod is the time the circuit stays open before tofnd is tried again
+	OpenPeriod time.Duration
+}
+
+// DefaultManagedClientConfig returns the default configuration of the ManagedClient
+func DefaultManagedClientConfig(minTimeout time.Duration) ManagedClientConfig {
+	return ManagedClientConfig{
+		BackOff:     utils.LinearBackOff(minTimeout),
+		MaxBackOff:  time.Minute,
+		MaxFailures: 5,
+		OpenPeriod:  30 * time.Second,
+	}
+}
+
+// ManagedClient is a tofnd multisig client that re-establishes the connection to tofnd when it breaks.
+// Requests that fail because tofnd is unavailable are retried with back-off until their context expires,
+// so the request deadline should not exceed the expiry of the corresponding keygen or signing session.
+// After too many consecutive failures the circuit opens, and all requests wait for the open period to pass
+// instead of reconnecting to tofnd. Requests that expire before the circuit closes again fail immediately.
+type ManagedClient struct {
+	dial   Dialer
+	config ManagedClientConfig
+	logger log.Logger
+
+	lock      sync.Mutex
+	conn      *grpc.ClientConn
+	client    tofnd.MultisigClient
+	failures  int
+	openUntil time.Time
+}
+
+// NewManagedClient returns a new ManagedClient instance and establishes the initial connection to tofnd
+func NewManagedClient(dial Dialer, config ManagedClientConfig, logger log.Logger) (*ManagedClient, error) {
+	c := &ManagedClient{
+		dial:   dial,
+		config: config,
+		logger: logger,
+	}
+
+	if _, err := c.getClient(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// KeyPresence checks if tofnd holds the key with the given ID
+func (c *ManagedClient) KeyPresence(ctx context.Context, in *tofnd.KeyPresenceRequest, opts ...grpc.CallOption) (*tofnd.KeyPresenceResponse, error) {
+	var res *tofnd.KeyPresenceResponse
+	err := c.call(ctx, func(client tofnd.MultisigClient) error {
+		var err error
+		res, err = client.KeyPresence(ctx, in, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Keygen generates a new key in tofnd
+func (c *ManagedClient) Keygen(ctx context.Context, in *tofnd.KeygenRequest, opts ...grpc.CallOption) (*tofnd.KeygenResponse, error) {
+	var res *tofnd.KeygenResponse
+	err := c.call(ctx, func(client tofnd.MultisigClient) error {
+		var err error
+		res, err = client.Keygen(ctx, in, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Sign signs the given payload with a key held by tofnd
+func (c *ManagedClient) Sign(ctx context.Context, in *tofnd.SignRequest, opts ...grpc.CallOption) (*tofnd.SignResponse, error) {
+	var res *tofnd.SignResponse
+	err := c.call(ctx, func(client tofnd.MultisigClient) error {
+		var err error
+		res, err = client.Sign(ctx, in, opts...)
+		return err
+	})
+
+	return res, err
+}
+
+// Close closes the current connection to tofnd
+func (c *ManagedClient) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn = nil
+	c.client = nil
+
+	return err
+}
+
+func (c *ManagedClient) call(ctx context.Context, f func(client tofnd.MultisigClient) error) error {
+	for i := 0; ; i++ {
+		if err := c.waitForCircuit(ctx); err != nil {
+			return err
+		}
+
+		client, err := c.getClient()
+		if err == nil {
+			err = f(client)
+			if !isUnavailable(err) {
+				c.recordSuccess()
+				return err
+			}
+
+			c.disconnect(client, err)
+		}
+		c.recordFailure()
+
+		timeout := c.backOff(i)
+		c.logger.Info(sdkerrors.Wrapf(err, "tofnd is unavailable (retry in %v)", timeout).Error(), "num_attempts", i+1)
+
+		select {
+		case <-ctx.Done():
+			return sdkerrors.Wrapf(err, "tofnd unavailable until the request expired after %d attempts", i+1)
+		case <-time.After(timeout):
+		}
+	}
+}
+
+func (c *ManagedClient) backOff(retryCount int) time.Duration {
+	timeout := c.config.BackOff(retryCount)
+	if c.config.MaxBackOff > 0 && timeout > c.config.MaxBackOff {
+		return c.config.MaxBackOff
+	}
+
+	return timeout
+}
+
+// waitForCircuit blocks while the circuit is open. It fails immediately if the request expires before the circuit closes
+func (c *ManagedClient) waitForCircuit(ctx context.Context) error {
+	c.lock.Lock()
+	openUntil := c.openUntil
+	c.lock.Unlock()
+
+	wait := time.Until(openUntil)
+	if wait <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(openUntil) {
+		return fmt.Errorf("tofnd circuit is open until %s, after the request expires", openUntil.String())
+	}
+
+	select {
+	case <-ctx.Done():
+		return sdkerrors.Wrap(ctx.Err(), "request expired while the tofnd circuit was open")
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+func (c *ManagedClient) recordSuccess() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.failures >= c.config.MaxFailures && c.config.MaxFailures > 0 {
+		c.logger.Info("tofnd circuit closed")
+		telemetry.SetGauge(0, "vald", "tofnd", "circuit_open")
+	}
+	c.failures = 0
+}
+
+// recordFailure opens the circuit when the number of consecutive failures reaches the limit.
+// While the circuit is half-open after the open period, a single failure opens it again.
+func (c *ManagedClient) recordFailure() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.failures++
+	if c.config.MaxFailures <= 0 || c.failures < c.config.MaxFailures || time.Now().Before(c.openUntil) {
+		return
+	}
+
+	c.openUntil = time.Now().Add(c.config.OpenPeriod)
+
+	c.logger.Error(fmt.Sprintf("tofnd circuit opened after %d consecutive failures, retrying at %s", c.failures, c.openUntil.String()))
+	telemetry.SetGauge(1, "vald", "tofnd", "circuit_open")
+	telemetry.IncrCounter(1, "vald", "tofnd", "circuit_opened")
+}
+
+// getClient returns the client of the current connection, or establishes a new connection if there is none
+func (c *ManagedClient) getClient() (tofnd.MultisigClient, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	conn, err := c.dial()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to connect to tofnd")
+	}
+
+	c.conn = conn
+	c.client = tofnd.NewMultisigClient(conn)
+
+	c.logger.Info("connected to tofnd")
+	telemetry.SetGauge(1, "vald", "tofnd", "connected")
+	telemetry.IncrCounter(1, "vald", "tofnd", "connects")
+
+	return c.client, nil
+}
+
+// disconnect drops the connection of the given client, unless it has already been replaced by another caller
+func (c *ManagedClient) disconnect(client tofnd.MultisigClient, reason error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.client != client {
+		return
+	}
+
+	if err := c.conn.Close(); err != nil {
+		c.logger.Debug(sdkerrors.Wrap(err, "failed to close tofnd connection").Error())
+	}
+	c.conn = nil
+	c.client = nil
+
+	c.logger.Error(fmt.Sprintf("lost connection to tofnd: %s", reason.Error()))
+	telemetry.SetGauge(0, "vald", "tofnd", "connected")
+	metrics.IncrCounterWithLabels([]string{"vald", "tofnd", "disconnects"}, 1, []metrics.Label{
+		telemetry.NewLabel("code", status.Code(reason).String()),
+	})
+}
+
+func isUnavailable(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
@@ -0,0 +1,204 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	tofndgrpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+)
+
+type multisigServer struct {
+	tofnd.UnimplementedMultisigServer
+}
+
+func (multisigServer) Sign(context.Context, *tofnd.SignRequest) (*tofnd.SignResponse, error) {
+	return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: []byte("signature")}}, nil
+}
+
+// tofndServer is an in-memory tofnd that can be restarted
+type tofndServer struct {
+	lock     sync.Mutex
+	listener *bufconn.Listener
+	server   *grpc.Server
+}
+
+func (s *tofndServer) start() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.listener = bufconn.Listen(1024 * 1024)
+	s.server = grpc.NewServer()
+	tofnd.RegisterMultisigServer(s.server, multisigServer{})
+
+	go func(server *grpc.Server, listener net.Listener) { _ = server.Serve(listener) }(s.server, s.listener)
+}
+
+func (s *tofndServer) stop() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.server.Stop()
+	s.listener = nil
+}
+
+func (s *tofndServer) dial() (*grpc.ClientConn, error) {
+	s.lock.Lock()
+	listener := s.listener
+	s.lock.Unlock()
+
+	if listener == nil {
+		return nil, fmt.Errorf("tofnd is down")
+	}
+
+	return grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+}
+
+func TestManagedClient(t *testing.T) {
+	config := tofndgrpc.DefaultManagedClientConfig(10 * time.Millisecond)
+	config.MaxFailures = 0
+
+	t.Run("should fail if tofnd cannot be reached initially", func(t *testing.T) {
+		server := &tofndServer{}
+
+		_, err := tofndgrpc.NewManagedClient(server.dial, config, log.TestingLogger())
+		assert.Error(t, err)
+	})
+
+	t.Run("should reconnect when tofnd restarts", func(t *testing.T) {
+		server := &tofndServer{}
+		server.start()
+
+		client, err := tofndgrpc.NewManagedClient(server.dial, config, log.TestingLogger())
+		assert.NoError(t, err)
+		defer client.Close()
+
+		_, err = client.Sign(context.Background(), &tofnd.SignRequest{})
+		assert.NoError(t, err)
+
+		server.stop()
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			server.start()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		res, err := client.Sign(ctx, &tofnd.SignRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("signature"), res.GetSignature())
+		server.stop()
+	})
+
+	t.Run("should give up when the request expires", func(t *testing.T) {
+		server := &tofndServer{}
+		server.start()
+
+		client, err := tofndgrpc.NewManagedClient(server.dial, config, log.TestingLogger())
+		assert.NoError(t, err)
+		defer client.Close()
+
+		server.stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err = client.Sign(ctx, &tofnd.SignRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("should not retry errors returned by tofnd", func(t *testing.T) {
+		server := &tofndServer{}
+		server.start()
+		defer server.stop()
+
+		client, err := tofndgrpc.NewManagedClient(server.dial, config, log.TestingLogger())
+		assert.NoError(t, err)
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Keygen is not implemented by the test server
+		_, err = client.Keygen(ctx, &tofnd.KeygenRequest{})
+		assert.Error(t, err)
+		assert.NoError(t, ctx.Err())
+	})
+
+	t.Run("should cap the back-off", func(t *testing.T) {
+		server := &tofndServer{}
+		server.start()
+
+		config := config
+		config.BackOff = func(int) time.Duration { return time.Hour }
+		config.MaxBackOff = 10 * time.Millisecond
+
+		client, err := tofndgrpc.NewManagedClient(server.dial, config, log.TestingLogger())
+		assert.NoError(t, err)
+		defer client.Close()
+
+		server.stop()
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			server.start()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err = client.Sign(ctx, &tofnd.SignRequest{})
+		assert.NoError(t, err)
+		server.stop()
+	})
+
+	t.Run("should stop connecting to tofnd while the circuit is open", func(t *testing.T) {
+		server := &tofndServer{}
+		server.start()
+
+		var dials int
+		dial := func() (*grpc.ClientConn, error) {
+			dials++
+			return server.dial()
+		}
+
+		config := config
+		config.MaxFailures = 2
+		config.OpenPeriod = time.Hour
+
+		client, err := tofndgrpc.NewManagedClient(dial, config, log.TestingLogger())
+		assert.NoError(t, err)
+		defer client.Close()
+
+		server.stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		_, err = client.Sign(ctx, &tofnd.SignRequest{})
+		assert.Error(t, err)
+		dialsBeforeOpen := dials
+
+		server.start()
+		defer server.stop()
+
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err = client.Sign(ctx, &tofnd.SignRequest{})
+		assert.Error(t, err)
+		assert.NoError(t, ctx.Err())
+		assert.Equal(t, dialsBeforeOpen, dials)
+	})
+}
@@ -6,6 +6,7 @@ package mock
 import (
 	"context"
 	"github.com/axelarnetwork/axelar-core/vald/tss/rpc"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	"google.golang.org/grpc"
 	"sync"
@@ -197,3 +198,80 @@ func (mock *MultiSigClientMock) SignCalls() []struct {
 	mock.lockSign.RUnlock()
 	return calls
 }
+
+// Ensure, that KeyQueryClientMock does implement rpc.KeyQueryClient.
+// If this is not the case, regenerate this file with moq.
+var _ rpc.KeyQueryClient = &KeyQueryClientMock{}
+
+// KeyQueryClientMock is a mock implementation of rpc.KeyQueryClient.
+//
+// 	func TestSomethingThatUsesKeyQueryClient(t *testing.T) {
+//
+// 		// make and configure a mocked rpc.KeyQueryClient
+// 		mockedKeyQueryClient := &KeyQueryClientMock{
+// 			KeyFunc: func(ctx context.Context, in *multisig.KeyRequest, opts ...grpc.CallOption) (*multisig.KeyResponse, error) {
+// 				panic("mock out the Key method")
+// 			},
+// 		}
+//
+// 		// use mockedKeyQueryClient in code that requires rpc.KeyQueryClient
+// 		// and then make assertions.
+//
+// 	}
+type KeyQueryClientMock struct {
+	// KeyFunc mocks the Key method.
+	KeyFunc func(ctx context.Context, in *multisig.KeyRequest, opts ...grpc.CallOption) (*multisig.KeyResponse, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Key holds details about calls to the Key method.
+		Key []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *multisig.KeyRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+	}
+	lockKey sync.RWMutex
+}
+
+// Key calls KeyFunc.
+func (mock *KeyQueryClientMock) Key(ctx context.Context, in *multisig.KeyRequest, opts ...grpc.CallOption) (*multisig.KeyResponse, error) {
+	if mock.KeyFunc == nil {
+		panic("KeyQueryClientMock.KeyFunc: method is nil but KeyQueryClient.Key was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *multisig.KeyRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockKey.Lock()
+	mock.calls.Key = append(mock.calls.Key, callInfo)
+	mock.lockKey.Unlock()
+	return mock.KeyFunc(ctx, in, opts...)
+}
+
+// KeyCalls gets all the calls that were made to Key.
+// Check the length with:
+//     len(mockedKeyQueryClient.KeyCalls())
+func (mock *KeyQueryClientMock) KeyCalls() []struct {
+	Ctx  context.Context
+	In   *multisig.KeyRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *multisig.KeyRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockKey.RLock()
+	calls = mock.calls.Key
+	mock.lockKey.RUnlock()
+	return calls
+}
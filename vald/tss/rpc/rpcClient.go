@@ -1,9 +1,22 @@
 package rpc
 
-import "github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+)
+
+//go:generate moq -pkg mock -out ./mock/rpcClient.go . MultiSigClient KeyQueryClient
 
-//go:generate moq -pkg mock -out ./mock/rpcClient.go . MultiSigClient
 // MultiSigClient defines the interface of a grpc client to communicate with tofnd Multisig service
 type MultiSigClient interface {
 	tofnd.MultisigClient
 }
+
+// KeyQueryClient defines the interface of a grpc client to query multisig keys from axelar
+type KeyQueryClient interface {
+	Key(ctx context.Context, in *multisig.KeyRequest, opts ...grpc.CallOption) (*multisig.KeyResponse, error)
+}
@@ -2,18 +2,23 @@ package tss
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"time"
 
 	sdkClient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/libs/log"
 	"google.golang.org/grpc"
 
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
 	"github.com/axelarnetwork/axelar-core/vald/parse"
+	tofndgrpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
 	"github.com/axelarnetwork/axelar-core/vald/tss/rpc"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/exported"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
@@ -23,6 +28,7 @@ import (
 // Mgr represents an object that manages all communication with the external tss process
 type Mgr struct {
 	multiSigClient rpc.MultiSigClient
+	keyQueryClient rpc.KeyQueryClient
 	cliCtx         sdkClient.Context
 	principalAddr  string
 	keys           map[string][][]byte
@@ -33,19 +39,15 @@ type Mgr struct {
 }
 
 // Connect connects to tofnd gRPC Server
-func Connect(host string, port string, timeout time.Duration, logger log.Logger) (*grpc.ClientConn, error) {
-	tofndServerAddress := host + ":" + port
-	logger.Info(fmt.Sprintf("initiate connection to tofnd gRPC server: %s", tofndServerAddress))
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-
-	return grpc.DialContext(ctx, tofndServerAddress, grpc.WithInsecure(), grpc.WithBlock())
+func Connect(cfg tss.TssConfig, logger log.Logger) (*grpc.ClientConn, error) {
+	return tofndgrpc.Connect(cfg, logger)
 }
 
 // NewMgr returns a new tss manager instance
-func NewMgr(multiSigClient rpc.MultiSigClient, cliCtx sdkClient.Context, timeout time.Duration, principalAddr string, broadcaster broadcast.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *Mgr {
+func NewMgr(multiSigClient rpc.MultiSigClient, keyQueryClient rpc.KeyQueryClient, cliCtx sdkClient.Context, timeout time.Duration, principalAddr string, broadcaster broadcast.Broadcaster, logger log.Logger, cdc *codec.LegacyAmino) *Mgr {
 	return &Mgr{
 		multiSigClient: multiSigClient,
+		keyQueryClient: keyQueryClient,
 		cliCtx:         cliCtx,
 		Timeout:        timeout,
 		principalAddr:  principalAddr,
@@ -56,79 +58,138 @@ func NewMgr(multiSigClient rpc.MultiSigClient, cliCtx sdkClient.Context, timeout
 	}
 }
 
-// ProcessHeartBeatEvent broadcasts the heartbeat
+// ProcessHeartBeatEvent verifies that tofnd is alive and holds the key shares of all active keys and broadcasts the heartbeat
 func (mgr *Mgr) ProcessHeartBeatEvent(e tmEvents.Event) error {
-	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
+	if err := mgr.checkTofndLiveness(); err != nil {
+		return err
+	}
+
+	keyInfos := parseHeartBeatParams(mgr.cdc, e.Attributes)
+
+	var present []exported.KeyID
+	var missing []exported.KeyID
+	for _, keyInfo := range keyInfos {
+		if keyInfo.KeyType != exported.Multisig {
+			return fmt.Errorf("unrecognized key type %s", keyInfo.KeyType.SimpleString())
+		}
+
+		pubKey, ok, err := mgr.getPubKey(keyInfo.KeyID)
+		if err != nil {
+			// the key share cannot be verified, so it must not be acknowledged
+			mgr.Logger.Error(sdkerrors.Wrapf(err, "failed to query multisig key %s", keyInfo.KeyID).Error())
+			missing = append(missing, keyInfo.KeyID)
+			continue
+		}
+
+		if !ok {
+			mgr.Logger.Debug(fmt.Sprintf("operator %s does not participate in multisig key %s", mgr.principalAddr, keyInfo.KeyID))
+			continue
+		}
+
+		isPresent, err := mgr.isKeyPresent(keyInfo.KeyID, pubKey)
+		if err != nil {
+			return err
+		}
+
+		if isPresent {
+			present = append(present, keyInfo.KeyID)
+		} else {
+			missing = append(missing, keyInfo.KeyID)
+		}
+	}
+
+	telemetry.SetGauge(float32(len(missing)), "vald", "tofnd", "missing_key_shares")
+	for _, keyID := range missing {
+		mgr.Logger.Error(fmt.Sprintf("tofnd is MISSING the key share of operator %s for active multisig key %s, signing sessions for this key will be missed", mgr.principalAddr, keyID))
+	}
+
+	tssMsg := tss.NewHeartBeatRequest(mgr.cliCtx.FromAddress, present)
+
+	mgr.Logger.Info(fmt.Sprintf("operator %s sending heartbeat acknowledging keys: %s", mgr.principalAddr, present))
+	if _, err := mgr.broadcaster.Broadcast(context.TODO(), tssMsg); err != nil {
+		return sdkerrors.Wrap(err, "handler goroutine: failure to broadcast outgoing heartbeat msg")
+	}
+
+	if len(missing) == 0 {
+		mgr.Logger.Info(fmt.Sprintf("no keygen/signing issues reported for operator %s", mgr.principalAddr))
+	}
+
+	return nil
+}
+
+// checkTofndLiveness verifies that tofnd is reachable and set up correctly, independent of any active keys
+func (mgr *Mgr) checkTofndLiveness() error {
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
 	defer cancel()
 
 	// tofnd health check using a dummy ID
-	// TODO: we should have a specific GRPC to do this diagnostic
-	request := &tofnd.KeyPresenceRequest{
+	response, err := mgr.multiSigClient.KeyPresence(ctx, &tofnd.KeyPresenceRequest{
 		KeyUid: "dummyID",
 		PubKey: []byte{},
-	}
-
-	response, err := mgr.multiSigClient.KeyPresence(grpcCtx, request)
+	})
 	if err != nil {
 		return sdkerrors.Wrapf(err, "failed to invoke KeyPresence grpc")
 	}
 
 	switch response.Response {
-	case tofnd.RESPONSE_UNSPECIFIED, tofnd.RESPONSE_FAIL:
-		return sdkerrors.Wrap(err, "tofnd not set up correctly")
 	case tofnd.RESPONSE_PRESENT, tofnd.RESPONSE_ABSENT:
-		// tofnd is working properly
+		return nil
+	case tofnd.RESPONSE_UNSPECIFIED, tofnd.RESPONSE_FAIL:
+		return fmt.Errorf("tofnd not set up correctly")
 	default:
-		return sdkerrors.Wrap(err, "unknown tofnd response")
+		return fmt.Errorf("unknown tofnd response %s", response.Response.String())
 	}
+}
 
-	// check for keys presence according to the IDs included in the event
-	keyInfos := parseHeartBeatParams(mgr.cdc, e.Attributes)
-	var present []exported.KeyID
-
-	for _, keyInfo := range keyInfos {
-
-		grpcCtx, cancel = context.WithTimeout(context.Background(), mgr.Timeout)
-		defer cancel()
+// getPubKey returns the operator's public key of the given multisig key, or false if the operator is not a participant
+func (mgr *Mgr) getPubKey(keyID exported.KeyID) (multisig.PublicKey, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
+	defer cancel()
 
-		switch keyInfo.KeyType {
-		case exported.Multisig:
-			request = &tofnd.KeyPresenceRequest{
-				KeyUid: fmt.Sprintf("%s_%d", string(keyInfo.KeyID), 0),
-				PubKey: []byte{},
-			}
+	res, err := mgr.keyQueryClient.Key(ctx, &multisigTypes.KeyRequest{KeyID: multisig.KeyID(keyID)})
+	if err != nil {
+		return nil, false, err
+	}
 
-			response, err = mgr.multiSigClient.KeyPresence(grpcCtx, request)
-		default:
-			return sdkerrors.Wrapf(err, fmt.Sprintf("unrecognize key type %s", keyInfo.KeyType.SimpleString()))
+	for _, participant := range res.Participants {
+		if participant.Address != mgr.principalAddr {
+			continue
 		}
 
+		pubKey, err := hex.DecodeString(participant.PubKey)
 		if err != nil {
-			return sdkerrors.Wrapf(err, "failed to invoke KeyPresence grpc")
+			return nil, false, sdkerrors.Wrapf(err, "invalid public key of operator %s", mgr.principalAddr)
 		}
 
-		switch response.Response {
-		case tofnd.RESPONSE_UNSPECIFIED, tofnd.RESPONSE_FAIL:
-			return sdkerrors.Wrap(err, "tofnd not set up correctly")
-		case tofnd.RESPONSE_ABSENT:
-			// key is absent
-		case tofnd.RESPONSE_PRESENT:
-			present = append(present, keyInfo.KeyID)
-		default:
-			return sdkerrors.Wrap(err, "unknown tofnd response")
-		}
+		return pubKey, true, nil
 	}
 
-	tssMsg := tss.NewHeartBeatRequest(mgr.cliCtx.FromAddress, present)
+	return nil, false, nil
+}
 
-	mgr.Logger.Info(fmt.Sprintf("operator %s sending heartbeat acknowledging keys: %s", mgr.principalAddr, present))
-	if _, err := mgr.broadcaster.Broadcast(context.TODO(), tssMsg); err != nil {
-		return sdkerrors.Wrap(err, "handler goroutine: failure to broadcast outgoing heartbeat msg")
-	}
+// isKeyPresent checks if tofnd holds the key share for the given multisig key and public key
+func (mgr *Mgr) isKeyPresent(keyID exported.KeyID, pubKey multisig.PublicKey) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.Timeout)
+	defer cancel()
 
-	mgr.Logger.Info(fmt.Sprintf("no keygen/signing issues reported for operator %s", mgr.principalAddr))
+	response, err := mgr.multiSigClient.KeyPresence(ctx, &tofnd.KeyPresenceRequest{
+		KeyUid: fmt.Sprintf("%s_%d", string(keyID), 0),
+		PubKey: pubKey,
+	})
+	if err != nil {
+		return false, sdkerrors.Wrapf(err, "failed to invoke KeyPresence grpc")
+	}
 
-	return nil
+	switch response.Response {
+	case tofnd.RESPONSE_PRESENT:
+		return true, nil
+	case tofnd.RESPONSE_ABSENT:
+		return false, nil
+	case tofnd.RESPONSE_UNSPECIFIED, tofnd.RESPONSE_FAIL:
+		return false, fmt.Errorf("tofnd not set up correctly")
+	default:
+		return false, fmt.Errorf("unknown tofnd response %s", response.Response.String())
+	}
 }
 
 func parseHeartBeatParams(cdc *codec.LegacyAmino, attributes map[string]string) []tss.KeyInfo {
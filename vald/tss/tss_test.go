@@ -1,14 +1,29 @@
 package tss
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"net"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	rpcmock "github.com/axelarnetwork/axelar-core/vald/tss/rpc/mock"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/axelar-core/x/tss/exported"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	tss "github.com/axelarnetwork/axelar-core/x/tss/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
 )
 
 func TestGRPCTimeout(t *testing.T) {
@@ -40,3 +55,136 @@ func TestGRPCTimeout(t *testing.T) {
 		assert.Equal(t, context.DeadlineExceeded, err)
 	})
 }
+
+func TestMgr_ProcessHeartBeatEvent(t *testing.T) {
+	var (
+		mgr            *Mgr
+		multiSigClient *rpcmock.MultiSigClientMock
+		keyQueryClient *rpcmock.KeyQueryClientMock
+		broadcaster    *mock.BroadcasterMock
+		principalAddr  string
+		pubKeys        map[exported.KeyID]multisig.PublicKey
+		absentKeys     map[exported.KeyID]bool
+	)
+
+	setup := func() {
+		principalAddr = rand.ValAddr().String()
+		pubKeys = make(map[exported.KeyID]multisig.PublicKey)
+		absentKeys = make(map[exported.KeyID]bool)
+
+		keyQueryClient = &rpcmock.KeyQueryClientMock{
+			KeyFunc: func(_ context.Context, in *multisigTypes.KeyRequest, _ ...grpc.CallOption) (*multisigTypes.KeyResponse, error) {
+				participants := []multisigTypes.KeygenParticipant{{Address: rand.ValAddr().String(), PubKey: multisig.PublicKey(rand.Bytes(33)).String()}}
+				if pubKey, ok := pubKeys[exported.KeyID(in.KeyID)]; ok {
+					participants = append(participants, multisigTypes.KeygenParticipant{Address: principalAddr, PubKey: pubKey.String()})
+				}
+
+				return &multisigTypes.KeyResponse{KeyID: in.KeyID, Participants: participants}, nil
+			},
+		}
+		multiSigClient = &rpcmock.MultiSigClientMock{
+			KeyPresenceFunc: func(_ context.Context, in *tofnd.KeyPresenceRequest, _ ...grpc.CallOption) (*tofnd.KeyPresenceResponse, error) {
+				for keyID, pubKey := range pubKeys {
+					if in.KeyUid == fmt.Sprintf("%s_0", keyID) && bytes.Equal(pubKey, in.PubKey) && !absentKeys[keyID] {
+						return &tofnd.KeyPresenceResponse{Response: tofnd.RESPONSE_PRESENT}, nil
+					}
+				}
+
+				return &tofnd.KeyPresenceResponse{Response: tofnd.RESPONSE_ABSENT}, nil
+			},
+		}
+		broadcaster = &mock.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return nil, nil },
+		}
+
+		mgr = NewMgr(multiSigClient, keyQueryClient, client.Context{FromAddress: rand.AccAddr()}, time.Second, principalAddr, broadcaster, log.TestingLogger(), tss.ModuleCdc.LegacyAmino)
+	}
+
+	heartbeat := func(keyIDs ...exported.KeyID) tmEvents.Event {
+		var keyInfos []tss.KeyInfo
+		for _, keyID := range keyIDs {
+			keyInfos = append(keyInfos, tss.KeyInfo{KeyID: keyID, KeyType: exported.Multisig})
+		}
+
+		return tmEvents.Event{Attributes: map[string]string{tss.AttributeKeyKeyInfos: string(tss.ModuleCdc.LegacyAmino.MustMarshalJSON(keyInfos))}}
+	}
+
+	acknowledgedKeys := func() []exported.KeyID {
+		assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		return broadcaster.BroadcastCalls()[0].Msgs[0].(*tss.HeartBeatRequest).KeyIDs
+	}
+
+	t.Run("should acknowledge all keys the operator holds a key share for", func(t *testing.T) {
+		setup()
+		keyID1, keyID2 := exported.KeyID(rand.StrBetween(5, 20)), exported.KeyID(rand.StrBetween(5, 20))
+		pubKeys[keyID1] = rand.Bytes(33)
+		pubKeys[keyID2] = rand.Bytes(33)
+
+		assert.NoError(t, mgr.ProcessHeartBeatEvent(heartbeat(keyID1, keyID2)))
+		assert.Equal(t, []exported.KeyID{keyID1, keyID2}, acknowledgedKeys())
+		// one liveness check and one check per key
+		assert.Len(t, multiSigClient.KeyPresenceCalls(), 3)
+	})
+
+	t.Run("should not check keys the operator does not participate in", func(t *testing.T) {
+		setup()
+		keyID := exported.KeyID(rand.StrBetween(5, 20))
+
+		assert.NoError(t, mgr.ProcessHeartBeatEvent(heartbeat(keyID)))
+		assert.Empty(t, acknowledgedKeys())
+		assert.Len(t, multiSigClient.KeyPresenceCalls(), 1)
+	})
+
+	t.Run("should not acknowledge keys that cannot be queried", func(t *testing.T) {
+		setup()
+		keyID1, keyID2 := exported.KeyID(rand.StrBetween(5, 20)), exported.KeyID(rand.StrBetween(5, 20))
+		pubKeys[keyID1] = rand.Bytes(33)
+		pubKeys[keyID2] = rand.Bytes(33)
+
+		queryKey := keyQueryClient.KeyFunc
+		keyQueryClient.KeyFunc = func(ctx context.Context, in *multisigTypes.KeyRequest, opts ...grpc.CallOption) (*multisigTypes.KeyResponse, error) {
+			if exported.KeyID(in.KeyID) == keyID2 {
+				return nil, fmt.Errorf("query failed")
+			}
+
+			return queryKey(ctx, in, opts...)
+		}
+
+		assert.NoError(t, mgr.ProcessHeartBeatEvent(heartbeat(keyID1, keyID2)))
+		assert.Equal(t, []exported.KeyID{keyID1}, acknowledgedKeys())
+	})
+
+	t.Run("should check tofnd liveness when there are no active keys", func(t *testing.T) {
+		setup()
+		multiSigClient.KeyPresenceFunc = func(context.Context, *tofnd.KeyPresenceRequest, ...grpc.CallOption) (*tofnd.KeyPresenceResponse, error) {
+			return nil, fmt.Errorf("tofnd unavailable")
+		}
+
+		assert.Error(t, mgr.ProcessHeartBeatEvent(heartbeat()))
+		assert.Len(t, multiSigClient.KeyPresenceCalls(), 1)
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	})
+
+	t.Run("should not acknowledge keys with missing key shares", func(t *testing.T) {
+		setup()
+		keyID1, keyID2 := exported.KeyID(rand.StrBetween(5, 20)), exported.KeyID(rand.StrBetween(5, 20))
+		pubKeys[keyID1] = rand.Bytes(33)
+		pubKeys[keyID2] = rand.Bytes(33)
+		absentKeys[keyID2] = true
+
+		assert.NoError(t, mgr.ProcessHeartBeatEvent(heartbeat(keyID1, keyID2)))
+		assert.Equal(t, []exported.KeyID{keyID1}, acknowledgedKeys())
+	})
+
+	t.Run("should fail if tofnd is not set up correctly", func(t *testing.T) {
+		setup()
+		keyID := exported.KeyID(rand.StrBetween(5, 20))
+		pubKeys[keyID] = rand.Bytes(33)
+		multiSigClient.KeyPresenceFunc = func(context.Context, *tofnd.KeyPresenceRequest, ...grpc.CallOption) (*tofnd.KeyPresenceResponse, error) {
+			return &tofnd.KeyPresenceResponse{Response: tofnd.RESPONSE_FAIL}, nil
+		}
+
+		assert.Error(t, mgr.ProcessHeartBeatEvent(heartbeat(keyID)))
+		assert.Len(t, broadcaster.BroadcastCalls(), 0)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	antetypes "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/axelarnetwork/axelar-core/x/ante/types"
 	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
@@ -34,7 +35,10 @@ func NewCheckRefundFeeDecorator(registry cdctypes.InterfaceRegistry, ak antetype
 
 // AnteHandle record qualified refund for the multiSig and vote transactions
 func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	msgs := tx.GetMsgs()
+	msgs, err := unwrapAuthzExec(tx.GetMsgs())
+	if err != nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
 	if !anyRefundable(msgs) {
 		return next(ctx, tx, simulate)
@@ -66,6 +70,27 @@ func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// unwrapAuthzExec replaces authz executions with the msgs they execute, so refunds also apply to msgs that are
+// broadcast by an account on behalf of the proxy
+func unwrapAuthzExec(msgs []sdk.Msg) ([]sdk.Msg, error) {
+	var unwrapped []sdk.Msg
+	for _, msg := range msgs {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			unwrapped = append(unwrapped, msg)
+			continue
+		}
+
+		innerMsgs, err := exec.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+		unwrapped = append(unwrapped, innerMsgs...)
+	}
+
+	return unwrapped, nil
+}
+
 func anyRefundable(msgs []sdk.Msg) bool {
 	if len(msgs) == 0 {
 		return false
@@ -0,0 +1,77 @@
+package ante
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+// RestrictedAuthzExec restricts authz executions to refundable messages
+type RestrictedAuthzExec struct {
+	registry   cdctypes.InterfaceRegistry
+	decorators []sdk.AnteDecorator
+}
+
+// NewRestrictedAuthzExec constructor for RestrictedAuthzExec.
+// The given decorators check the messages that are executed through authz as if they had been sent directly.
+func NewRestrictedAuthzExec(registry cdctypes.InterfaceRegistry, decorators ...sdk.AnteDecorator) RestrictedAuthzExec {
+	return RestrictedAuthzExec{
+		registry:   registry,
+		decorators: decorators,
+	}
+}
+
+// AnteHandle fails the transaction if it executes any message on behalf of another account that is not refundable.
+// The ante handlers of the chain only see the authz execution itself, so the executed messages are checked again by the decorators of this handler.
+func (d RestrictedAuthzExec) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var executed []sdk.Msg
+	for _, msg := range tx.GetMsgs() {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+
+		innerMsgs, err := exec.GetMessages()
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		for _, innerMsg := range innerMsgs {
+			refundMsg, ok := innerMsg.(*rewardtypes.RefundMsgRequest)
+			if !ok {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("message type %T cannot be executed on behalf of another account", innerMsg))
+			}
+
+			if !msgRegistered(d.registry, refundMsg.InnerMessage.TypeUrl) {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("message type %s cannot be executed on behalf of another account", refundMsg.InnerMessage.TypeUrl))
+			}
+		}
+
+		executed = append(executed, innerMsgs...)
+	}
+
+	if len(executed) > 0 && len(d.decorators) > 0 {
+		checkExecuted := sdk.ChainAnteDecorators(d.decorators...)
+		if _, err := checkExecuted(ctx, executedTx{Tx: tx, msgs: executed}, simulate); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// executedTx is a view of a tx that only contains the messages executed through authz
+type executedTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+}
+
+// GetMsgs returns the executed messages
+func (tx executedTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
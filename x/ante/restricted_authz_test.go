@@ -0,0 +1,121 @@
+package ante_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/ante"
+	"github.com/axelarnetwork/axelar-core/x/ante/types/mock"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	votetypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestRestrictedAuthzExec(t *testing.T) {
+	var (
+		handler ante.RestrictedAuthzExec
+		tx      *mock.TxMock
+	)
+
+	proxy := rand.AccAddr()
+	grantee := rand.AccAddr()
+
+	txWithMsgs := func(msgs ...sdk.Msg) func() {
+		return func() {
+			tx = &mock.TxMock{
+				GetMsgsFunc: func() []sdk.Msg { return msgs },
+			}
+		}
+	}
+
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+
+	refundableMsg := func() sdk.Msg {
+		return rewardtypes.NewRefundMsgRequest(proxy, votetypes.NewVoteRequest(proxy, vote.PollID(rand.PosI64()), &evm.VoteEvents{}))
+	}
+
+	letTxThrough := func(t *testing.T) {
+		_, err := handler.AnteHandle(sdk.Context{}, tx, false,
+			func(sdk.Context, sdk.Tx, bool) (sdk.Context, error) { return sdk.Context{}, nil })
+		assert.NoError(t, err)
+	}
+
+	stopTx := func(t *testing.T) {
+		_, err := handler.AnteHandle(sdk.Context{}, tx, false,
+			func(sdk.Context, sdk.Tx, bool) (sdk.Context, error) { return sdk.Context{}, nil })
+		assert.Error(t, err)
+	}
+
+	Given("a restricted authz exec ante handler", func() {
+		handler = ante.NewRestrictedAuthzExec(app.MakeEncodingConfig().InterfaceRegistry)
+	}).Branch(
+		When("tx does not contain an authz exec", txWithMsgs(&banktypes.MsgSend{}, refundableMsg())).
+			Then("let the tx through", letTxThrough),
+
+		When("authz exec only contains refundable msgs", txWithMsgs(exec(refundableMsg(), refundableMsg()), exec(refundableMsg()))).
+			Then("let the tx through", letTxThrough),
+
+		When("authz exec contains a msg that is not refundable", txWithMsgs(exec(refundableMsg(), &banktypes.MsgSend{}))).
+			Then("stop tx", stopTx),
+
+		When("authz exec contains a refund request for an msg that is not refundable",
+			txWithMsgs(exec(rewardtypes.NewRefundMsgRequest(proxy, &evm.LinkRequest{})))).
+			Then("stop tx", stopTx),
+	).Run(t)
+
+	var checked []sdk.Msg
+	checkExecuted := func(err error) sdk.AnteDecorator {
+		return decorator(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+			checked = append(checked, tx.GetMsgs()...)
+			if err != nil {
+				return ctx, err
+			}
+
+			return next(ctx, tx, simulate)
+		})
+	}
+
+	msg1, msg2 := refundableMsg(), refundableMsg()
+
+	Given("a restricted authz exec ante handler that checks executed msgs", func() {
+		checked = nil
+		handler = ante.NewRestrictedAuthzExec(app.MakeEncodingConfig().InterfaceRegistry, checkExecuted(nil))
+	}).
+		When("authz exec contains refundable msgs", txWithMsgs(&banktypes.MsgSend{}, exec(msg1), exec(msg2))).
+		Then("check the executed msgs", func(t *testing.T) {
+			letTxThrough(t)
+			assert.Equal(t, []sdk.Msg{msg1, msg2}, checked)
+		}).Run(t)
+
+	Given("a restricted authz exec ante handler that rejects executed msgs", func() {
+		checked = nil
+		handler = ante.NewRestrictedAuthzExec(app.MakeEncodingConfig().InterfaceRegistry, checkExecuted(fmt.Errorf("rejected")))
+	}).Branch(
+		When("tx does not contain an authz exec", txWithMsgs(refundableMsg())).
+			Then("let the tx through", func(t *testing.T) {
+				letTxThrough(t)
+				assert.Empty(t, checked)
+			}),
+
+		When("authz exec contains refundable msgs", txWithMsgs(exec(refundableMsg()))).
+			Then("stop tx", stopTx),
+	).Run(t)
+}
+
+type decorator func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error)
+
+func (d decorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return d(ctx, tx, simulate, next)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+var _ nexus.TransferTracer = transferTracer{}
+
+type transferTracer struct {
+	keeper types.BaseKeeper
+}
+
+// NewTransferTracer returns the tracer of cross-chain transfers to cosmos chains.
+// Deposits on cosmos chains are not linked to their source txs, so only the destination of transfers is traced
+func NewTransferTracer(keeper types.BaseKeeper) nexus.TransferTracer {
+	return transferTracer{keeper: keeper}
+}
+
+// TraceSourceTx returns no records because deposits on cosmos chains are not linked to their source txs
+func (t transferTracer) TraceSourceTx(sdk.Context, nexus.ChainName, string) ([]nexus.TraceStep, []nexus.TransferID) {
+	return nil, nil
+}
+
+// TraceSource returns no records because deposits on cosmos chains are not linked to their source txs
+func (t transferTracer) TraceSource(sdk.Context, nexus.TransferID) []nexus.TraceStep {
+	return nil
+}
+
+// TraceDestination returns the IBC transfer that routes the assets of the given transfer to its recipient chain
+func (t transferTracer) TraceDestination(ctx sdk.Context, transfer nexus.CrossChainTransfer) []nexus.TraceStep {
+	ibcTransfer, ok := t.keeper.GetTransfer(ctx, transfer.ID)
+	if !ok {
+		return nil
+	}
+
+	return []nexus.TraceStep{{
+		Module: types.ModuleName,
+		Chain:  transfer.Recipient.Chain.Name,
+		Type:   "ibc_transfer",
+		ID:     ibcTransfer.ID.String(),
+		Status: ibcTransfer.Status.String(),
+	}}
+}
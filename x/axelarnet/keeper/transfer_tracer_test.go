@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/keeper"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types"
+	"github.com/axelarnetwork/axelar-core/x/axelarnet/types/mock"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+func TestTransferTracer(t *testing.T) {
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+	chain := nexus.Chain{Name: nexus.ChainName(rand.Str(5)), Module: types.ModuleName}
+	transfer := nexus.NewCrossChainTransfer(uint64(rand.PosI64()), nexus.CrossChainAddress{Chain: chain, Address: rand.AccAddr().String()}, sdk.NewCoin(rand.Denom(5, 10), sdk.NewInt(rand.PosI64())), nexus.Archived)
+
+	ibcTransfer := types.NewIBCTransfer(rand.AccAddr(), transfer.Recipient.Address, transfer.Asset, "transfer", rand.Str(5), transfer.ID)
+	ibcTransfer.Status = types.TransferCompleted
+
+	axelarnetK := &mock.BaseKeeperMock{
+		GetTransferFunc: func(_ sdk.Context, id nexus.TransferID) (types.IBCTransfer, bool) {
+			return ibcTransfer, id == ibcTransfer.ID
+		},
+	}
+	tracer := keeper.NewTransferTracer(axelarnetK)
+
+	assert.Equal(t, []nexus.TraceStep{{Module: types.ModuleName, Chain: chain.Name, Type: "ibc_transfer", ID: transfer.ID.String(), Status: types.TransferCompleted.String()}}, tracer.TraceDestination(ctx, transfer))
+
+	transfer.ID++
+	assert.Empty(t, tracer.TraceDestination(ctx, transfer))
+
+	steps, ids := tracer.TraceSourceTx(ctx, chain.Name, rand.HexStr(64))
+	assert.Empty(t, steps)
+	assert.Empty(t, ids)
+	assert.Empty(t, tracer.TraceSource(ctx, transfer.ID))
+}
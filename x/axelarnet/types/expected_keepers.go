@@ -31,6 +31,7 @@ type BaseKeeper interface {
 	GetIBCTransferQueue(ctx sdk.Context) utils.KVQueue
 	SetSeqIDMapping(ctx sdk.Context, t IBCTransfer) error
 	SetTransferFailed(ctx sdk.Context, transferID nexus.TransferID) error
+	GetTransfer(ctx sdk.Context, transferID nexus.TransferID) (IBCTransfer, bool)
 }
 
 // Nexus provides functionality to manage cross-chain transfers
@@ -48,6 +48,9 @@ var _ axelarnettypes.BaseKeeper = &BaseKeeperMock{}
 // 			GetRouteTimeoutWindowFunc: func(ctx cosmossdktypes.Context) uint64 {
 // 				panic("mock out the GetRouteTimeoutWindow method")
 // 			},
+// 			GetTransferFunc: func(ctx cosmossdktypes.Context, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID) (axelarnettypes.IBCTransfer, bool) {
+// 				panic("mock out the GetTransfer method")
+// 			},
 // 			GetTransferLimitFunc: func(ctx cosmossdktypes.Context) uint64 {
 // 				panic("mock out the GetTransferLimit method")
 // 			},
@@ -85,6 +88,9 @@ type BaseKeeperMock struct {
 	// GetRouteTimeoutWindowFunc mocks the GetRouteTimeoutWindow method.
 	GetRouteTimeoutWindowFunc func(ctx cosmossdktypes.Context) uint64
 
+	// GetTransferFunc mocks the GetTransfer method.
+	GetTransferFunc func(ctx cosmossdktypes.Context, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID) (axelarnettypes.IBCTransfer, bool)
+
 	// GetTransferLimitFunc mocks the GetTransferLimit method.
 	GetTransferLimitFunc func(ctx cosmossdktypes.Context) uint64
 
@@ -133,6 +139,13 @@ type BaseKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
 		}
+		// GetTransfer holds details about calls to the GetTransfer method.
+		GetTransfer []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// TransferID is the transferID argument value.
+			TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+		}
 		// GetTransferLimit holds details about calls to the GetTransferLimit method.
 		GetTransferLimit []struct {
 			// Ctx is the ctx argument value.
@@ -164,6 +177,7 @@ type BaseKeeperMock struct {
 	lockGetEndBlockerLimit    sync.RWMutex
 	lockGetIBCTransferQueue   sync.RWMutex
 	lockGetRouteTimeoutWindow sync.RWMutex
+	lockGetTransfer           sync.RWMutex
 	lockGetTransferLimit      sync.RWMutex
 	lockLogger                sync.RWMutex
 	lockSetSeqIDMapping       sync.RWMutex
@@ -364,6 +378,41 @@ func (mock *BaseKeeperMock) GetRouteTimeoutWindowCalls() []struct {
 	return calls
 }
 
+// GetTransfer calls GetTransferFunc.
+func (mock *BaseKeeperMock) GetTransfer(ctx cosmossdktypes.Context, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID) (axelarnettypes.IBCTransfer, bool) {
+	if mock.GetTransferFunc == nil {
+		panic("BaseKeeperMock.GetTransferFunc: method is nil but BaseKeeper.GetTransfer was just called")
+	}
+	callInfo := struct {
+		Ctx        cosmossdktypes.Context
+		TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+	}{
+		Ctx:        ctx,
+		TransferID: transferID,
+	}
+	mock.lockGetTransfer.Lock()
+	mock.calls.GetTransfer = append(mock.calls.GetTransfer, callInfo)
+	mock.lockGetTransfer.Unlock()
+	return mock.GetTransferFunc(ctx, transferID)
+}
+
+// GetTransferCalls gets all the calls that were made to GetTransfer.
+// Check the length with:
+//     len(mockedBaseKeeper.GetTransferCalls())
+func (mock *BaseKeeperMock) GetTransferCalls() []struct {
+	Ctx        cosmossdktypes.Context
+	TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+} {
+	var calls []struct {
+		Ctx        cosmossdktypes.Context
+		TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+	}
+	mock.lockGetTransfer.RLock()
+	calls = mock.calls.GetTransfer
+	mock.lockGetTransfer.RUnlock()
+	return calls
+}
+
 // GetTransferLimit calls GetTransferLimitFunc.
 func (mock *BaseKeeperMock) GetTransferLimit(ctx cosmossdktypes.Context) uint64 {
 	if mock.GetTransferLimitFunc == nil {
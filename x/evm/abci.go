@@ -1,16 +1,21 @@
 package evm
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
 )
 
 func validateChains(ctx sdk.Context, sourceChainName nexus.ChainName, destinationChainName nexus.ChainName, n types.Nexus) (nexus.Chain, nexus.Chain, error) {
@@ -67,6 +72,8 @@ func handleTokenSent(ctx sdk.Context, event types.Event, bk types.BaseKeeper, n
 		return false
 	}
 
+	sourceCk.SetEventTransferID(ctx, event.GetID(), transferID)
+
 	bk.Logger(ctx).Debug(fmt.Sprintf("enqueued transfer for event from chain %s", sourceChain.Name),
 		"chain", destinationChain.Name,
 		"eventID", event.GetID(),
@@ -278,6 +285,7 @@ func handleConfirmDeposit(ctx sdk.Context, event types.Event, ck types.ChainKeep
 	}
 
 	ck.SetDeposit(ctx, erc20Deposit, types.DepositStatus_Confirmed)
+	ck.SetEventTransferID(ctx, event.GetID(), transferID)
 
 	ck.Logger(ctx).Info(fmt.Sprintf("deposit confirmation result to %s %s", e.To.Hex(), e.Amount),
 		"chain", chain.Name,
@@ -557,6 +565,154 @@ func handleConfirmedEvents(ctx sdk.Context, bk types.BaseKeeper, n types.Nexus,
 	return nil
 }
 
+// handleSignRetries retries signing the aborted command batches of all evm chains whose scheduled retry height has been reached
+func handleSignRetries(ctx sdk.Context, bk types.BaseKeeper, n types.Nexus, multisig types.MultisigKeeper) {
+	for _, chain := range slices.Filter(n.GetChains(ctx), types.IsEVMChain) {
+		ck := bk.ForChain(chain.Name)
+
+		latest := ck.GetLatestCommandBatch(ctx)
+		if !latest.Is(types.BatchAborted) || latest.GetSignRetryHeight() == 0 || ctx.BlockHeight() < latest.GetSignRetryHeight() {
+			continue
+		}
+
+		if !n.IsChainActivated(ctx, chain) {
+			continue
+		}
+
+		retried := utils.RunCached(ctx, bk, func(cachedCtx sdk.Context) (bool, error) {
+			if err := retrySignCommandBatch(cachedCtx, ck, multisig, chain); err != nil {
+				return false, err
+			}
+
+			return true, nil
+		})
+		if !retried {
+			// count the failed retry outside the cached context so the batch is not retried forever
+			funcs.MustNoErr(latest.SetSignRetryFailed())
+			keeper.ScheduleSignRetry(ctx, ck, latest)
+		}
+	}
+}
+
+// retrySignCommandBatch starts a new signing session for the aborted latest command batch with the current key of the chain.
+// If the key was rotated since the batch was created, its commands are batched again for the current key
+func retrySignCommandBatch(ctx sdk.Context, ck types.ChainKeeper, multisig types.MultisigKeeper, chain nexus.Chain) error {
+	// the batch needs to be retrieved with the given context so its changes are rolled back on failure
+	commandBatch := ck.GetLatestCommandBatch(ctx)
+	retry := commandBatch.GetSignRetries() + 1
+
+	keyID, ok := multisig.GetCurrentKeyID(ctx, chain.Name)
+	if !ok {
+		return fmt.Errorf("current key not set for chain %s", chain.Name)
+	}
+
+	if keyID == commandBatch.GetKeyID() {
+		if err := commandBatch.SetSignRetried(); err != nil {
+			return err
+		}
+	} else {
+		// the gateway does not accept signatures of a retired key, so the batch is replaced by one for the current key
+		if err := ck.RequeueCommandBatch(ctx, keyID); err != nil {
+			return err
+		}
+
+		var err error
+		if commandBatch, err = ck.CreateNewBatchToSign(ctx); err != nil {
+			return err
+		}
+
+		if len(commandBatch.GetCommandIDs()) == 0 {
+			return fmt.Errorf("failed to batch the commands of the aborted command batch again")
+		}
+
+		commandBatch.SetSignRetries(retry)
+	}
+
+	if err := multisig.Sign(
+		ctx,
+		commandBatch.GetKeyID(),
+		commandBatch.GetSigHash().Bytes(),
+		types.ModuleName,
+		types.NewSigMetadata(types.SigCommand, chain.Name, commandBatch.GetID()),
+	); err != nil {
+		return err
+	}
+
+	batchedCommandsIDHex := hex.EncodeToString(commandBatch.GetID())
+	ck.Logger(ctx).Info(fmt.Sprintf("retrying to sign command batch %s for chain %s using key %s (retry %d)", batchedCommandsIDHex, chain.Name, commandBatch.GetKeyID(), retry),
+		types.AttributeKeyChain, chain.Name,
+		types.AttributeKeyKeyID, string(commandBatch.GetKeyID()),
+		"commandBatchID", batchedCommandsIDHex,
+	)
+
+	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(types.NewCommandBatchSignRetried(chain.Name, commandBatch.GetID(), retry, commandBatch.GetKeyID())))
+
+	return nil
+}
+
+// handleAutoBatching creates the mint commands of pending transfers and starts signing a new command batch
+// every AutoBatchInterval blocks for all evm chains that enabled it
+func handleAutoBatching(ctx sdk.Context, bk types.BaseKeeper, n types.Nexus, multisig types.MultisigKeeper) {
+	for _, chain := range slices.Filter(n.GetChains(ctx), types.IsEVMChain) {
+		ck := bk.ForChain(chain.Name)
+
+		params := ck.GetParams(ctx)
+		if params.AutoBatchInterval <= 0 || ctx.BlockHeight()%params.AutoBatchInterval != 0 {
+			continue
+		}
+
+		if !n.IsChainActivated(ctx, chain) {
+			continue
+		}
+
+		// do not process more transfers than the end blocker limit allows
+		limit := params.TransferLimit
+		if uint64(params.EndBlockerLimit) < limit {
+			limit = uint64(params.EndBlockerLimit)
+		}
+
+		// the mint commands are kept even if the batch cannot be signed, so the next batch includes them
+		_ = utils.RunCached(ctx, bk, func(cachedCtx sdk.Context) ([]abci.ValidatorUpdate, error) {
+			return nil, keeper.CreatePendingTransfers(cachedCtx, ck, n, multisig, chain, limit)
+		})
+
+		_ = utils.RunCached(ctx, bk, func(cachedCtx sdk.Context) ([]abci.ValidatorUpdate, error) {
+			return nil, signNextCommandBatch(cachedCtx, ck, multisig, chain)
+		})
+	}
+}
+
+// signNextCommandBatch starts signing a new batch of the queued commands, unless the latest batch is being signed
+// or aborted. Aborted batches are left to the signing retries so their retry limit and backoff apply
+func signNextCommandBatch(ctx sdk.Context, ck types.ChainKeeper, multisig types.MultisigKeeper, chain nexus.Chain) error {
+	if latest := ck.GetLatestCommandBatch(ctx); latest.Is(types.BatchSigning) || latest.Is(types.BatchAborted) {
+		ck.Logger(ctx).Debug(fmt.Sprintf("skipping automatic batching for chain %s due to command batch %s with status %s", chain.Name, hex.EncodeToString(latest.GetID()), latest.GetStatus()))
+		return nil
+	}
+
+	commandBatch, err := keeper.SignCommands(ctx, ck, multisig, chain)
+	if err != nil {
+		return err
+	}
+
+	if len(commandBatch.GetCommandIDs()) == 0 {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSign,
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueStart),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChain, chain.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyBatchedCommandsID, hex.EncodeToString(commandBatch.GetID())),
+			sdk.NewAttribute(types.AttributeKeyCommandsIDs, strings.Join(types.CommandIDsToStrings(commandBatch.GetCommandIDs()), ",")),
+		),
+	)
+
+	return nil
+}
+
 // BeginBlocker check for infraction evidence or downtime of validators
 // on every begin block
 func BeginBlocker(sdk.Context, abci.RequestBeginBlock, types.BaseKeeper) {}
@@ -567,5 +723,8 @@ func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, bk types.BaseKeeper, n
 		return nil, err
 	}
 
+	handleSignRetries(ctx, bk, n, multisig)
+	handleAutoBatching(ctx, bk, n, multisig)
+
 	return nil, nil
 }
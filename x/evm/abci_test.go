@@ -6,9 +6,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 	evmCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"golang.org/x/exp/maps"
@@ -26,6 +29,7 @@ import (
 	multisigTestUtils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
 	multisigTypesTestuilts "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
@@ -237,6 +241,8 @@ func TestHandleTokenSent(t *testing.T) {
 			},
 		}
 		ctx, bk, n, _, sourceCk, destinationCk = setup()
+
+		sourceCk.SetEventTransferIDFunc = func(sdk.Context, types.EventID, nexus.TransferID) {}
 	})
 
 	whenChainsAreRegistered := givenTokenSentEvent.
@@ -372,6 +378,8 @@ func TestHandleTokenSent(t *testing.T) {
 			ok := handleTokenSent(ctx, event, bk, n)
 			assert.True(t, ok)
 			assert.Len(t, n.EnqueueTransferCalls(), 1)
+			assert.Len(t, sourceCk.SetEventTransferIDCalls(), 1)
+			assert.Equal(t, event.GetID(), sourceCk.SetEventTransferIDCalls()[0].EventID)
 		}).
 		Run(t)
 
@@ -387,6 +395,8 @@ func TestHandleTokenSent(t *testing.T) {
 			ok := handleTokenSent(ctx, event, bk, n)
 			assert.True(t, ok)
 			assert.Len(t, n.EnqueueTransferCalls(), 1)
+			assert.Len(t, sourceCk.SetEventTransferIDCalls(), 1)
+			assert.Equal(t, event.GetID(), sourceCk.SetEventTransferIDCalls()[0].EventID)
 		}).
 		Run(t)
 }
@@ -738,6 +748,7 @@ func TestHandleConfirmDeposit(t *testing.T) {
 		}
 
 		sourceCk.SetDepositFunc = func(sdk.Context, types.ERC20Deposit, types.DepositStatus) {}
+		sourceCk.SetEventTransferIDFunc = func(sdk.Context, types.EventID, nexus.TransferID) {}
 	})
 
 	burnerInfoFound := func(found bool) func() {
@@ -839,6 +850,7 @@ func TestHandleConfirmDeposit(t *testing.T) {
 			assert.True(t, ok)
 			assert.Len(t, n.EnqueueForTransferCalls(), 1)
 			assert.Len(t, sourceCk.SetDepositCalls(), 1)
+			assert.Len(t, sourceCk.SetEventTransferIDCalls(), 1)
 		}).
 		Run(t)
 }
@@ -1209,6 +1221,373 @@ func TestHandleConfirmedEvent(t *testing.T) {
 		Then2(shouldSetEventFailed).Run(t)
 }
 
+func TestHandleAutoBatching(t *testing.T) {
+	var (
+		ctx            sdk.Context
+		bk             *mock.BaseKeeperMock
+		n              *mock.NexusMock
+		multisigKeeper *mock.MultisigKeeperMock
+		ck             *mock.ChainKeeperMock
+
+		params    types.Params
+		transfers []nexus.CrossChainTransfer
+		batch     types.CommandBatchMetadata
+	)
+
+	chain := nexus.Chain{Name: nexus.ChainName(rand.Str(5)), Module: types.ModuleName}
+	keyID := multisigTestUtils.KeyID()
+
+	givenEVMChain := Given("an evm chain with auto batching enabled", func() {
+		ctx, bk, n, multisigKeeper, ck, _ = setup()
+		ctx = ctx.WithBlockHeight(rand.I64Between(1, 100) * 10)
+
+		params = types.Params{
+			AutoBatchInterval: 10,
+			TransferLimit:     uint64(rand.I64Between(1, 50)),
+			EndBlockerLimit:   rand.I64Between(1, 50),
+		}
+		transfers = nil
+		batch = types.CommandBatchMetadata{}
+
+		bk.ForChainFunc = func(nexus.ChainName) types.ChainKeeper { return ck }
+		n.GetChainsFunc = func(sdk.Context) []nexus.Chain {
+			return []nexus.Chain{chain, {Name: nexus.ChainName(rand.Str(5)), Module: rand.Str(5)}}
+		}
+		n.IsChainActivatedFunc = func(sdk.Context, nexus.Chain) bool { return true }
+		n.GetTransfersForChainPaginatedFunc = func(_ sdk.Context, _ nexus.Chain, _ nexus.TransferState, pageRequest *query.PageRequest) ([]nexus.CrossChainTransfer, *query.PageResponse, error) {
+			if uint64(len(transfers)) > pageRequest.Limit {
+				return transfers[:pageRequest.Limit], nil, nil
+			}
+			return transfers, nil, nil
+		}
+		n.ArchivePendingTransferFunc = func(sdk.Context, nexus.CrossChainTransfer) {}
+		multisigKeeper.GetCurrentKeyIDFunc = func(sdk.Context, nexus.ChainName) (multisig.KeyID, bool) { return keyID, true }
+		multisigKeeper.SignFunc = func(sdk.Context, multisig.KeyID, multisig.Hash, string, ...codec.ProtoMarshaler) error { return nil }
+
+		ck.GetParamsFunc = func(sdk.Context) types.Params { return params }
+		ck.GetERC20TokenByAssetFunc = func(_ sdk.Context, asset string) types.ERC20Token {
+			return types.CreateERC20Token(func(types.ERC20TokenMetadata) {}, types.ERC20TokenMetadata{Asset: asset, Status: types.Confirmed})
+		}
+		ck.EnqueueCommandFunc = func(sdk.Context, types.Command) error { return nil }
+		ck.GetChainIDFunc = func(sdk.Context) (sdk.Int, bool) { return sdk.NewInt(1), true }
+		ck.GetLatestCommandBatchFunc = func(sdk.Context) types.CommandBatch { return types.NonExistentCommand }
+		ck.CreateNewBatchToSignFunc = func(sdk.Context) (types.CommandBatch, error) {
+			return types.NewCommandBatch(batch, func(m types.CommandBatchMetadata) { batch = m }), nil
+		}
+	})
+
+	withPendingTransfers := When("pending transfers exist", func() {
+		count := rand.I64Between(1, 100)
+		for i := int64(0); i < count; i++ {
+			transfers = append(transfers, nexus.NewPendingCrossChainTransfer(uint64(i), nexus.CrossChainAddress{Chain: chain, Address: evmTestUtils.RandomAddress().Hex()}, sdk.NewCoin(rand.Denom(5, 10), sdk.NewInt(rand.PosI64()))))
+		}
+
+		batch = evmTestUtils.RandomBatch()
+		batch.KeyID = keyID
+		batch.Status = types.BatchSigning
+	})
+
+	withAbortedBatch := When("the latest command batch is aborted", func() {
+		aborted := evmTestUtils.RandomBatch()
+		aborted.KeyID = multisigTestUtils.KeyID()
+		aborted.Status = types.BatchAborted
+
+		ck.GetLatestCommandBatchFunc = func(sdk.Context) types.CommandBatch {
+			return types.NewCommandBatch(aborted, func(types.CommandBatchMetadata) {})
+		}
+	})
+
+	givenEVMChain.
+		When2(withPendingTransfers).
+		Then("should create mint commands within limits and sign a new batch", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			limit := int(params.TransferLimit)
+			if int(params.EndBlockerLimit) < limit {
+				limit = int(params.EndBlockerLimit)
+			}
+			if len(transfers) < limit {
+				limit = len(transfers)
+			}
+
+			assert.Len(t, ck.EnqueueCommandCalls(), limit)
+			assert.Len(t, n.ArchivePendingTransferCalls(), limit)
+			assert.Len(t, multisigKeeper.SignCalls(), 1)
+			assert.Equal(t, keyID, multisigKeeper.SignCalls()[0].KeyID)
+			assert.Len(t, slices.Filter(ctx.EventManager().Events(), func(e sdk.Event) bool { return e.Type == types.EventTypeSign }), 1)
+		}).
+		Run(t)
+
+	givenEVMChain.
+		When2(withPendingTransfers).
+		When("a command batch is being signed", func() {
+			ck.GetLatestCommandBatchFunc = func(sdk.Context) types.CommandBatch {
+				return types.NewCommandBatch(types.CommandBatchMetadata{Status: types.BatchSigning}, func(types.CommandBatchMetadata) {})
+			}
+		}).
+		Then("should create mint commands but not sign a new batch", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			assert.NotEmpty(t, ck.EnqueueCommandCalls())
+			assert.Empty(t, ck.CreateNewBatchToSignCalls())
+			assert.Empty(t, multisigKeeper.SignCalls())
+		}).
+		Run(t)
+
+	givenEVMChain.
+		When("the command queue is empty", func() {}).
+		Then("should not sign", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			assert.Empty(t, ck.EnqueueCommandCalls())
+			assert.Len(t, ck.CreateNewBatchToSignCalls(), 1)
+			assert.Empty(t, multisigKeeper.SignCalls())
+		}).
+		Run(t)
+
+	givenEVMChain.
+		When2(withPendingTransfers).
+		When("the block height is not a multiple of the interval", func() {
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + rand.I64Between(1, 10))
+		}).
+		Then("should do nothing", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			assert.Empty(t, n.GetTransfersForChainPaginatedCalls())
+			assert.Empty(t, ck.CreateNewBatchToSignCalls())
+		}).
+		Run(t)
+
+	givenEVMChain.
+		When2(withPendingTransfers).
+		When("auto batching is disabled", func() {
+			params.AutoBatchInterval = 0
+		}).
+		Then("should do nothing", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			assert.Empty(t, n.GetTransfersForChainPaginatedCalls())
+			assert.Empty(t, ck.CreateNewBatchToSignCalls())
+		}).
+		Run(t)
+
+	givenEVMChain.
+		When2(withPendingTransfers).
+		When("signing fails", func() {
+			multisigKeeper.SignFunc = func(sdk.Context, multisig.KeyID, multisig.Hash, string, ...codec.ProtoMarshaler) error {
+				return fmt.Errorf("failed")
+			}
+		}).
+		Then("should keep the created commands", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			assert.NotEmpty(t, ck.EnqueueCommandCalls())
+			assert.Len(t, multisigKeeper.SignCalls(), 1)
+			assert.Len(t, slices.Filter(ctx.EventManager().Events(), func(e sdk.Event) bool { return e.Type == proto.MessageName(&types.MintCommand{}) }), len(ck.EnqueueCommandCalls()))
+			assert.Empty(t, slices.Filter(ctx.EventManager().Events(), func(e sdk.Event) bool { return e.Type == types.EventTypeSign }))
+		}).
+		Run(t)
+
+	givenEVMChain.
+		When2(withPendingTransfers).
+		When2(withAbortedBatch).
+		Then("should create mint commands but leave the aborted batch to the signing retries", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			assert.NotEmpty(t, ck.EnqueueCommandCalls())
+			assert.Empty(t, ck.CreateNewBatchToSignCalls())
+			assert.Empty(t, multisigKeeper.SignCalls())
+		}).
+		Run(t)
+
+	givenEVMChain.
+		When("the command queue is empty", func() {}).
+		When2(withAbortedBatch).
+		Then("should not sign", func(t *testing.T) {
+			handleAutoBatching(ctx, bk, n, multisigKeeper)
+
+			assert.Empty(t, ck.CreateNewBatchToSignCalls())
+			assert.Empty(t, multisigKeeper.SignCalls())
+		}).
+		Run(t)
+}
+
+func TestHandleSignRetries(t *testing.T) {
+	var (
+		ctx            sdk.Context
+		bk             *mock.BaseKeeperMock
+		n              *mock.NexusMock
+		multisigKeeper *mock.MultisigKeeperMock
+		ck             *mock.ChainKeeperMock
+
+		batch  types.CommandBatchMetadata
+		params types.Params
+	)
+
+	chain := nexus.Chain{Name: nexus.ChainName(rand.Str(5)), Module: types.ModuleName}
+
+	givenAbortedBatch := Given("an aborted command batch with a scheduled signing retry", func() {
+		ctx, bk, n, multisigKeeper, ck, _ = setup()
+
+		batch = evmTestUtils.RandomBatch()
+		batch.Status = types.BatchAborted
+		batch.KeyID = multisigTestUtils.KeyID()
+		batch.SignRetries = uint32(rand.I64Between(0, 10))
+		batch.SignRetryHeight = ctx.BlockHeight() - rand.I64Between(0, ctx.BlockHeight())
+		params = types.Params{SignRetryLimit: uint32(rand.I64Between(11, 20)), SignRetryBackoff: rand.I64Between(1, 100)}
+
+		bk.ForChainFunc = func(nexus.ChainName) types.ChainKeeper { return ck }
+		n.GetChainsFunc = func(sdk.Context) []nexus.Chain { return []nexus.Chain{chain} }
+		n.IsChainActivatedFunc = func(sdk.Context, nexus.Chain) bool { return true }
+		ck.GetLatestCommandBatchFunc = func(sdk.Context) types.CommandBatch {
+			return types.NewCommandBatch(batch, func(m types.CommandBatchMetadata) { batch = m })
+		}
+		ck.GetParamsFunc = func(sdk.Context) types.Params { return params }
+		ck.GetNameFunc = func() string { return chain.Name.String() }
+		multisigKeeper.GetCurrentKeyIDFunc = func(sdk.Context, nexus.ChainName) (multisig.KeyID, bool) { return batch.KeyID, true }
+		multisigKeeper.SignFunc = func(sdk.Context, multisig.KeyID, multisig.Hash, string, ...codec.ProtoMarshaler) error { return nil }
+	})
+
+	signingFails := When("signing fails", func() {
+		multisigKeeper.SignFunc = func(sdk.Context, multisig.KeyID, multisig.Hash, string, ...codec.ProtoMarshaler) error {
+			return fmt.Errorf("failed")
+		}
+	})
+
+	shouldRetry := Then("should sign the batch with its own key", func(t *testing.T) {
+		retries := batch.SignRetries
+		keyID := batch.KeyID
+
+		handleSignRetries(ctx, bk, n, multisigKeeper)
+
+		assert.Len(t, multisigKeeper.SignCalls(), 1)
+		assert.Equal(t, keyID, multisigKeeper.SignCalls()[0].KeyID)
+		assert.Equal(t, batch.SigHash.Bytes(), []byte(multisigKeeper.SignCalls()[0].PayloadHash))
+		assert.Equal(t, types.BatchSigning, batch.Status)
+		assert.Equal(t, keyID, batch.KeyID)
+		assert.Equal(t, retries+1, batch.SignRetries)
+		assert.Zero(t, batch.SignRetryHeight)
+
+		events := slices.Filter(ctx.EventManager().Events(), func(e sdk.Event) bool {
+			return e.Type == proto.MessageName(&types.CommandBatchSignRetried{})
+		})
+		assert.Len(t, events, 1)
+	})
+
+	givenAbortedBatch.
+		When("the retry height is reached", func() {}).
+		Then2(shouldRetry).
+		Run(t)
+
+	givenAbortedBatch.
+		When("the key was rotated", func() {
+			currentKeyID := multisigTestUtils.KeyID()
+			multisigKeeper.GetCurrentKeyIDFunc = func(sdk.Context, nexus.ChainName) (multisig.KeyID, bool) { return currentKeyID, true }
+
+			var requeued types.CommandBatchMetadata
+			ck.RequeueCommandBatchFunc = func(_ sdk.Context, keyID multisig.KeyID) error {
+				requeued = batch
+				requeued.KeyID = keyID
+				return nil
+			}
+			ck.CreateNewBatchToSignFunc = func(sdk.Context) (types.CommandBatch, error) {
+				replacement := evmTestUtils.RandomBatch()
+				replacement.CommandIDs = requeued.CommandIDs
+				replacement.KeyID = requeued.KeyID
+				replacement.Status = types.BatchSigning
+
+				return types.NewCommandBatch(replacement, func(m types.CommandBatchMetadata) { batch = m }), nil
+			}
+		}).
+		Then("should sign a new batch of the commands with the current key", func(t *testing.T) {
+			aborted := batch
+			currentKeyID := funcs.MustOk(multisigKeeper.GetCurrentKeyID(ctx, chain.Name))
+
+			handleSignRetries(ctx, bk, n, multisigKeeper)
+
+			assert.Len(t, ck.RequeueCommandBatchCalls(), 1)
+			assert.Equal(t, currentKeyID, ck.RequeueCommandBatchCalls()[0].KeyID)
+			assert.Len(t, ck.CreateNewBatchToSignCalls(), 1)
+
+			assert.NotEqual(t, aborted.ID, batch.ID)
+			assert.Equal(t, aborted.CommandIDs, batch.CommandIDs)
+			assert.Equal(t, types.BatchSigning, batch.Status)
+			assert.Equal(t, aborted.SignRetries+1, batch.SignRetries)
+
+			assert.Len(t, multisigKeeper.SignCalls(), 1)
+			assert.Equal(t, currentKeyID, multisigKeeper.SignCalls()[0].KeyID)
+			assert.Equal(t, batch.SigHash.Bytes(), []byte(multisigKeeper.SignCalls()[0].PayloadHash))
+
+			events := slices.Filter(ctx.EventManager().Events(), func(e sdk.Event) bool {
+				return e.Type == proto.MessageName(&types.CommandBatchSignRetried{})
+			})
+			assert.Len(t, events, 1)
+			retried := funcs.Must(sdk.ParseTypedEvent(abci.Event(events[0]))).(*types.CommandBatchSignRetried)
+			assert.Equal(t, currentKeyID, retried.KeyID)
+			assert.Equal(t, batch.ID, retried.CommandBatchID)
+			assert.Equal(t, aborted.SignRetries+1, retried.Retry)
+		}).
+		Run(t)
+
+	givenAbortedBatch.
+		When("the retry height is not reached", func() {
+			batch.SignRetryHeight = ctx.BlockHeight() + rand.I64Between(1, 100)
+		}).
+		Then("should not retry", func(t *testing.T) {
+			handleSignRetries(ctx, bk, n, multisigKeeper)
+
+			assert.Empty(t, multisigKeeper.SignCalls())
+			assert.Equal(t, types.BatchAborted, batch.Status)
+		}).
+		Run(t)
+
+	givenAbortedBatch.
+		When("no retry is scheduled", func() {
+			batch.SignRetryHeight = 0
+		}).
+		Then("should not retry", func(t *testing.T) {
+			handleSignRetries(ctx, bk, n, multisigKeeper)
+
+			assert.Empty(t, multisigKeeper.SignCalls())
+			assert.Equal(t, types.BatchAborted, batch.Status)
+		}).
+		Run(t)
+
+	givenAbortedBatch.
+		When2(signingFails).
+		Then("should count the retry and schedule the next one", func(t *testing.T) {
+			retries := batch.SignRetries
+
+			handleSignRetries(ctx, bk, n, multisigKeeper)
+
+			assert.Len(t, multisigKeeper.SignCalls(), 1)
+			assert.Empty(t, ctx.EventManager().Events())
+			assert.Equal(t, types.BatchAborted, batch.Status)
+			assert.Equal(t, retries+1, batch.SignRetries)
+			assert.Equal(t, ctx.BlockHeight()+params.SignRetryBackoff*int64(retries+2), batch.SignRetryHeight)
+		}).
+		Run(t)
+
+	givenAbortedBatch.
+		When2(signingFails).
+		When("the retry limit is reached", func() {
+			params.SignRetryLimit = batch.SignRetries + 1
+		}).
+		Then("should not schedule another retry", func(t *testing.T) {
+			handleSignRetries(ctx, bk, n, multisigKeeper)
+
+			assert.Len(t, multisigKeeper.SignCalls(), 1)
+			assert.Equal(t, types.BatchAborted, batch.Status)
+			assert.Equal(t, params.SignRetryLimit, batch.SignRetries)
+			assert.Zero(t, batch.SignRetryHeight)
+
+			multisigKeeper.SignFunc = func(sdk.Context, multisig.KeyID, multisig.Hash, string, ...codec.ProtoMarshaler) error { return nil }
+			handleSignRetries(ctx.WithBlockHeight(ctx.BlockHeight()+1000), bk, n, multisigKeeper)
+			assert.Len(t, multisigKeeper.SignCalls(), 1)
+		}).
+		Run(t)
+}
+
 func randTransferKeyEvent(chain nexus.ChainName) types.Event {
 	event := types.Event{
 		Chain: chain,
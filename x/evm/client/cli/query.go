@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -35,14 +36,18 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		getCmdBytecode(queryRoute),
 		getCmdQueryBatchedCommands(queryRoute),
 		getCmdLatestBatchedCommands(queryRoute),
+		getCmdCommandBatches(queryRoute),
+		getCmdBatchedCommandsByCommand(queryRoute),
 		getCmdPendingCommands(queryRoute),
 		getCmdCommand(queryRoute),
 		getCmdBurnerInfo(queryRoute),
 		getCmdChains(queryRoute),
 		getCmdConfirmationHeight(queryRoute),
+		getCmdChainID(queryRoute),
 		getCmdERC20Tokens(queryRoute),
 		getCmdTokenInfo(queryRoute),
 		getCmdEvent(queryRoute),
+		getCmdEvents(queryRoute),
 	)
 
 	return evmQueryCmd
@@ -291,6 +296,102 @@ func getCmdLatestBatchedCommands(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// getCmdCommandBatches returns the query to get the command batches of a chain
+func getCmdCommandBatches(queryRoute string) *cobra.Command {
+	cmdName := "command-batches"
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [chain]", cmdName),
+		Short: "Get the command batches of the given chain",
+		Args:  cobra.ExactArgs(1),
+	}
+	status := cmd.Flags().String("status", "", "the batch status [signing|aborted|signed]")
+	keyID := cmd.Flags().String("key-id", "", "the ID of the key the batches are signed with")
+	minHeight := cmd.Flags().Int64("min-height", 0, "the minimum block height at which the batches were created")
+	maxHeight := cmd.Flags().Int64("max-height", 0, "the maximum block height at which the batches were created")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+
+		pageReq, err := client.ReadPageRequest(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+		if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+			pageReq.Key = nil
+		}
+
+		var statusEnum types.BatchedCommandsStatus
+		switch *status {
+		case "":
+			statusEnum = types.BatchNonExistent
+		case "signing":
+			statusEnum = types.BatchSigning
+		case "aborted":
+			statusEnum = types.BatchAborted
+		case "signed":
+			statusEnum = types.BatchSigned
+		default:
+			return fmt.Errorf("invalid batch status %s provided", *status)
+		}
+
+		res, err := queryClient.CommandBatches(cmd.Context(),
+			&types.CommandBatchesRequest{
+				Chain:      utils.NormalizeString(args[0]),
+				Status:     statusEnum,
+				KeyID:      multisig.KeyID(utils.NormalizeString(*keyID)),
+				MinHeight:  *minHeight,
+				MaxHeight:  *maxHeight,
+				Pagination: pageReq,
+			})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+	return cmd
+}
+
+// getCmdBatchedCommandsByCommand returns the query to get the batched commands that contain the given command
+func getCmdBatchedCommandsByCommand(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batched-commands-by-command [chain] [commandID]",
+		Short: "Get the batched commands that contain the given command",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.BatchedCommandsByCommand(cmd.Context(),
+				&types.BatchedCommandsByCommandRequest{
+					Chain:     utils.NormalizeString(args[0]),
+					CommandId: utils.NormalizeString(args[1]),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // getCmdPendingCommands returns the query to get the list of commands not yet added to a batch
 func getCmdPendingCommands(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -431,6 +532,37 @@ func getCmdConfirmationHeight(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// getCmdChainID returns the query to get the ID of the network the given chain is connected to
+func getCmdChainID(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-id [chain]",
+		Short: "Returns the ID of the network the given chain is connected to",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+
+		res, err := queryClient.ChainID(cmd.Context(),
+			&types.ChainIDRequest{
+				Chain: args[0],
+			})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // getCmdEvent returns the query to an event for a chain based on the event's txID
 func getCmdEvent(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -466,6 +598,72 @@ func getCmdEvent(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// getCmdEvents returns the query to get the events for a given chain
+func getCmdEvents(queryRoute string) *cobra.Command {
+	cmdName := "events"
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [chain]", cmdName),
+		Short: "Returns the events for the given chain",
+		Args:  cobra.ExactArgs(1),
+	}
+	status := cmd.Flags().String("status", "", "the event status [confirmed|completed|failed]")
+	eventType := cmd.Flags().String("event-type", "", fmt.Sprintf("the event type [%s]", strings.Join(types.EventTypeNames, "|")))
+	destinationChain := cmd.Flags().String("destination-chain", "", "the destination chain of the events")
+	txID := cmd.Flags().String("tx-id", "", "the ID of the tx the events were emitted in")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+
+		pageReq, err := client.ReadPageRequest(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+		if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+			pageReq.Key = nil
+		}
+
+		var statusEnum types.Event_Status
+		switch *status {
+		case "":
+			statusEnum = types.EventNonExistent
+		case "confirmed":
+			statusEnum = types.EventConfirmed
+		case "completed":
+			statusEnum = types.EventCompleted
+		case "failed":
+			statusEnum = types.EventFailed
+		default:
+			return fmt.Errorf("invalid event status %s provided", *status)
+		}
+
+		res, err := queryClient.Events(cmd.Context(),
+			&types.EventsRequest{
+				Chain:            utils.NormalizeString(args[0]),
+				Status:           statusEnum,
+				Type:             *eventType,
+				DestinationChain: *destinationChain,
+				TxID:             utils.NormalizeString(*txID),
+				Pagination:       pageReq,
+			})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+	return cmd
+}
+
 // getCmdERC20Tokens returns the query to get the ERC20 tokens for a given chain
 func getCmdERC20Tokens(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
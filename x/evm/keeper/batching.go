@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+// CreatePendingTransfers creates the mint commands of up to limit pending transfers to the given chain and archives the transfers
+func CreatePendingTransfers(ctx sdk.Context, ck types.ChainKeeper, n types.Nexus, multisigKeeper types.MultisigKeeper, chain nexus.Chain, limit uint64) error {
+	pendingTransfers, _, err := n.GetTransfersForChainPaginated(ctx, chain, nexus.Pending, &query.PageRequest{Limit: limit})
+	if err != nil {
+		return err
+	}
+
+	if len(pendingTransfers) == 0 {
+		ck.Logger(ctx).Debug("no pending transfers found")
+		return nil
+	}
+
+	keyID, ok := multisigKeeper.GetCurrentKeyID(ctx, chain.Name)
+	if !ok {
+		return fmt.Errorf("current key not set for chain %s", chain.Name)
+	}
+
+	for _, transfer := range pendingTransfers {
+		token := ck.GetERC20TokenByAsset(ctx, transfer.Asset.Denom)
+		if !token.Is(types.Confirmed) {
+			ck.Logger(ctx).Debug(fmt.Sprintf("token %s is not confirmed on %s", token.GetAsset(), chain.Name))
+			continue
+		}
+
+		cmd, err := token.CreateMintCommand(keyID, transfer)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed create mint-token command for transfer %d", transfer.ID)
+		}
+
+		ck.Logger(ctx).Info(fmt.Sprintf("minting %s to recipient %s on %s with transfer ID %s and command ID %s", transfer.Asset.String(), transfer.Recipient.Address, transfer.Recipient.Chain.Name, transfer.ID.String(), cmd.ID.Hex()),
+			types.AttributeKeyDestinationChain, transfer.Recipient.Chain.Name,
+			types.AttributeKeyDestinationAddress, transfer.Recipient.Address,
+			sdk.AttributeKeyAmount, transfer.Asset.String(),
+			types.AttributeKeyAsset, transfer.Asset.Denom,
+			types.AttributeKeyTransferID, transfer.ID.String(),
+			types.AttributeKeyCommandsID, cmd.ID.Hex(),
+		)
+
+		if err := ck.EnqueueCommand(ctx, cmd); err != nil {
+			return err
+		}
+
+		funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.MintCommand{
+			Chain:              chain.Name,
+			TransferID:         transfer.ID,
+			CommandID:          cmd.ID,
+			DestinationChain:   transfer.Recipient.Chain.Name,
+			DestinationAddress: transfer.Recipient.Address,
+			Asset:              transfer.Asset,
+		}))
+
+		n.ArchivePendingTransfer(ctx, transfer)
+	}
+
+	return nil
+}
+
+// SignCommands starts signing the aborted latest command batch of the given chain again,
+// or a new batch of the queued commands if the latest batch is signed. The returned batch has no commands if the queue is empty
+func SignCommands(ctx sdk.Context, ck types.ChainKeeper, multisigKeeper types.MultisigKeeper, chain nexus.Chain) (types.CommandBatch, error) {
+	if _, ok := ck.GetChainID(ctx); !ok {
+		return types.CommandBatch{}, fmt.Errorf("could not find chain ID for '%s'", chain.Name)
+	}
+
+	commandBatch, err := getCommandBatchToSign(ctx, ck)
+	if err != nil {
+		return types.CommandBatch{}, err
+	}
+
+	if len(commandBatch.GetCommandIDs()) == 0 {
+		return commandBatch, nil
+	}
+
+	if err := multisigKeeper.Sign(
+		ctx,
+		commandBatch.GetKeyID(),
+		commandBatch.GetSigHash().Bytes(),
+		types.ModuleName,
+		types.NewSigMetadata(types.SigCommand, chain.Name, commandBatch.GetID()),
+	); err != nil {
+		return types.CommandBatch{}, err
+	}
+
+	if !commandBatch.SetStatus(types.BatchSigning) {
+		return types.CommandBatch{}, fmt.Errorf("failed setting status of command batch %s to be signing", hex.EncodeToString(commandBatch.GetID()))
+	}
+
+	batchedCommandsIDHex := hex.EncodeToString(commandBatch.GetID())
+	for _, commandID := range types.CommandIDsToStrings(commandBatch.GetCommandIDs()) {
+		ck.Logger(ctx).Info(
+			fmt.Sprintf("signing command %s in batch %s for chain %s using key %s", commandID, batchedCommandsIDHex, chain.Name, string(commandBatch.GetKeyID())),
+			types.AttributeKeyChain, chain.Name,
+			types.AttributeKeyKeyID, string(commandBatch.GetKeyID()),
+			"commandBatchID", batchedCommandsIDHex,
+			"commandID", commandID,
+		)
+	}
+
+	return commandBatch, nil
+}
+
+func getCommandBatchToSign(ctx sdk.Context, keeper types.ChainKeeper) (types.CommandBatch, error) {
+	latest := keeper.GetLatestCommandBatch(ctx)
+
+	switch latest.GetStatus() {
+	case types.BatchSigning:
+		return types.CommandBatch{}, sdkerrors.Wrapf(types.ErrSignCommandsInProgress, "command batch '%s'", hex.EncodeToString(latest.GetID()))
+	case types.BatchAborted:
+		return latest, nil
+	default:
+		return keeper.CreateNewBatchToSign(ctx)
+	}
+}
+
+// ScheduleSignRetry schedules the next automatic signing retry of the aborted command batch, unless the retry limit of the chain is reached
+func ScheduleSignRetry(ctx sdk.Context, ck types.ChainKeeper, commandBatch types.CommandBatch) {
+	params := ck.GetParams(ctx)
+	if commandBatch.GetSignRetries() >= params.SignRetryLimit {
+		return
+	}
+
+	// every retry waits one backoff period longer than the previous one
+	retryHeight := ctx.BlockHeight() + params.SignRetryBackoff*int64(commandBatch.GetSignRetries()+1)
+	funcs.MustNoErr(commandBatch.ScheduleSignRetry(retryHeight))
+
+	ck.Logger(ctx).Info(fmt.Sprintf("scheduled signing retry of command batch %s for chain %s at block height %d", hex.EncodeToString(commandBatch.GetID()), ck.GetName(), retryHeight),
+		types.AttributeKeyChain, ck.GetName(),
+		"commandBatchID", hex.EncodeToString(commandBatch.GetID()),
+		"retry", commandBatch.GetSignRetries()+1,
+	)
+}
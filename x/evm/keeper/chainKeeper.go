@@ -17,6 +17,8 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/utils/funcs"
 )
 
@@ -30,9 +32,13 @@ var (
 	confirmedDepositPrefix      = utils.KeyFromStr("confirmed_deposit")
 	burnedDepositPrefix         = utils.KeyFromStr("burned_deposit")
 	commandBatchPrefix          = utils.KeyFromStr("batched_commands")
+	commandBatchByCommandPrefix = utils.KeyFromStr("batch_by_command")
+	commandBatchByHeightPrefix  = utils.KeyFromStr("batch_by_height")
 	commandPrefix               = utils.KeyFromStr("command")
 	burnerAddrPrefix            = utils.KeyFromStr("burnerAddr")
 	eventPrefix                 = utils.KeyFromStr("event")
+	transferByEventPrefix       = utils.KeyFromStr("transfer_by_event")
+	eventByTransferPrefix       = utils.KeyFromStr("source_event_by_transfer")
 
 	commandQueueName        = "cmd_queue"
 	confirmedEventQueueName = "confirmed_event_queue"
@@ -592,6 +598,72 @@ func (k chainKeeper) getCommandBatchesMetadata(ctx sdk.Context) []types.CommandB
 	return batches
 }
 
+// GetCommandBatchesPaginated returns the command batches that match the given filter with the given pagination properties.
+// Batches are returned in order of the block height at which they were created
+func (k chainKeeper) GetCommandBatchesPaginated(ctx sdk.Context, filter func(batch types.CommandBatchMetadata) bool, pageRequest *query.PageRequest) ([]types.CommandBatch, *query.PageResponse, error) {
+	setter := func(m types.CommandBatchMetadata) {
+		k.setCommandBatchMetadata(ctx, m)
+	}
+
+	var batches []types.CommandBatch
+	batchesPrefix := append(commandBatchByHeightPrefix.AsKey(), []byte(utils.DefaultDelimiter)...)
+	resp, err := query.FilteredPaginate(prefix.NewStore(k.getStore(ctx, k.chainLowerKey).KVStore, batchesPrefix), pageRequest, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		batch := k.getCommandBatchMetadata(ctx, value)
+		if batch.Status == types.BatchNonExistent {
+			return false, fmt.Errorf("command batch %s not found", hex.EncodeToString(value))
+		}
+
+		if !filter(batch) {
+			return false, nil
+		}
+
+		if accumulate {
+			batches = append(batches, types.NewCommandBatch(batch, setter))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return batches, resp, nil
+}
+
+func getCommandBatchByHeightKey(batch types.CommandBatchMetadata) utils.Key {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(batch.Height))
+
+	return commandBatchByHeightPrefix.Append(utils.KeyFromBz(heightBz)).Append(utils.KeyFromBz(batch.ID))
+}
+
+// setCommandBatchByHeight indexes the given batch by the block height at which it was created.
+// Batches created before the height was recorded have height 0, so they are indexed before all other batches
+func (k chainKeeper) setCommandBatchByHeight(ctx sdk.Context, batch types.CommandBatchMetadata) {
+	k.getStore(ctx, k.chainLowerKey).SetRaw(getCommandBatchByHeightKey(batch), batch.ID)
+}
+
+func getCommandBatchByCommandKey(id types.CommandID) utils.Key {
+	return commandBatchByCommandPrefix.Append(utils.LowerCaseKey(id.Hex()))
+}
+
+// setCommandBatchByCommand indexes the given batch by the IDs of its commands
+func (k chainKeeper) setCommandBatchByCommand(ctx sdk.Context, batch types.CommandBatchMetadata) {
+	for _, id := range batch.CommandIDs {
+		k.getStore(ctx, k.chainLowerKey).SetRaw(getCommandBatchByCommandKey(id), batch.ID)
+	}
+}
+
+// GetBatchByCommandID retrieves the batch that contains the given command if it exists
+func (k chainKeeper) GetBatchByCommandID(ctx sdk.Context, id types.CommandID) types.CommandBatch {
+	batchID := k.getStore(ctx, k.chainLowerKey).GetRaw(getCommandBatchByCommandKey(id))
+	if batchID == nil {
+		return types.NonExistentCommand
+	}
+
+	return k.GetBatchByID(ctx, batchID)
+}
+
 func (k chainKeeper) getLatestSignedCommandBatchID(ctx sdk.Context) []byte {
 	return k.getStore(ctx, k.chainLowerKey).GetRaw(latestSignedBatchIDKey)
 }
@@ -656,6 +728,8 @@ func (k chainKeeper) CreateNewBatchToSign(ctx sdk.Context) (types.CommandBatch,
 
 	commandBatch.PrevBatchedCommandsID = latest.GetID()
 	k.setCommandBatchMetadata(ctx, commandBatch)
+	k.setCommandBatchByCommand(ctx, commandBatch)
+	k.setCommandBatchByHeight(ctx, commandBatch)
 	k.setUnsignedCommandBatchID(ctx, commandBatch.ID)
 
 	setter := func(m types.CommandBatchMetadata) {
@@ -664,6 +738,31 @@ func (k chainKeeper) CreateNewBatchToSign(ctx sdk.Context) (types.CommandBatch,
 	return types.NewCommandBatch(commandBatch, setter), nil
 }
 
+// RequeueCommandBatch queues the commands of the aborted unsigned command batch again to be signed with the given key
+// and discards the batch, so the next command batch is created from its commands
+func (k chainKeeper) RequeueCommandBatch(ctx sdk.Context, keyID multisig.KeyID) error {
+	batch := k.getUnsignedCommandBatch(ctx)
+	if batch.Status != types.BatchAborted {
+		return fmt.Errorf("no aborted command batch to re-queue")
+	}
+
+	// the commands were batched before any of the queued commands, so they are queued ahead of them
+	queue := utils.NewBlockHeightKVQueue(commandQueueName, k.getStore(ctx, k.chainLowerKey), 0, k.Logger(ctx))
+	for _, id := range batch.CommandIDs {
+		cmd, ok := k.GetCommand(ctx, id)
+		if !ok {
+			return fmt.Errorf("command %s not found", id.Hex())
+		}
+
+		cmd.KeyID = keyID
+		queue.Enqueue(commandPrefix.AppendStr(id.Hex()), &cmd)
+	}
+
+	k.DeleteUnsignedCommandBatchID(ctx)
+
+	return nil
+}
+
 // DeleteUnsignedCommandBatchID deletes the unsigned command batch ID
 func (k chainKeeper) DeleteUnsignedCommandBatchID(ctx sdk.Context) {
 	k.getStore(ctx, k.chainLowerKey).Delete(unsignedBatchIDKey)
@@ -856,6 +955,37 @@ func (k chainKeeper) getEvents(ctx sdk.Context) []types.Event {
 	return events
 }
 
+// GetEventsPaginated returns the events that match the given filter with the given pagination properties.
+// Only the events of the given tx are considered if txID is not nil
+func (k chainKeeper) GetEventsPaginated(ctx sdk.Context, txID *types.Hash, filter func(event types.Event) bool, pageRequest *query.PageRequest) ([]types.Event, *query.PageResponse, error) {
+	eventsPrefix := append(eventPrefix.AsKey(), []byte(utils.DefaultDelimiter)...)
+	if txID != nil {
+		// event IDs have the format txID-index
+		eventsPrefix = eventPrefix.Append(utils.LowerCaseKey(txID.Hex() + "-")).AsKey()
+	}
+
+	var events []types.Event
+	resp, err := query.FilteredPaginate(prefix.NewStore(k.getStore(ctx, k.chainLowerKey).KVStore, eventsPrefix), pageRequest, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var event types.Event
+		k.cdc.MustUnmarshalLengthPrefixed(value, &event)
+
+		if !filter(event) {
+			return false, nil
+		}
+
+		if accumulate {
+			events = append(events, event)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, resp, nil
+}
+
 // GetEvent returns the event for the given event ID
 func (k chainKeeper) GetEvent(ctx sdk.Context, eventID types.EventID) (event types.Event, ok bool) {
 	k.getStore(ctx, k.chainLowerKey).Get(getEventKey(eventID), &event)
@@ -863,6 +993,32 @@ func (k chainKeeper) GetEvent(ctx sdk.Context, eventID types.EventID) (event typ
 	return event, event.Status != types.EventNonExistent
 }
 
+// SetEventTransferID links the given event to the cross-chain transfer it started
+func (k chainKeeper) SetEventTransferID(ctx sdk.Context, eventID types.EventID, transferID nexus.TransferID) {
+	k.getStore(ctx, k.chainLowerKey).SetRaw(transferByEventPrefix.Append(utils.LowerCaseKey(string(eventID))), transferID.Bytes())
+	k.getStore(ctx, k.chainLowerKey).SetRaw(eventByTransferPrefix.Append(utils.KeyFromStr(transferID.String())), []byte(eventID))
+}
+
+// GetEventTransferID returns the ID of the cross-chain transfer the given event started
+func (k chainKeeper) GetEventTransferID(ctx sdk.Context, eventID types.EventID) (nexus.TransferID, bool) {
+	bz := k.getStore(ctx, k.chainLowerKey).GetRaw(transferByEventPrefix.Append(utils.LowerCaseKey(string(eventID))))
+	if bz == nil {
+		return 0, false
+	}
+
+	return nexus.TransferID(binary.BigEndian.Uint64(bz)), true
+}
+
+// GetTransferEventID returns the ID of the event that started the given cross-chain transfer
+func (k chainKeeper) GetTransferEventID(ctx sdk.Context, transferID nexus.TransferID) (types.EventID, bool) {
+	bz := k.getStore(ctx, k.chainLowerKey).GetRaw(eventByTransferPrefix.Append(utils.KeyFromStr(transferID.String())))
+	if bz == nil {
+		return "", false
+	}
+
+	return types.EventID(bz), true
+}
+
 // SetConfirmedEvent sets the event as confirmed
 func (k chainKeeper) SetConfirmedEvent(ctx sdk.Context, event types.Event) error {
 	eventID := event.GetID()
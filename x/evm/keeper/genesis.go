@@ -35,6 +35,8 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		var latestBatch types.CommandBatchMetadata
 		for _, batch := range chain.CommandBatches {
 			ck.setCommandBatchMetadata(ctx, batch)
+			ck.setCommandBatchByCommand(ctx, batch)
+			ck.setCommandBatchByHeight(ctx, batch)
 			latestBatch = batch
 		}
 
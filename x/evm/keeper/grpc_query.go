@@ -166,6 +166,7 @@ func commandBatchToResp(ctx sdk.Context, commandBatch types.CommandBatch, multis
 			PrevBatchedCommandsID: prevID,
 			CommandIDs:            commandIDs,
 			Proof:                 &proof,
+			Height:                commandBatch.GetHeight(),
 		}, nil
 	default:
 		return types.BatchedCommandsResponse{
@@ -177,6 +178,7 @@ func commandBatchToResp(ctx sdk.Context, commandBatch types.CommandBatch, multis
 			PrevBatchedCommandsID: prevID,
 			CommandIDs:            commandIDs,
 			Proof:                 nil,
+			Height:                commandBatch.GetHeight(),
 		}, nil
 	}
 }
@@ -237,6 +239,76 @@ func (q Querier) BatchedCommands(c context.Context, req *types.BatchedCommandsRe
 	return &resp, nil
 }
 
+// CommandBatches returns the command batches of the specified chain that match the filters of the request in order of their height
+func (q Querier) CommandBatches(c context.Context, req *types.CommandBatchesRequest) (*types.CommandBatchesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !q.keeper.HasChain(ctx, nexustypes.ChainName(req.Chain)) {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("%s is not a registered chain", req.Chain)).Error())
+	}
+
+	filter := func(batch types.CommandBatchMetadata) bool {
+		switch {
+		case req.Status != types.BatchNonExistent && batch.Status != req.Status:
+			return false
+		case req.KeyID != "" && batch.KeyID != req.KeyID:
+			return false
+		// batches created before the height was recorded have height 0, so their height is unknown
+		case (req.MinHeight != 0 || req.MaxHeight != 0) && batch.Height == 0:
+			return false
+		case req.MinHeight != 0 && batch.Height < req.MinHeight:
+			return false
+		case req.MaxHeight != 0 && batch.Height > req.MaxHeight:
+			return false
+		default:
+			return true
+		}
+	}
+
+	batches, pagination, err := q.keeper.ForChain(nexustypes.ChainName(req.Chain)).GetCommandBatchesPaginated(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrap(types.ErrEVM, err.Error()).Error())
+	}
+
+	resp := types.CommandBatchesResponse{Pagination: pagination}
+	for _, batch := range batches {
+		batchResp, err := commandBatchToResp(ctx, batch, q.multisig)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		resp.Batches = append(resp.Batches, batchResp)
+	}
+
+	return &resp, nil
+}
+
+// BatchedCommandsByCommand returns the batched commands that contain the specified command
+func (q Querier) BatchedCommandsByCommand(c context.Context, req *types.BatchedCommandsByCommandRequest) (*types.BatchedCommandsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !q.keeper.HasChain(ctx, nexustypes.ChainName(req.Chain)) {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("%s is not a registered chain", req.Chain)).Error())
+	}
+
+	commandID, err := types.HexToCommandID(req.CommandId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("invalid command ID: %v", err)).Error())
+	}
+
+	commandBatch := q.keeper.ForChain(nexustypes.ChainName(req.Chain)).GetBatchByCommandID(ctx, commandID)
+	if commandBatch.Is(types.BatchNonExistent) {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("no batched commands contain command %s", commandID.Hex())).Error())
+	}
+
+	resp, err := commandBatchToResp(ctx, commandBatch, q.multisig)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &resp, nil
+}
+
 // ConfirmationHeight implements the confirmation height grpc query
 func (q Querier) ConfirmationHeight(c context.Context, req *types.ConfirmationHeightRequest) (*types.ConfirmationHeightResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -256,6 +328,22 @@ func (q Querier) ConfirmationHeight(c context.Context, req *types.ConfirmationHe
 	return &types.ConfirmationHeightResponse{Height: height}, nil
 }
 
+// ChainID returns the ID of the network the given chain is connected to
+func (q Querier) ChainID(c context.Context, req *types.ChainIDRequest) (*types.ChainIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !q.keeper.HasChain(ctx, nexustypes.ChainName(req.Chain)) {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("[%s] is not a registered chain", req.Chain)).Error())
+	}
+
+	chainID, ok := q.keeper.ForChain(nexustypes.ChainName(req.Chain)).GetChainID(ctx)
+	if !ok {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("could not find chain ID of chain [%s]", req.Chain)).Error())
+	}
+
+	return &types.ChainIDResponse{Id: chainID}, nil
+}
+
 // Event implements the query for an event at a chain based on the event's ID
 func (q Querier) Event(c context.Context, req *types.EventRequest) (*types.EventResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -271,6 +359,55 @@ func (q Querier) Event(c context.Context, req *types.EventRequest) (*types.Event
 	return &types.EventResponse{Event: &event}, nil
 }
 
+// Events returns the events of the specified chain that match the filters of the request
+func (q Querier) Events(c context.Context, req *types.EventsRequest) (*types.EventsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasChain(ctx, nexustypes.ChainName(req.Chain)) {
+		return nil, status.Error(codes.NotFound, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("[%s] is not a registered chain", req.Chain)).Error())
+	}
+
+	if req.Type != "" && !slices.Any(types.EventTypeNames, func(name string) bool { return name == req.Type }) {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("unknown event type [%s]", req.Type)).Error())
+	}
+
+	var txID *types.Hash
+	if req.TxID != "" {
+		bz, err := hex.DecodeString(strings.TrimPrefix(req.TxID, "0x"))
+		if err != nil || len(bz) != common.HashLength {
+			return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrap(types.ErrEVM, fmt.Sprintf("invalid tx ID [%s]", req.TxID)).Error())
+		}
+
+		hash := types.Hash(common.BytesToHash(bz))
+		txID = &hash
+	}
+
+	filter := func(event types.Event) bool {
+		if req.Status != types.EventNonExistent && event.Status != req.Status {
+			return false
+		}
+
+		if req.Type != "" && event.GetEventTypeName() != req.Type {
+			return false
+		}
+
+		if req.DestinationChain != "" {
+			destinationChain, ok := event.GetDestinationChain()
+			if !ok || !destinationChain.Equals(nexustypes.ChainName(req.DestinationChain)) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	events, pagination, err := q.keeper.ForChain(nexustypes.ChainName(req.Chain)).GetEventsPaginated(ctx, txID, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.Wrap(types.ErrEVM, err.Error()).Error())
+	}
+
+	return &types.EventsResponse{Events: events, Pagination: pagination}, nil
+}
+
 func queryDepositState(ctx sdk.Context, k types.ChainKeeper, n types.Nexus, params *types.QueryDepositStateParams) (types.DepositStatus, string, codes.Code) {
 	if _, ok := n.GetChain(ctx, nexustypes.ChainName(k.GetName())); !ok {
 		return -1, fmt.Sprintf("%s is not a registered chain", k.GetName()), codes.NotFound
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,7 @@ import (
 	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	multisigTestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/slices"
 )
 
 func TestQueryPendingCommands(t *testing.T) {
@@ -606,6 +608,223 @@ func TestEvent(t *testing.T) {
 	}).Repeat(repeatCount))
 }
 
+func TestChainID(t *testing.T) {
+	existingChain := nexus.ChainName("existing-chain")
+	chainID := sdk.NewInt(rand.PosI64())
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+
+	var hasChainID bool
+	chainKeeper := &mock.ChainKeeperMock{
+		GetChainIDFunc: func(sdk.Context) (sdk.Int, bool) { return chainID, hasChainID },
+	}
+	baseKeeper := &mock.BaseKeeperMock{
+		HasChainFunc: func(_ sdk.Context, chain nexus.ChainName) bool { return chain == existingChain },
+		ForChainFunc: func(nexus.ChainName) types.ChainKeeper { return chainKeeper },
+	}
+	q := evmKeeper.NewGRPCQuerier(baseKeeper, &mock.NexusMock{}, &mock.MultisigKeeperMock{})
+
+	t.Run("chain ID exists", func(t *testing.T) {
+		hasChainID = true
+		res, err := q.ChainID(sdk.WrapSDKContext(ctx), &types.ChainIDRequest{Chain: existingChain.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, chainID, res.Id)
+	})
+
+	t.Run("chain ID doesn't exist", func(t *testing.T) {
+		hasChainID = false
+		_, err := q.ChainID(sdk.WrapSDKContext(ctx), &types.ChainIDRequest{Chain: existingChain.String()})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("chain doesn't exist", func(t *testing.T) {
+		hasChainID = true
+		_, err := q.ChainID(sdk.WrapSDKContext(ctx), &types.ChainIDRequest{Chain: "non-existing-chain"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEvents(t *testing.T) {
+	var (
+		chainKeeper *mock.ChainKeeperMock
+		ctx         sdk.Context
+		grpcQuerier evmKeeper.Querier
+		events      []types.Event
+	)
+
+	chain := nexus.ChainName("Ethereum")
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+
+		events = []types.Event{
+			{Chain: chain, TxID: evmTest.RandomHash(), Status: types.EventConfirmed, Event: &types.Event_TokenSent{TokenSent: &types.EventTokenSent{DestinationChain: "Polygon"}}},
+			{Chain: chain, TxID: evmTest.RandomHash(), Status: types.EventFailed, Event: &types.Event_ContractCall{ContractCall: &types.EventContractCall{DestinationChain: "Polygon"}}},
+			{Chain: chain, TxID: evmTest.RandomHash(), Status: types.EventFailed, Event: &types.Event_ContractCall{ContractCall: &types.EventContractCall{DestinationChain: "Avalanche"}}},
+			{Chain: chain, TxID: evmTest.RandomHash(), Status: types.EventCompleted, Event: &types.Event_Transfer{Transfer: &types.EventTransfer{}}},
+		}
+
+		chainKeeper = &mock.ChainKeeperMock{
+			GetEventsPaginatedFunc: func(_ sdk.Context, txID *types.Hash, filter func(types.Event) bool, _ *query.PageRequest) ([]types.Event, *query.PageResponse, error) {
+				var filtered []types.Event
+				for _, event := range events {
+					if (txID == nil || *txID == event.TxID) && filter(event) {
+						filtered = append(filtered, event)
+					}
+				}
+				return filtered, &query.PageResponse{Total: uint64(len(filtered))}, nil
+			},
+		}
+		baseKeeper := &mock.BaseKeeperMock{
+			HasChainFunc: func(_ sdk.Context, c nexus.ChainName) bool { return c == chain },
+			ForChainFunc: func(nexus.ChainName) types.ChainKeeper { return chainKeeper },
+		}
+
+		grpcQuerier = evmKeeper.NewGRPCQuerier(baseKeeper, &mock.NexusMock{}, &mock.MultisigKeeperMock{})
+	}
+
+	t.Run("should return all events if no filter is set", func(t *testing.T) {
+		setup()
+		res, err := grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: chain.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, events, res.Events)
+		assert.EqualValues(t, len(events), res.Pagination.Total)
+	})
+
+	t.Run("should filter events by status, type and destination chain", func(t *testing.T) {
+		setup()
+		res, err := grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: chain.String(), Status: types.EventFailed})
+		assert.NoError(t, err)
+		assert.Equal(t, events[1:3], res.Events)
+
+		res, err = grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: chain.String(), Type: "contract_call", DestinationChain: "avalanche"})
+		assert.NoError(t, err)
+		assert.Equal(t, events[2:3], res.Events)
+
+		res, err = grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: chain.String(), DestinationChain: "Polygon"})
+		assert.NoError(t, err)
+		assert.Equal(t, events[:2], res.Events)
+	})
+
+	t.Run("should filter events by tx ID", func(t *testing.T) {
+		setup()
+		res, err := grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: chain.String(), TxID: events[3].TxID.Hex()})
+		assert.NoError(t, err)
+		assert.Equal(t, events[3:], res.Events)
+	})
+
+	t.Run("should return error for invalid requests", func(t *testing.T) {
+		setup()
+		_, err := grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: rand.NormalizedStr(5)})
+		assert.Error(t, err)
+
+		_, err = grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: chain.String(), Type: rand.NormalizedStr(5)})
+		assert.Error(t, err)
+
+		_, err = grpcQuerier.Events(sdk.WrapSDKContext(ctx), &types.EventsRequest{Chain: chain.String(), TxID: rand.HexStr(10)})
+		assert.Error(t, err)
+
+		assert.Len(t, chainKeeper.GetEventsPaginatedCalls(), 0)
+	})
+}
+
+func TestCommandBatches(t *testing.T) {
+	var (
+		chainKeeper *mock.ChainKeeperMock
+		ctx         sdk.Context
+		grpcQuerier evmKeeper.Querier
+		batches     []types.CommandBatchMetadata
+	)
+
+	chain := nexus.ChainName("Ethereum")
+	keyID := multisigTestutils.KeyID()
+	setup := func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.TestingLogger())
+
+		batches = []types.CommandBatchMetadata{
+			{ID: rand.Bytes(common.HashLength), CommandIDs: []types.CommandID{types.NewCommandID(rand.Bytes(10), sdk.NewInt(1))}, Status: types.BatchSigning, KeyID: keyID, Height: 10},
+			{ID: rand.Bytes(common.HashLength), CommandIDs: []types.CommandID{types.NewCommandID(rand.Bytes(10), sdk.NewInt(1))}, Status: types.BatchAborted, KeyID: multisigTestutils.KeyID(), Height: 20},
+			{ID: rand.Bytes(common.HashLength), CommandIDs: []types.CommandID{types.NewCommandID(rand.Bytes(10), sdk.NewInt(1))}, Status: types.BatchSigning, KeyID: keyID, Height: 30},
+			// created before the height was recorded
+			{ID: rand.Bytes(common.HashLength), CommandIDs: []types.CommandID{types.NewCommandID(rand.Bytes(10), sdk.NewInt(1))}, Status: types.BatchSigned, KeyID: keyID},
+		}
+
+		chainKeeper = &mock.ChainKeeperMock{
+			GetCommandBatchesPaginatedFunc: func(_ sdk.Context, filter func(types.CommandBatchMetadata) bool, _ *query.PageRequest) ([]types.CommandBatch, *query.PageResponse, error) {
+				var filtered []types.CommandBatch
+				for _, batch := range batches {
+					if filter(batch) {
+						filtered = append(filtered, types.NewCommandBatch(batch, func(types.CommandBatchMetadata) {}))
+					}
+				}
+				return filtered, &query.PageResponse{Total: uint64(len(filtered))}, nil
+			},
+			GetBatchByCommandIDFunc: func(_ sdk.Context, id types.CommandID) types.CommandBatch {
+				for _, batch := range batches {
+					if batch.CommandIDs[0] == id {
+						return types.NewCommandBatch(batch, func(types.CommandBatchMetadata) {})
+					}
+				}
+				return types.NonExistentCommand
+			},
+		}
+		baseKeeper := &mock.BaseKeeperMock{
+			HasChainFunc: func(_ sdk.Context, c nexus.ChainName) bool { return c == chain },
+			ForChainFunc: func(nexus.ChainName) types.ChainKeeper { return chainKeeper },
+		}
+
+		grpcQuerier = evmKeeper.NewGRPCQuerier(baseKeeper, &mock.NexusMock{}, &mock.MultisigKeeperMock{})
+	}
+
+	batchIDs := func(batches []types.BatchedCommandsResponse) []string {
+		return slices.Map(batches, func(batch types.BatchedCommandsResponse) string { return batch.ID })
+	}
+
+	t.Run("should return batches that match the filters", func(t *testing.T) {
+		setup()
+		res, err := grpcQuerier.CommandBatches(sdk.WrapSDKContext(ctx), &types.CommandBatchesRequest{Chain: chain.String()})
+		assert.NoError(t, err)
+		assert.Len(t, res.Batches, 4)
+		assert.EqualValues(t, 4, res.Pagination.Total)
+		assert.EqualValues(t, 20, res.Batches[1].Height)
+
+		res, err = grpcQuerier.CommandBatches(sdk.WrapSDKContext(ctx), &types.CommandBatchesRequest{Chain: chain.String(), Status: types.BatchSigning, KeyID: keyID})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{hex.EncodeToString(batches[0].ID), hex.EncodeToString(batches[2].ID)}, batchIDs(res.Batches))
+
+		res, err = grpcQuerier.CommandBatches(sdk.WrapSDKContext(ctx), &types.CommandBatchesRequest{Chain: chain.String(), MinHeight: 15, MaxHeight: 30})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{hex.EncodeToString(batches[1].ID), hex.EncodeToString(batches[2].ID)}, batchIDs(res.Batches))
+
+		res, err = grpcQuerier.CommandBatches(sdk.WrapSDKContext(ctx), &types.CommandBatchesRequest{Chain: chain.String(), MaxHeight: 20})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{hex.EncodeToString(batches[0].ID), hex.EncodeToString(batches[1].ID)}, batchIDs(res.Batches))
+	})
+
+	t.Run("should return the batch that contains the command", func(t *testing.T) {
+		setup()
+		res, err := grpcQuerier.BatchedCommandsByCommand(sdk.WrapSDKContext(ctx), &types.BatchedCommandsByCommandRequest{Chain: chain.String(), CommandId: batches[1].CommandIDs[0].Hex()})
+		assert.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(batches[1].ID), res.ID)
+
+		_, err = grpcQuerier.BatchedCommandsByCommand(sdk.WrapSDKContext(ctx), &types.BatchedCommandsByCommandRequest{Chain: chain.String(), CommandId: types.NewCommandID(rand.Bytes(10), sdk.NewInt(1)).Hex()})
+		assert.Error(t, err)
+
+		_, err = grpcQuerier.BatchedCommandsByCommand(sdk.WrapSDKContext(ctx), &types.BatchedCommandsByCommandRequest{Chain: chain.String(), CommandId: rand.Str(10)})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error if the chain does not exist", func(t *testing.T) {
+		setup()
+		_, err := grpcQuerier.CommandBatches(sdk.WrapSDKContext(ctx), &types.CommandBatchesRequest{Chain: rand.NormalizedStr(5)})
+		assert.Error(t, err)
+
+		_, err = grpcQuerier.BatchedCommandsByCommand(sdk.WrapSDKContext(ctx), &types.BatchedCommandsByCommandRequest{Chain: rand.NormalizedStr(5), CommandId: batches[0].CommandIDs[0].Hex()})
+		assert.Error(t, err)
+	})
+}
+
 func TestERC20Tokens(t *testing.T) {
 	var (
 		baseKeeper    *mock.BaseKeeperMock
@@ -22,8 +22,10 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/evm/exported"
 	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	evmTestUtils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
 	multisigTestUtils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -86,6 +88,94 @@ func TestCommands(t *testing.T) {
 			}
 		}
 	}).Repeat(repeats))
+
+	t.Run("look up batches by command and height", testutils.Func(func(t *testing.T) {
+		setup()
+		chainKeeper := keeper.ForChain(chain)
+		chainKeeper.SetParams(ctx, types.DefaultParams()[0])
+		chainID, ok := chainKeeper.GetChainID(ctx)
+		assert.True(t, ok)
+
+		batchCount := int(rand.I64Between(2, 10))
+		var batches []types.CommandBatch
+		for i := 0; i < batchCount; i++ {
+			ctx = ctx.WithBlockHeight(int64(i + 1))
+
+			cmd, err := types.CreateDeployTokenCommand(chainID, multisigTestUtils.KeyID(), rand.Str(5), createDetails(rand.NormalizedStr(10), rand.NormalizedStr(10)), types.ZeroAddress, sdk.NewUint(uint64(rand.PosI64())))
+			assert.NoError(t, err)
+			assert.NoError(t, chainKeeper.EnqueueCommand(ctx, cmd))
+
+			batch, err := chainKeeper.CreateNewBatchToSign(ctx)
+			assert.NoError(t, err)
+			assert.EqualValues(t, i+1, batch.GetHeight())
+			batch.SetStatus(types.BatchSigned)
+
+			actual := chainKeeper.GetBatchByCommandID(ctx, cmd.ID)
+			assert.Equal(t, batch.GetID(), actual.GetID())
+
+			batches = append(batches, batch)
+		}
+
+		assert.True(t, chainKeeper.GetBatchByCommandID(ctx, types.NewCommandID(rand.Bytes(32), chainID)).Is(types.BatchNonExistent))
+
+		all := func(types.CommandBatchMetadata) bool { return true }
+		actual, resp, err := chainKeeper.GetCommandBatchesPaginated(ctx, all, &query.PageRequest{CountTotal: true})
+		assert.NoError(t, err)
+		assert.EqualValues(t, batchCount, resp.Total)
+		assert.Equal(t, slices.Map(batches, types.CommandBatch.GetID), slices.Map(actual, types.CommandBatch.GetID))
+
+		actual, resp, err = chainKeeper.GetCommandBatchesPaginated(ctx, all, &query.PageRequest{Limit: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{batches[0].GetID()}, slices.Map(actual, types.CommandBatch.GetID))
+
+		actual, _, err = chainKeeper.GetCommandBatchesPaginated(ctx, all, &query.PageRequest{Key: resp.NextKey})
+		assert.NoError(t, err)
+		assert.Equal(t, slices.Map(batches[1:], types.CommandBatch.GetID), slices.Map(actual, types.CommandBatch.GetID))
+
+		first := func(batch types.CommandBatchMetadata) bool { return batch.Height == 1 }
+		actual, _, err = chainKeeper.GetCommandBatchesPaginated(ctx, first, &query.PageRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, actual, 1)
+		assert.Equal(t, batches[0].GetID(), actual[0].GetID())
+		assert.True(t, actual[0].Is(types.BatchSigned))
+	}).Repeat(repeats))
+
+	t.Run("re-queue the commands of an aborted batch for a new key", testutils.Func(func(t *testing.T) {
+		setup()
+		ctx = ctx.WithBlockHeight(rand.I64Between(1, 100))
+		chainKeeper := keeper.ForChain(chain)
+		chainKeeper.SetParams(ctx, types.DefaultParams()[0])
+		chainID, ok := chainKeeper.GetChainID(ctx)
+		assert.True(t, ok)
+
+		oldKeyID := multisigTestUtils.KeyID()
+		for i := 0; i < int(rand.I64Between(1, 20)); i++ {
+			cmd, err := types.CreateDeployTokenCommand(chainID, oldKeyID, rand.Str(5), createDetails(rand.NormalizedStr(10), rand.NormalizedStr(10)), types.ZeroAddress, sdk.NewUint(uint64(rand.PosI64())))
+			assert.NoError(t, err)
+			assert.NoError(t, chainKeeper.EnqueueCommand(ctx, cmd))
+		}
+
+		assert.Error(t, chainKeeper.RequeueCommandBatch(ctx, multisigTestUtils.KeyID()))
+
+		aborted, err := chainKeeper.CreateNewBatchToSign(ctx)
+		assert.NoError(t, err)
+		assert.True(t, aborted.SetStatus(types.BatchAborted))
+		pendingCount := len(chainKeeper.GetPendingCommands(ctx))
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + rand.I64Between(1, 100))
+		newKeyID := multisigTestUtils.KeyID()
+		assert.NoError(t, chainKeeper.RequeueCommandBatch(ctx, newKeyID))
+		assert.True(t, chainKeeper.GetLatestCommandBatch(ctx).Is(types.BatchNonExistent))
+		assert.Len(t, chainKeeper.GetPendingCommands(ctx), pendingCount+len(aborted.GetCommandIDs()))
+
+		batch, err := chainKeeper.CreateNewBatchToSign(ctx)
+		assert.NoError(t, err)
+		assert.NotEqual(t, aborted.GetID(), batch.GetID())
+		assert.Equal(t, aborted.GetCommandIDs(), batch.GetCommandIDs())
+		assert.Equal(t, newKeyID, batch.GetKeyID())
+		assert.Len(t, chainKeeper.GetPendingCommands(ctx), pendingCount)
+		assert.Equal(t, batch.GetID(), chainKeeper.GetBatchByCommandID(ctx, batch.GetCommandIDs()[0]).GetID())
+	}).Repeat(repeats))
 }
 
 func TestSetBurnerInfoGetBurnerInfo(t *testing.T) {
@@ -356,3 +446,79 @@ func TestGetConfirmedDepositsPaginated(t *testing.T) {
 		}).
 		Run(t, repeats)
 }
+
+func TestGetEventsPaginated(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		chainKeeper types.ChainKeeper
+		txID        types.Hash
+		events      []types.Event
+	)
+
+	all := func(types.Event) bool { return true }
+
+	Given("a chain keeper", func() {
+		encCfg := params.MakeEncodingConfig()
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("params"), sdk.NewKVStoreKey("tparams"))
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		chainKeeper = evmKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("evm"), paramsK).ForChain("Ethereum")
+	}).
+		When("events are confirmed", func() {
+			events = nil
+			txID = evmTestUtils.RandomHash()
+			for i := 0; i < 10; i++ {
+				event := types.Event{
+					Chain: "Ethereum",
+					TxID:  txID,
+					Index: uint64(i),
+					Event: &types.Event_ContractCall{ContractCall: &types.EventContractCall{DestinationChain: "Polygon"}},
+				}
+				if i%2 == 0 {
+					event.TxID = evmTestUtils.RandomHash()
+				}
+
+				assert.NoError(t, chainKeeper.SetConfirmedEvent(ctx, event))
+				event.Status = types.EventConfirmed
+				events = append(events, event)
+			}
+		}).
+		Branch(
+			Then("return pages of events", func(t *testing.T) {
+				var actual []types.Event
+				var key []byte
+				for {
+					page, resp, err := chainKeeper.GetEventsPaginated(ctx, nil, all, &query.PageRequest{Key: key, Limit: 3})
+					assert.NoError(t, err)
+					assert.LessOrEqual(t, len(page), 3)
+
+					actual = append(actual, page...)
+					key = resp.NextKey
+					if key == nil {
+						break
+					}
+				}
+
+				assert.ElementsMatch(t, events, actual)
+			}),
+			Then("return only events of the given tx", func(t *testing.T) {
+				actual, resp, err := chainKeeper.GetEventsPaginated(ctx, &txID, all, &query.PageRequest{CountTotal: true})
+				assert.NoError(t, err)
+				assert.EqualValues(t, 5, resp.Total)
+				assert.Len(t, actual, 5)
+				for _, event := range actual {
+					assert.Equal(t, txID, event.TxID)
+				}
+			}),
+			Then("return only events that match the filter", func(t *testing.T) {
+				assert.NoError(t, chainKeeper.SetEventFailed(ctx, events[3].GetID()))
+
+				failed := func(event types.Event) bool { return event.Status == types.EventFailed }
+				actual, resp, err := chainKeeper.GetEventsPaginated(ctx, nil, failed, &query.PageRequest{CountTotal: true})
+				assert.NoError(t, err)
+				assert.EqualValues(t, 1, resp.Total)
+				assert.Len(t, actual, 1)
+				assert.Equal(t, events[3].GetID(), actual[0].GetID())
+			}),
+		).
+		Run(t)
+}
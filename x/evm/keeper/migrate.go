@@ -14,6 +14,9 @@ import (
 // The migration includes:
 // - migrate contracts bytecode (CRUCIAL AND DO NOT DELETE) for all evm chains
 // - set TransferLimit parameter
+// - set AutoBatchInterval parameter
+// - set SignRetryLimit and SignRetryBackoff parameters
+// - index the existing command batches by the IDs of their commands and by height (0, because their height is unknown)
 func GetMigrationHandler(k BaseKeeper, n types.Nexus, m types.MultisigKeeper) func(ctx sdk.Context) error {
 	return func(ctx sdk.Context) error {
 		// migrate contracts bytecode (CRUCIAL AND DO NOT DELETE) for all evm chains
@@ -32,10 +35,41 @@ func GetMigrationHandler(k BaseKeeper, n types.Nexus, m types.MultisigKeeper) fu
 			}
 		}
 
+		// set AutoBatchInterval param
+		for _, chain := range slices.Filter(n.GetChains(ctx), types.IsEVMChain) {
+			ck := k.ForChain(chain.Name).(chainKeeper)
+			if err := addAutoBatchIntervalParam(ctx, ck); err != nil {
+				return err
+			}
+		}
+
+		// set SignRetryLimit and SignRetryBackoff params
+		for _, chain := range slices.Filter(n.GetChains(ctx), types.IsEVMChain) {
+			ck := k.ForChain(chain.Name).(chainKeeper)
+			if err := addSignRetryParams(ctx, ck); err != nil {
+				return err
+			}
+		}
+
+		// index command batches by command ID and height
+		for _, chain := range slices.Filter(n.GetChains(ctx), types.IsEVMChain) {
+			ck := k.ForChain(chain.Name).(chainKeeper)
+			indexCommandBatches(ctx, ck)
+		}
+
 		return nil
 	}
 }
 
+// indexCommandBatches indexes the existing command batches. Their creation height was not recorded,
+// so they are indexed at height 0 and are listed before all batches created after the upgrade
+func indexCommandBatches(ctx sdk.Context, ck chainKeeper) {
+	for _, batch := range ck.getCommandBatchesMetadata(ctx) {
+		ck.setCommandBatchByCommand(ctx, batch)
+		ck.setCommandBatchByHeight(ctx, batch)
+	}
+}
+
 func addTransferLimitParam(ctx sdk.Context, ck chainKeeper) error {
 	subspace, ok := ck.getSubspace(ctx)
 	if !ok {
@@ -47,6 +81,29 @@ func addTransferLimitParam(ctx sdk.Context, ck chainKeeper) error {
 	return nil
 }
 
+func addAutoBatchIntervalParam(ctx sdk.Context, ck chainKeeper) error {
+	subspace, ok := ck.getSubspace(ctx)
+	if !ok {
+		return fmt.Errorf("param subspace for chain %s should exist", ck.GetName())
+	}
+
+	subspace.Set(ctx, types.KeyAutoBatchInterval, types.DefaultParams()[0].AutoBatchInterval)
+
+	return nil
+}
+
+func addSignRetryParams(ctx sdk.Context, ck chainKeeper) error {
+	subspace, ok := ck.getSubspace(ctx)
+	if !ok {
+		return fmt.Errorf("param subspace for chain %s should exist", ck.GetName())
+	}
+
+	subspace.Set(ctx, types.KeySignRetryLimit, types.DefaultParams()[0].SignRetryLimit)
+	subspace.Set(ctx, types.KeySignRetryBackoff, types.DefaultParams()[0].SignRetryBackoff)
+
+	return nil
+}
+
 // this function migrates the contracts bytecode to the latest for every existing
 // EVM chain. It's crucial whenever contracts are changed between versions and
 // DO NOT DELETE
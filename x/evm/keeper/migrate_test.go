@@ -5,13 +5,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/axelarnetwork/axelar-core/app/params"
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/x/evm/exported"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/evm/types/mock"
@@ -79,4 +82,76 @@ func TestGetMigrationHandler(t *testing.T) {
 				assert.Equal(t, types.DefaultParams()[0].TransferLimit, ck.GetParams(ctx).TransferLimit)
 			}
 		})
+
+	givenMigrationHandler.
+		When("AutoBatchInterval param is set to a different value", func() {
+			for _, chain := range evmChains {
+				ck := keeper.ForChain(chain.Name).(chainKeeper)
+				subspace, _ := ck.getSubspace(ctx)
+				subspace.Set(ctx, types.KeyAutoBatchInterval, rand.PosI64())
+			}
+		}).
+		Then("should set AutoBatchInterval param to its default", func(t *testing.T) {
+			err := handler(ctx)
+			assert.NoError(t, err)
+
+			for _, chain := range evmChains {
+				ck := keeper.ForChain(chain.Name).(chainKeeper)
+				assert.Equal(t, types.DefaultParams()[0].AutoBatchInterval, ck.GetParams(ctx).AutoBatchInterval)
+			}
+		}).
+		Run(t)
+
+	givenMigrationHandler.
+		When("SignRetryLimit and SignRetryBackoff params are set to different values", func() {
+			for _, chain := range evmChains {
+				ck := keeper.ForChain(chain.Name).(chainKeeper)
+				subspace, _ := ck.getSubspace(ctx)
+				subspace.Set(ctx, types.KeySignRetryLimit, uint32(rand.I64Between(10, 100)))
+				subspace.Set(ctx, types.KeySignRetryBackoff, rand.I64Between(100, 1000))
+			}
+		}).
+		Then("should set SignRetryLimit and SignRetryBackoff params to their defaults", func(t *testing.T) {
+			err := handler(ctx)
+			assert.NoError(t, err)
+
+			for _, chain := range evmChains {
+				ck := keeper.ForChain(chain.Name).(chainKeeper)
+				assert.Equal(t, types.DefaultParams()[0].SignRetryLimit, ck.GetParams(ctx).SignRetryLimit)
+				assert.Equal(t, types.DefaultParams()[0].SignRetryBackoff, ck.GetParams(ctx).SignRetryBackoff)
+			}
+		}).
+		Run(t)
+
+	givenMigrationHandler.
+		When("command batches exist", func() {
+			for _, chain := range evmChains {
+				ck := keeper.ForChain(chain.Name).(chainKeeper)
+				batch := types.CommandBatchMetadata{
+					ID:         rand.Bytes(common.HashLength),
+					CommandIDs: []types.CommandID{types.NewCommandID(rand.Bytes(10), sdk.NewInt(1)), types.NewCommandID(rand.Bytes(10), sdk.NewInt(1))},
+					Status:     types.BatchSigned,
+				}
+				ck.setCommandBatchMetadata(ctx, batch)
+			}
+		}).
+		Then("should index the batches by command ID and height", func(t *testing.T) {
+			err := handler(ctx)
+			assert.NoError(t, err)
+
+			for _, chain := range evmChains {
+				ck := keeper.ForChain(chain.Name).(chainKeeper)
+				for _, batch := range ck.getCommandBatchesMetadata(ctx) {
+					for _, id := range batch.CommandIDs {
+						assert.Equal(t, batch.ID, ck.GetBatchByCommandID(ctx, id).GetID())
+					}
+				}
+
+				batches, _, err := ck.GetCommandBatchesPaginated(ctx, func(types.CommandBatchMetadata) bool { return true }, &query.PageRequest{})
+				assert.NoError(t, err)
+				assert.Len(t, batches, 1)
+				assert.EqualValues(t, 0, batches[0].GetHeight())
+			}
+		}).
+		Run(t)
 }
@@ -529,66 +529,10 @@ func (s msgServer) CreatePendingTransfers(c context.Context, req *types.CreatePe
 	}
 
 	keeper := s.ForChain(chain.Name)
-	transferLimit := keeper.GetParams(ctx).TransferLimit
-	pageRequest := &query.PageRequest{
-		Key:        nil,
-		Offset:     0,
-		Limit:      transferLimit,
-		CountTotal: false,
-		Reverse:    false,
-	}
-	pendingTransfers, _, err := s.nexus.GetTransfersForChainPaginated(ctx, chain, nexus.Pending, pageRequest)
-	if err != nil {
+	if err := CreatePendingTransfers(ctx, keeper, s.nexus, s.multisigKeeper, chain, keeper.GetParams(ctx).TransferLimit); err != nil {
 		return nil, err
 	}
 
-	if len(pendingTransfers) == 0 {
-		s.Logger(ctx).Debug("no pending transfers found")
-		return &types.CreatePendingTransfersResponse{}, nil
-	}
-
-	keyID, ok := s.multisigKeeper.GetCurrentKeyID(ctx, chain.Name)
-	if !ok {
-		return nil, fmt.Errorf("current key not set for chain %s", chain.Name)
-	}
-
-	for _, transfer := range pendingTransfers {
-		token := keeper.GetERC20TokenByAsset(ctx, transfer.Asset.Denom)
-		if !token.Is(types.Confirmed) {
-			s.Logger(ctx).Debug(fmt.Sprintf("token %s is not confirmed on %s", token.GetAsset(), chain.Name))
-			continue
-		}
-
-		cmd, err := token.CreateMintCommand(multisig.KeyID(keyID), transfer)
-		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed create mint-token command for transfer %d", transfer.ID)
-		}
-
-		s.Logger(ctx).Info(fmt.Sprintf("minting %s to recipient %s on %s with transfer ID %s and command ID %s", transfer.Asset.String(), transfer.Recipient.Address, transfer.Recipient.Chain.Name, transfer.ID.String(), cmd.ID.Hex()),
-			types.AttributeKeyDestinationChain, transfer.Recipient.Chain.Name,
-			types.AttributeKeyDestinationAddress, transfer.Recipient.Address,
-			sdk.AttributeKeyAmount, transfer.Asset.String(),
-			types.AttributeKeyAsset, transfer.Asset.Denom,
-			types.AttributeKeyTransferID, transfer.ID.String(),
-			types.AttributeKeyCommandsID, cmd.ID.Hex(),
-		)
-
-		if err := keeper.EnqueueCommand(ctx, cmd); err != nil {
-			return nil, err
-		}
-
-		funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.MintCommand{
-			Chain:              chain.Name,
-			TransferID:         transfer.ID,
-			CommandID:          cmd.ID,
-			DestinationChain:   transfer.Recipient.Chain.Name,
-			DestinationAddress: transfer.Recipient.Address,
-			Asset:              transfer.Asset,
-		}))
-
-		s.nexus.ArchivePendingTransfer(ctx, transfer)
-	}
-
 	return &types.CreatePendingTransfersResponse{}, nil
 }
 
@@ -648,19 +592,6 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, keeper types.ChainK
 	return types.CreateMultisigTransferCommand(chainID, keyID, nextKey), nil
 }
 
-func getCommandBatchToSign(ctx sdk.Context, keeper types.ChainKeeper) (types.CommandBatch, error) {
-	latest := keeper.GetLatestCommandBatch(ctx)
-
-	switch latest.GetStatus() {
-	case types.BatchSigning:
-		return types.CommandBatch{}, sdkerrors.Wrapf(types.ErrSignCommandsInProgress, "command batch '%s'", hex.EncodeToString(latest.GetID()))
-	case types.BatchAborted:
-		return latest, nil
-	default:
-		return keeper.CreateNewBatchToSign(ctx)
-	}
-}
-
 func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsRequest) (*types.SignCommandsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	chain, ok := s.nexus.GetChain(ctx, req.Chain)
@@ -672,13 +603,7 @@ func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsReques
 		return nil, err
 	}
 
-	keeper := s.ForChain(chain.Name)
-
-	if _, ok := keeper.GetChainID(ctx); !ok {
-		return nil, fmt.Errorf("could not find chain ID for '%s'", chain.Name)
-	}
-
-	commandBatch, err := getCommandBatchToSign(ctx, keeper)
+	commandBatch, err := SignCommands(ctx, s.ForChain(chain.Name), s.multisigKeeper, chain)
 	if err != nil {
 		return nil, err
 	}
@@ -686,31 +611,8 @@ func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsReques
 		return &types.SignCommandsResponse{CommandCount: 0, BatchedCommandsID: nil}, nil
 	}
 
-	if err := s.multisigKeeper.Sign(
-		ctx,
-		commandBatch.GetKeyID(),
-		commandBatch.GetSigHash().Bytes(),
-		types.ModuleName,
-		types.NewSigMetadata(types.SigCommand, chain.Name, commandBatch.GetID()),
-	); err != nil {
-		return nil, err
-	}
-
-	if !commandBatch.SetStatus(types.BatchSigning) {
-		return nil, fmt.Errorf("failed setting status of command batch %s to be signing", hex.EncodeToString(commandBatch.GetID()))
-	}
-
 	batchedCommandsIDHex := hex.EncodeToString(commandBatch.GetID())
 	commandList := types.CommandIDsToStrings(commandBatch.GetCommandIDs())
-	for _, commandID := range commandList {
-		s.Logger(ctx).Info(
-			fmt.Sprintf("signing command %s in batch %s for chain %s using key %s", commandID, batchedCommandsIDHex, chain.Name, string(commandBatch.GetKeyID())),
-			types.AttributeKeyChain, chain.Name,
-			types.AttributeKeyKeyID, string(commandBatch.GetKeyID()),
-			"commandBatchID", batchedCommandsIDHex,
-			"commandID", commandID,
-		)
-	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -802,9 +704,10 @@ func (s msgServer) initializePoll(ctx sdk.Context, chain nexus.Chain, txID types
 		return vote.PollParticipants{}, err
 	}
 
+	expiresAt := ctx.BlockHeight() + params.RevoteLockingPeriod
 	pollID, err := s.voter.InitializePoll(
 		ctx,
-		vote.NewPollBuilder(types.ModuleName, params.VotingThreshold, snap, ctx.BlockHeight()+params.RevoteLockingPeriod).
+		vote.NewPollBuilder(types.ModuleName, params.VotingThreshold, snap, expiresAt).
 			MinVoterCount(params.MinVoterCount).
 			RewardPoolName(chain.Name.String()).
 			GracePeriod(keeper.GetParams(ctx).VotingGracePeriod).
@@ -816,5 +719,6 @@ func (s msgServer) initializePoll(ctx sdk.Context, chain nexus.Chain, txID types
 	return vote.PollParticipants{
 		PollID:       pollID,
 		Participants: snap.GetParticipantAddresses(),
+		ExpiresAt:    expiresAt,
 	}, err
 }
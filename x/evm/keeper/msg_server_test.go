@@ -179,7 +179,7 @@ func TestSignCommands(t *testing.T) {
 		}
 
 		chainKeeper := &mock.ChainKeeperMock{}
-		evmBaseKeeper.LoggerFunc = func(ctx sdk.Context) log.Logger { return ctx.Logger() }
+		chainKeeper.LoggerFunc = func(ctx sdk.Context) log.Logger { return ctx.Logger() }
 		evmBaseKeeper.ForChainFunc = func(chain nexus.ChainName) types.ChainKeeper { return chainKeeper }
 		chainKeeper.GetChainIDFunc = func(ctx sdk.Context) (sdk.Int, bool) { return sdk.NewInt(0), true }
 		chainKeeper.GetLatestCommandBatchFunc = func(ctx sdk.Context) types.CommandBatch {
@@ -217,7 +217,7 @@ func TestSignCommands(t *testing.T) {
 		}
 
 		chainKeeper := &mock.ChainKeeperMock{}
-		evmBaseKeeper.LoggerFunc = func(ctx sdk.Context) log.Logger { return ctx.Logger() }
+		chainKeeper.LoggerFunc = func(ctx sdk.Context) log.Logger { return ctx.Logger() }
 		evmBaseKeeper.ForChainFunc = func(chain nexus.ChainName) types.ChainKeeper { return chainKeeper }
 		chainKeeper.GetChainIDFunc = func(ctx sdk.Context) (sdk.Int, bool) { return sdk.NewInt(0), true }
 		chainKeeper.GetLatestCommandBatchFunc = func(ctx sdk.Context) types.CommandBatch {
@@ -237,6 +237,7 @@ func TestSignCommands(t *testing.T) {
 
 		assert.Len(t, chainKeeper.CreateNewBatchToSignCalls(), 0)
 		assert.Len(t, signerKeeper.SignCalls(), 1)
+		assert.Equal(t, commandBatch.KeyID, signerKeeper.SignCalls()[0].KeyID)
 	}))
 }
 
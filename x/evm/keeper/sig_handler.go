@@ -53,6 +53,8 @@ func (s sigHandler) HandleFailed(ctx sdk.Context, moduleMetadata codec.ProtoMars
 
 	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(types.NewCommandBatchAborted(sigMetadata.Chain, sigMetadata.CommandBatchID)))
 
+	ScheduleSignRetry(ctx, s.keeper.ForChain(sigMetadata.Chain), commandBatch)
+
 	return nil
 }
 
@@ -115,3 +115,61 @@ func TestHandleCompleted(t *testing.T) {
 		}).
 		Run(t)
 }
+
+func TestHandleFailed(t *testing.T) {
+	var (
+		ctx                  sdk.Context
+		chaink               *mock.ChainKeeperMock
+		moduleMetadata       codec.ProtoMarshaler
+		handler              multisig.SigHandler
+		commandBatchMetadata types.CommandBatchMetadata
+		params               types.Params
+	)
+
+	givenSigningBatch := Given("a command batch being signed", func() {
+		ctx, _, chaink, handler = setup2()
+		ctx = ctx.WithBlockHeight(rand.I64Between(1, 1000))
+
+		moduleMetadata = funcs.Must(codectypes.NewAnyWithValue(&types.SigMetadata{
+			Type:           types.SigCommand,
+			Chain:          exported.Ethereum.Name,
+			CommandBatchID: rand.Bytes(common.HashLength),
+		})).GetCachedValue().(codec.ProtoMarshaler)
+
+		commandBatchMetadata = types.CommandBatchMetadata{Status: types.BatchSigning}
+		chaink.GetBatchByIDFunc = func(ctx sdk.Context, id []byte) types.CommandBatch {
+			return types.NewCommandBatch(
+				commandBatchMetadata,
+				func(batch types.CommandBatchMetadata) { commandBatchMetadata = batch })
+		}
+
+		params = types.Params{SignRetryLimit: uint32(rand.I64Between(1, 10)), SignRetryBackoff: rand.I64Between(1, 100)}
+		chaink.GetParamsFunc = func(sdk.Context) types.Params { return params }
+		chaink.GetNameFunc = func() string { return exported.Ethereum.Name.String() }
+		chaink.LoggerFunc = func(ctx sdk.Context) log.Logger { return ctx.Logger() }
+	})
+
+	givenSigningBatch.
+		When("the retry limit is not reached", func() {
+			commandBatchMetadata.SignRetries = uint32(rand.I64Between(0, int64(params.SignRetryLimit)))
+		}).
+		Then("should abort the batch and schedule a retry", func(t *testing.T) {
+			retries := commandBatchMetadata.SignRetries
+
+			assert.NoError(t, handler.HandleFailed(ctx, moduleMetadata))
+			assert.Equal(t, types.BatchAborted, commandBatchMetadata.Status)
+			assert.Equal(t, ctx.BlockHeight()+params.SignRetryBackoff*int64(retries+1), commandBatchMetadata.SignRetryHeight)
+		}).
+		Run(t, 20)
+
+	givenSigningBatch.
+		When("the retry limit is reached", func() {
+			commandBatchMetadata.SignRetries = params.SignRetryLimit
+		}).
+		Then("should abort the batch without scheduling a retry", func(t *testing.T) {
+			assert.NoError(t, handler.HandleFailed(ctx, moduleMetadata))
+			assert.Equal(t, types.BatchAborted, commandBatchMetadata.Status)
+			assert.Zero(t, commandBatchMetadata.SignRetryHeight)
+		}).
+		Run(t)
+}
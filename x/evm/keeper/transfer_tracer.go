@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+var _ nexus.TransferTracer = transferTracer{}
+
+type transferTracer struct {
+	keeper types.BaseKeeper
+	nexus  types.Nexus
+}
+
+// NewTransferTracer returns the tracer of cross-chain transfers from and to EVM chains
+func NewTransferTracer(keeper types.BaseKeeper, n types.Nexus) nexus.TransferTracer {
+	return transferTracer{keeper: keeper, nexus: n}
+}
+
+// TraceSourceTx returns the confirmed events of the given tx on the given EVM chain and the transfers they started
+func (t transferTracer) TraceSourceTx(ctx sdk.Context, chain nexus.ChainName, txID string) ([]nexus.TraceStep, []nexus.TransferID) {
+	if !t.keeper.HasChain(ctx, chain) {
+		return nil, nil
+	}
+
+	bz, err := hex.DecodeString(strings.TrimPrefix(txID, "0x"))
+	if err != nil || len(bz) != common.HashLength {
+		return nil, nil
+	}
+	hash := types.Hash(common.BytesToHash(bz))
+
+	ck := t.keeper.ForChain(chain)
+	events, _, err := ck.GetEventsPaginated(ctx, &hash, func(types.Event) bool { return true }, &query.PageRequest{Limit: query.MaxLimit})
+	funcs.MustNoErr(err)
+
+	var steps []nexus.TraceStep
+	var transferIDs []nexus.TransferID
+	for _, event := range events {
+		steps = append(steps, t.traceEvent(ctx, ck, event)...)
+
+		if transferID, ok := ck.GetEventTransferID(ctx, event.GetID()); ok {
+			transferIDs = append(transferIDs, transferID)
+		}
+	}
+
+	return steps, transferIDs
+}
+
+// TraceSource returns the event that started the given transfer if it originates from an EVM chain
+func (t transferTracer) TraceSource(ctx sdk.Context, id nexus.TransferID) []nexus.TraceStep {
+	for _, ck := range t.chainKeepers(ctx) {
+		eventID, ok := ck.GetTransferEventID(ctx, id)
+		if !ok {
+			continue
+		}
+
+		event, ok := ck.GetEvent(ctx, eventID)
+		if !ok {
+			continue
+		}
+
+		return t.traceEvent(ctx, ck, event)
+	}
+
+	return nil
+}
+
+// TraceDestination returns the command that mints the assets of the given transfer and the batch that contains it
+func (t transferTracer) TraceDestination(ctx sdk.Context, transfer nexus.CrossChainTransfer) []nexus.TraceStep {
+	if !t.keeper.HasChain(ctx, transfer.Recipient.Chain.Name) {
+		return nil
+	}
+
+	return traceCommand(ctx, t.keeper.ForChain(transfer.Recipient.Chain.Name), transfer.Recipient.Chain.Name, types.TransferIDToCommandID(transfer.ID))
+}
+
+func (t transferTracer) chainKeepers(ctx sdk.Context) []types.ChainKeeper {
+	var chainKeepers []types.ChainKeeper
+	for _, chain := range t.nexus.GetChains(ctx) {
+		if chain.Module != types.ModuleName || !t.keeper.HasChain(ctx, chain.Name) {
+			continue
+		}
+
+		chainKeepers = append(chainKeepers, t.keeper.ForChain(chain.Name))
+	}
+
+	return chainKeepers
+}
+
+// traceEvent returns the given event and the records that resulted from it directly, i.e. the deposit of a transfer
+// to a deposit address or the command that approves a contract call
+func (t transferTracer) traceEvent(ctx sdk.Context, ck types.ChainKeeper, event types.Event) []nexus.TraceStep {
+	steps := []nexus.TraceStep{{
+		Module: types.ModuleName,
+		Chain:  event.Chain,
+		Type:   event.GetEventTypeName(),
+		ID:     string(event.GetID()),
+		Status: event.Status.String(),
+	}}
+
+	switch e := event.GetEvent().(type) {
+	case *types.Event_Transfer:
+		burnerInfo := ck.GetBurnerInfo(ctx, e.Transfer.To)
+		if burnerInfo == nil {
+			break
+		}
+
+		if _, status, ok := ck.GetDeposit(ctx, event.TxID, burnerInfo.BurnerAddress); ok {
+			steps = append(steps, nexus.TraceStep{
+				Module: types.ModuleName,
+				Chain:  event.Chain,
+				Type:   "deposit",
+				ID:     fmt.Sprintf("%s-%s", event.TxID.Hex(), burnerInfo.BurnerAddress.Hex()),
+				Status: status.String(),
+			})
+		}
+	case *types.Event_ContractCall:
+		steps = append(steps, t.traceContractCall(ctx, event, e.ContractCall.DestinationChain)...)
+	case *types.Event_ContractCallWithToken:
+		steps = append(steps, t.traceContractCall(ctx, event, e.ContractCallWithToken.DestinationChain)...)
+	}
+
+	return steps
+}
+
+func (t transferTracer) traceContractCall(ctx sdk.Context, event types.Event, destinationChain nexus.ChainName) []nexus.TraceStep {
+	if !t.keeper.HasChain(ctx, destinationChain) {
+		return nil
+	}
+
+	destinationCk := t.keeper.ForChain(destinationChain)
+	chainID, ok := destinationCk.GetChainID(ctx)
+	if !ok {
+		return nil
+	}
+
+	return traceCommand(ctx, destinationCk, destinationChain, types.NewApproveContractCallCommandID(chainID, event.TxID, event.Index))
+}
+
+// traceCommand returns the given command and the batch that contains it if the command exists
+func traceCommand(ctx sdk.Context, ck types.ChainKeeper, chain nexus.ChainName, id types.CommandID) []nexus.TraceStep {
+	if _, ok := ck.GetCommand(ctx, id); !ok {
+		return nil
+	}
+
+	batch := ck.GetBatchByCommandID(ctx, id)
+	if batch.Is(types.BatchNonExistent) {
+		return []nexus.TraceStep{{Module: types.ModuleName, Chain: chain, Type: "command", ID: id.Hex(), Status: "queued"}}
+	}
+
+	return []nexus.TraceStep{
+		{Module: types.ModuleName, Chain: chain, Type: "command", ID: id.Hex(), Status: "batched"},
+		{Module: types.ModuleName, Chain: chain, Type: "command_batch", ID: hex.EncodeToString(batch.GetID()), Status: batch.GetStatus().String()},
+	}
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
+	"github.com/axelarnetwork/axelar-core/x/evm/exported"
+	"github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	"github.com/axelarnetwork/axelar-core/x/evm/types/mock"
+	evmTestUtils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+func TestTransferTracer(t *testing.T) {
+	var (
+		ctx    sdk.Context
+		bk     *mock.BaseKeeperMock
+		ck     *mock.ChainKeeperMock
+		tracer nexus.TransferTracer
+	)
+
+	setup := func() {
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+		ck = &mock.ChainKeeperMock{}
+		bk = &mock.BaseKeeperMock{
+			HasChainFunc: func(_ sdk.Context, chain nexus.ChainName) bool { return chain == exported.Ethereum.Name },
+			ForChainFunc: func(nexus.ChainName) types.ChainKeeper { return ck },
+		}
+		n := &mock.NexusMock{
+			GetChainsFunc: func(sdk.Context) []nexus.Chain { return []nexus.Chain{axelarnet.Axelarnet, exported.Ethereum} },
+		}
+		tracer = keeper.NewTransferTracer(bk, n)
+	}
+
+	t.Run("should trace the events of a source tx and the transfers they started", func(t *testing.T) {
+		setup()
+
+		txID := evmTestUtils.RandomHash()
+		tokenSent := types.Event{Chain: exported.Ethereum.Name, TxID: txID, Index: 0, Status: types.EventCompleted, Event: &types.Event_TokenSent{TokenSent: &types.EventTokenSent{}}}
+		deposit := types.Event{Chain: exported.Ethereum.Name, TxID: txID, Index: 1, Status: types.EventCompleted, Event: &types.Event_Transfer{Transfer: &types.EventTransfer{To: evmTestUtils.RandomAddress()}}}
+		burnerInfo := evmTestUtils.RandomBurnerInfo()
+		transferIDs := map[types.EventID]nexus.TransferID{tokenSent.GetID(): nexus.TransferID(rand.PosI64()), deposit.GetID(): nexus.TransferID(rand.PosI64())}
+
+		ck.GetEventsPaginatedFunc = func(_ sdk.Context, id *types.Hash, _ func(types.Event) bool, _ *query.PageRequest) ([]types.Event, *query.PageResponse, error) {
+			if *id != txID {
+				return nil, &query.PageResponse{}, nil
+			}
+			return []types.Event{tokenSent, deposit}, &query.PageResponse{}, nil
+		}
+		ck.GetEventTransferIDFunc = func(_ sdk.Context, eventID types.EventID) (nexus.TransferID, bool) {
+			id, ok := transferIDs[eventID]
+			return id, ok
+		}
+		ck.GetBurnerInfoFunc = func(sdk.Context, types.Address) *types.BurnerInfo { return &burnerInfo }
+		ck.GetDepositFunc = func(sdk.Context, types.Hash, types.Address) (types.ERC20Deposit, types.DepositStatus, bool) {
+			return types.ERC20Deposit{}, types.DepositStatus_Confirmed, true
+		}
+
+		steps, ids := tracer.TraceSourceTx(ctx, exported.Ethereum.Name, txID.Hex())
+		assert.Equal(t, []nexus.TransferID{transferIDs[tokenSent.GetID()], transferIDs[deposit.GetID()]}, ids)
+		assert.Equal(t, []nexus.TraceStep{
+			{Module: types.ModuleName, Chain: exported.Ethereum.Name, Type: "token_sent", ID: string(tokenSent.GetID()), Status: types.EventCompleted.String()},
+			{Module: types.ModuleName, Chain: exported.Ethereum.Name, Type: "transfer", ID: string(deposit.GetID()), Status: types.EventCompleted.String()},
+			{Module: types.ModuleName, Chain: exported.Ethereum.Name, Type: "deposit", ID: txID.Hex() + "-" + burnerInfo.BurnerAddress.Hex(), Status: types.DepositStatus_Confirmed.String()},
+		}, steps)
+		assert.Len(t, bk.ForChainCalls(), 1)
+
+		steps, ids = tracer.TraceSourceTx(ctx, exported.Ethereum.Name, "not a tx ID")
+		assert.Empty(t, steps)
+		assert.Empty(t, ids)
+
+		steps, ids = tracer.TraceSourceTx(ctx, axelarnet.Axelarnet.Name, txID.Hex())
+		assert.Empty(t, steps)
+		assert.Empty(t, ids)
+		assert.Len(t, ck.GetEventsPaginatedCalls(), 1)
+	})
+
+	t.Run("should trace the event that started a transfer", func(t *testing.T) {
+		setup()
+
+		event := evmTestUtils.RandomEvent(types.EventCompleted)
+		event.Event = &types.Event_TokenSent{TokenSent: &types.EventTokenSent{}}
+		transferID := nexus.TransferID(rand.PosI64())
+
+		ck.GetTransferEventIDFunc = func(_ sdk.Context, id nexus.TransferID) (types.EventID, bool) { return event.GetID(), id == transferID }
+		ck.GetEventFunc = func(sdk.Context, types.EventID) (types.Event, bool) { return event, true }
+
+		assert.Equal(t, []nexus.TraceStep{{Module: types.ModuleName, Chain: event.Chain, Type: "token_sent", ID: string(event.GetID()), Status: types.EventCompleted.String()}}, tracer.TraceSource(ctx, transferID))
+		assert.Empty(t, tracer.TraceSource(ctx, transferID+1))
+	})
+
+	t.Run("should trace the mint command of a transfer and its batch", func(t *testing.T) {
+		setup()
+
+		transfer := nexus.NewCrossChainTransfer(uint64(rand.PosI64()), nexus.CrossChainAddress{Chain: exported.Ethereum, Address: evmTestUtils.RandomAddress().Hex()}, sdk.NewCoin(rand.Denom(5, 10), sdk.NewInt(rand.PosI64())), nexus.Archived)
+		commandID := types.TransferIDToCommandID(transfer.ID)
+		batch := evmTestUtils.RandomBatch()
+		batch.Status = types.BatchSigned
+
+		ck.GetCommandFunc = func(_ sdk.Context, id types.CommandID) (types.Command, bool) { return types.Command{}, id == commandID }
+		ck.GetBatchByCommandIDFunc = func(sdk.Context, types.CommandID) types.CommandBatch { return types.NonExistentCommand }
+
+		assert.Equal(t, []nexus.TraceStep{{Module: types.ModuleName, Chain: exported.Ethereum.Name, Type: "command", ID: commandID.Hex(), Status: "queued"}}, tracer.TraceDestination(ctx, transfer))
+
+		ck.GetBatchByCommandIDFunc = func(sdk.Context, types.CommandID) types.CommandBatch {
+			return types.NewCommandBatch(batch, func(types.CommandBatchMetadata) {})
+		}
+
+		assert.Equal(t, []nexus.TraceStep{
+			{Module: types.ModuleName, Chain: exported.Ethereum.Name, Type: "command", ID: commandID.Hex(), Status: "batched"},
+			{Module: types.ModuleName, Chain: exported.Ethereum.Name, Type: "command_batch", ID: hex.EncodeToString(batch.ID), Status: types.BatchSigned.String()},
+		}, tracer.TraceDestination(ctx, transfer))
+
+		transfer.ID++
+		assert.Empty(t, tracer.TraceDestination(ctx, transfer))
+	})
+}
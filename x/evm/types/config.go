@@ -1,10 +1,40 @@
 package types
 
+import "time"
+
+// Modes to use multiple JSON-RPC endpoints of the same chain
+const (
+	RPCModeQuorum   = "quorum"
+	RPCModeFailover = "failover"
+)
+
+// Strategies to decide if a block of an EVM chain is final
+const (
+	FinalityConfirmationHeight = "confirmation_height"
+	FinalityFinalizedTag       = "finalized_tag"
+	FinalityMoonbeam           = "moonbeam"
+	FinalityRollup             = "rollup"
+)
+
 // EVMConfig contains all EVM module configuration values
 type EVMConfig struct {
-	Name       string `mapstructure:"name"`
-	RPCAddr    string `mapstructure:"rpc_addr"`
-	WithBridge bool   `mapstructure:"start-with-bridge"`
+	Name                   string        `mapstructure:"name"`
+	RPCAddr                string        `mapstructure:"rpc_addr"`
+	AdditionalRPCAddrs     []string      `mapstructure:"additional_rpc_addrs"`     // Further JSON-RPC endpoints of the same chain that are queried together with rpc_addr
+	RPCMode                string        `mapstructure:"rpc_mode"`                 // Either "quorum" to query all endpoints in parallel, or "failover" to only switch to the next endpoint when the current one fails. Defaults to quorum.
+	RPCQuorum              int           `mapstructure:"rpc_quorum"`               // The number of endpoints that must agree on a result in quorum mode. Defaults to a majority of all endpoints if set to 0.
+	FailoverCallTimeout    time.Duration `mapstructure:"failover_call_timeout"`    // The time after which a call is aborted and the next endpoint is tried in failover mode. Defaults to 10s if set to 0.
+	FailoverMaxFailures    int           `mapstructure:"failover_max_failures"`    // The number of failed calls in a row after which an endpoint is ejected in failover mode. Defaults to 3 if set to 0.
+	FailoverEjectionPeriod time.Duration `mapstructure:"failover_ejection_period"` // The duration for which an ejected endpoint is skipped in failover mode. Defaults to 1m if set to 0.
+	Finality               string        `mapstructure:"finality"`                 // The strategy to decide if a block is final, one of "confirmation_height", "finalized_tag", "moonbeam" or "rollup". Detected from the supported RPCs if not set.
+	L1RPCAddr              string        `mapstructure:"l1_rpc_addr"`              // The JSON-RPC endpoint of the L1 chain whose finality decides the finality of a rollup, required by the "rollup" finality
+	GatewayDiscovery       bool          `mapstructure:"gateway_discovery"`        // Watches the gateway for new ContractCall, ContractCallWithToken and TokenSent events and requests their confirmation automatically
+	WithBridge             bool          `mapstructure:"start-with-bridge"`
+}
+
+// RPCAddrs returns all configured JSON-RPC endpoints of the chain
+func (c EVMConfig) RPCAddrs() []string {
+	return append([]string{c.RPCAddr}, c.AdditionalRPCAddrs...)
 }
 
 // DefaultConfig returns a configuration populated with default values
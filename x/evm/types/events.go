@@ -1,6 +1,7 @@
 package types
 
 import (
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 )
@@ -68,3 +69,8 @@ func NewCommandBatchSigned(chain nexus.ChainName, batchID []byte) *CommandBatchS
 func NewCommandBatchAborted(chain nexus.ChainName, batchID []byte) *CommandBatchAborted {
 	return &CommandBatchAborted{Chain: chain, CommandBatchID: batchID}
 }
+
+// NewCommandBatchSignRetried returns a new CommandBatchSignRetried instance
+func NewCommandBatchSignRetried(chain nexus.ChainName, batchID []byte, retry uint32, keyID multisig.KeyID) *CommandBatchSignRetried {
+	return &CommandBatchSignRetried{Chain: chain, CommandBatchID: batchID, Retry: retry, KeyID: keyID}
+}
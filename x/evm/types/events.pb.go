@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	github_com_axelarnetwork_axelar_core_x_vote_exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
@@ -597,6 +598,74 @@ func (m *CommandBatchAborted) GetCommandBatchID() []byte {
 	return nil
 }
 
+type CommandBatchSignRetried struct {
+	Chain          github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	CommandBatchID []byte                                                          `protobuf:"bytes,2,opt,name=command_batch_id,json=commandBatchId,proto3" json:"command_batch_id,omitempty"`
+	Retry          uint32                                                          `protobuf:"varint,3,opt,name=retry,proto3" json:"retry,omitempty"`
+	KeyID          github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID  `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
+}
+
+func (m *CommandBatchSignRetried) Reset()         { *m = CommandBatchSignRetried{} }
+func (m *CommandBatchSignRetried) String() string { return proto.CompactTextString(m) }
+func (*CommandBatchSignRetried) ProtoMessage()    {}
+func (*CommandBatchSignRetried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_091c22a44ca6f0af, []int{11}
+}
+func (m *CommandBatchSignRetried) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandBatchSignRetried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandBatchSignRetried.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandBatchSignRetried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandBatchSignRetried.Merge(m, src)
+}
+func (m *CommandBatchSignRetried) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandBatchSignRetried) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandBatchSignRetried.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandBatchSignRetried proto.InternalMessageInfo
+
+func (m *CommandBatchSignRetried) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *CommandBatchSignRetried) GetCommandBatchID() []byte {
+	if m != nil {
+		return m.CommandBatchID
+	}
+	return nil
+}
+
+func (m *CommandBatchSignRetried) GetRetry() uint32 {
+	if m != nil {
+		return m.Retry
+	}
+	return 0
+}
+
+func (m *CommandBatchSignRetried) GetKeyID() github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
 type EVMEventConfirmed struct {
 	Chain   github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	EventID EventID                                                         `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3,casttype=EventID" json:"event_id,omitempty"`
@@ -607,7 +676,7 @@ func (m *EVMEventConfirmed) Reset()         { *m = EVMEventConfirmed{} }
 func (m *EVMEventConfirmed) String() string { return proto.CompactTextString(m) }
 func (*EVMEventConfirmed) ProtoMessage()    {}
 func (*EVMEventConfirmed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{11}
+	return fileDescriptor_091c22a44ca6f0af, []int{12}
 }
 func (m *EVMEventConfirmed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +736,7 @@ func (m *EVMEventCompleted) Reset()         { *m = EVMEventCompleted{} }
 func (m *EVMEventCompleted) String() string { return proto.CompactTextString(m) }
 func (*EVMEventCompleted) ProtoMessage()    {}
 func (*EVMEventCompleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{12}
+	return fileDescriptor_091c22a44ca6f0af, []int{13}
 }
 func (m *EVMEventCompleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +796,7 @@ func (m *EVMEventFailed) Reset()         { *m = EVMEventFailed{} }
 func (m *EVMEventFailed) String() string { return proto.CompactTextString(m) }
 func (*EVMEventFailed) ProtoMessage()    {}
 func (*EVMEventFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{13}
+	return fileDescriptor_091c22a44ca6f0af, []int{14}
 }
 func (m *EVMEventFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +856,7 @@ func (m *EVMEventRetryFailed) Reset()         { *m = EVMEventRetryFailed{} }
 func (m *EVMEventRetryFailed) String() string { return proto.CompactTextString(m) }
 func (*EVMEventRetryFailed) ProtoMessage()    {}
 func (*EVMEventRetryFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{14}
+	return fileDescriptor_091c22a44ca6f0af, []int{15}
 }
 func (m *EVMEventRetryFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +920,7 @@ func (m *ContractCallApproved) Reset()         { *m = ContractCallApproved{} }
 func (m *ContractCallApproved) String() string { return proto.CompactTextString(m) }
 func (*ContractCallApproved) ProtoMessage()    {}
 func (*ContractCallApproved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{15}
+	return fileDescriptor_091c22a44ca6f0af, []int{16}
 }
 func (m *ContractCallApproved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +999,7 @@ func (m *ContractCallWithMintApproved) Reset()         { *m = ContractCallWithMi
 func (m *ContractCallWithMintApproved) String() string { return proto.CompactTextString(m) }
 func (*ContractCallWithMintApproved) ProtoMessage()    {}
 func (*ContractCallWithMintApproved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{16}
+	return fileDescriptor_091c22a44ca6f0af, []int{17}
 }
 func (m *ContractCallWithMintApproved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1084,7 @@ func (m *TokenSent) Reset()         { *m = TokenSent{} }
 func (m *TokenSent) String() string { return proto.CompactTextString(m) }
 func (*TokenSent) ProtoMessage()    {}
 func (*TokenSent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{17}
+	return fileDescriptor_091c22a44ca6f0af, []int{18}
 }
 func (m *TokenSent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,7 +1175,7 @@ func (m *MintCommand) Reset()         { *m = MintCommand{} }
 func (m *MintCommand) String() string { return proto.CompactTextString(m) }
 func (*MintCommand) ProtoMessage()    {}
 func (*MintCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{18}
+	return fileDescriptor_091c22a44ca6f0af, []int{19}
 }
 func (m *MintCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1251,7 @@ func (m *BurnCommand) Reset()         { *m = BurnCommand{} }
 func (m *BurnCommand) String() string { return proto.CompactTextString(m) }
 func (*BurnCommand) ProtoMessage()    {}
 func (*BurnCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{19}
+	return fileDescriptor_091c22a44ca6f0af, []int{20}
 }
 func (m *BurnCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1251,6 +1320,7 @@ func init() {
 	proto.RegisterType((*ChainAdded)(nil), "axelar.evm.v1beta1.ChainAdded")
 	proto.RegisterType((*CommandBatchSigned)(nil), "axelar.evm.v1beta1.CommandBatchSigned")
 	proto.RegisterType((*CommandBatchAborted)(nil), "axelar.evm.v1beta1.CommandBatchAborted")
+	proto.RegisterType((*CommandBatchSignRetried)(nil), "axelar.evm.v1beta1.CommandBatchSignRetried")
 	proto.RegisterType((*EVMEventConfirmed)(nil), "axelar.evm.v1beta1.EVMEventConfirmed")
 	proto.RegisterType((*EVMEventCompleted)(nil), "axelar.evm.v1beta1.EVMEventCompleted")
 	proto.RegisterType((*EVMEventFailed)(nil), "axelar.evm.v1beta1.EVMEventFailed")
@@ -1265,78 +1335,82 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/events.proto", fileDescriptor_091c22a44ca6f0af) }
 
 var fileDescriptor_091c22a44ca6f0af = []byte{
-	// 1125 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0xe3, 0xc4,
-	0x17, 0xaf, 0x13, 0x27, 0x69, 0x5e, 0xb2, 0xfd, 0xe1, 0xf6, 0xbb, 0xdf, 0x6e, 0x85, 0xe2, 0x2a,
-	0x17, 0xc2, 0x01, 0x9b, 0x02, 0x2b, 0x21, 0x01, 0x82, 0x3a, 0x29, 0x6c, 0x58, 0x75, 0xb5, 0xf2,
-	0x56, 0x20, 0x10, 0x52, 0x34, 0xb1, 0xa7, 0x89, 0xb5, 0xb6, 0xc7, 0xb2, 0xa7, 0xd9, 0xe4, 0x06,
-	0x37, 0x8e, 0xfc, 0x23, 0x70, 0x58, 0x09, 0xf1, 0x2f, 0x2c, 0xb7, 0x1e, 0x57, 0x1c, 0x22, 0x94,
-	0x1e, 0x40, 0xfc, 0x01, 0x1c, 0x8a, 0x90, 0x90, 0xc7, 0x63, 0xc7, 0xe9, 0x16, 0x35, 0x2c, 0xc9,
-	0x92, 0xdd, 0x72, 0xf3, 0xfc, 0x7a, 0xf3, 0x79, 0x9f, 0xcf, 0x3c, 0xcf, 0x7b, 0x36, 0xc8, 0xa8,
-	0x8f, 0x6d, 0xe4, 0xab, 0xb8, 0xe7, 0xa8, 0xbd, 0xdd, 0x36, 0xa6, 0x68, 0x57, 0xc5, 0x3d, 0xec,
-	0xd2, 0x40, 0xf1, 0x7c, 0x42, 0x89, 0x24, 0x45, 0x13, 0x14, 0xdc, 0x73, 0x14, 0x3e, 0x61, 0x7b,
-	0xb3, 0x43, 0x3a, 0x84, 0x0d, 0xab, 0xe1, 0x53, 0x34, 0x73, 0xbb, 0xc6, 0x4d, 0xf5, 0x08, 0xc5,
-	0x2a, 0xee, 0x7b, 0xc4, 0xa7, 0xd8, 0x4c, 0x8c, 0xd2, 0x81, 0x87, 0xb9, 0xcd, 0xed, 0xca, 0x05,
-	0x9b, 0x4e, 0x8c, 0x1b, 0x24, 0x70, 0x48, 0xa0, 0xb6, 0x51, 0x80, 0x93, 0x09, 0x06, 0xb1, 0xdc,
-	0x68, 0xbc, 0x7a, 0x26, 0x00, 0xdc, 0x25, 0xb6, 0xfd, 0x01, 0xb2, 0x6c, 0x6c, 0x4a, 0xaf, 0x40,
-	0x8e, 0xf6, 0x5b, 0x96, 0xb9, 0x25, 0xec, 0x08, 0xb5, 0xb2, 0xb6, 0xf9, 0x68, 0x28, 0x2f, 0xfd,
-	0x38, 0x94, 0xc5, 0x5b, 0x28, 0xe8, 0x8e, 0x86, 0xb2, 0x78, 0xd8, 0x6f, 0x36, 0x74, 0x91, 0xf6,
-	0x9b, 0xa6, 0xf4, 0x29, 0xe4, 0x8c, 0x2e, 0xb2, 0xdc, 0xad, 0xcc, 0x8e, 0x50, 0x2b, 0x6a, 0xf5,
-	0xb3, 0xa1, 0xfc, 0x5e, 0xc7, 0xa2, 0xdd, 0xe3, 0xb6, 0x62, 0x10, 0x47, 0x8d, 0x70, 0xb9, 0x98,
-	0x3e, 0x20, 0xfe, 0x7d, 0xde, 0x7a, 0xd5, 0x20, 0x3e, 0x56, 0xfb, 0xaa, 0x8b, 0xfb, 0xc7, 0x41,
-	0xe2, 0x97, 0x52, 0x0f, 0xcd, 0xdc, 0x41, 0x0e, 0xd6, 0x23, 0x8b, 0xd2, 0x11, 0x14, 0x3c, 0x62,
-	0xdb, 0x21, 0x8e, 0xec, 0x8e, 0x50, 0x13, 0xb5, 0x03, 0x8e, 0xe3, 0xed, 0x29, 0x37, 0x98, 0xe0,
-	0x4d, 0x09, 0xfd, 0x6b, 0x36, 0x46, 0x43, 0x39, 0x1f, 0x3d, 0xe9, 0xf9, 0xd0, 0x7a, 0xd3, 0xac,
-	0xfe, 0x2e, 0x40, 0x29, 0xec, 0xda, 0xef, 0x7b, 0x96, 0x7f, 0xe5, 0xbc, 0xff, 0x43, 0x80, 0x6b,
-	0x61, 0x57, 0x9d, 0x38, 0x9e, 0x8d, 0xe9, 0x95, 0xf3, 0xff, 0xcb, 0x0c, 0xac, 0xdf, 0x21, 0xfb,
-	0x2c, 0x42, 0xeb, 0xc4, 0x3d, 0xb2, 0x7c, 0xe7, 0xca, 0x71, 0xf0, 0x6b, 0x06, 0x6e, 0x70, 0xdf,
-	0x6f, 0xe3, 0xc1, 0xa1, 0x8f, 0xdc, 0xe0, 0x08, 0xfb, 0xf7, 0x28, 0x0a, 0x97, 0x8d, 0x1d, 0x14,
-	0x66, 0xee, 0x60, 0x42, 0x73, 0xe6, 0x52, 0x9a, 0xdf, 0x82, 0xd5, 0x0e, 0xa2, 0xf8, 0x01, 0x1a,
-	0xb4, 0x90, 0x69, 0xfa, 0x38, 0x08, 0x18, 0x27, 0x65, 0x6d, 0x95, 0x2f, 0x2a, 0xec, 0x45, 0xdd,
-	0xfa, 0x0a, 0x9f, 0xc7, 0xdb, 0x92, 0x0a, 0x1b, 0x46, 0xe4, 0x1c, 0xa2, 0x16, 0x71, 0x5b, 0x5d,
-	0x6c, 0x75, 0xba, 0x74, 0x4b, 0x0c, 0x19, 0xd5, 0xa5, 0xf4, 0xd0, 0x2d, 0x36, 0x22, 0x7d, 0x0e,
-	0x65, 0x0f, 0xf9, 0xd4, 0x32, 0x2c, 0x0f, 0xb9, 0x34, 0xd8, 0xca, 0xed, 0x08, 0xb5, 0xd2, 0xeb,
-	0x8a, 0xc2, 0x5f, 0xdc, 0x21, 0xa9, 0x4a, 0xe2, 0x13, 0x7f, 0x9b, 0x32, 0x72, 0xef, 0xa6, 0x56,
-	0x69, 0xcb, 0x21, 0xae, 0x93, 0xa1, 0x2c, 0xe8, 0x13, 0xd6, 0xaa, 0xbf, 0x64, 0xe0, 0xff, 0x9c,
-	0xec, 0x0f, 0x23, 0xa0, 0x87, 0xfd, 0x98, 0xea, 0xc5, 0x38, 0x76, 0x2f, 0x0c, 0xd5, 0x0f, 0xb3,
-	0xf0, 0x3f, 0x4e, 0x75, 0x03, 0x7b, 0x24, 0xb0, 0xe8, 0xc2, 0x11, 0x6d, 0x46, 0xb8, 0x2e, 0x25,
-	0x9a, 0xcf, 0x8b, 0x89, 0x7e, 0x13, 0xae, 0x51, 0x72, 0x1f, 0xbb, 0xc9, 0x3a, 0xf1, 0xe2, 0x75,
-	0x65, 0x36, 0xeb, 0x12, 0x79, 0x72, 0x53, 0xcb, 0x93, 0x9f, 0xa5, 0x3c, 0xd2, 0x26, 0xe4, 0x50,
-	0x10, 0x60, 0xba, 0x55, 0x08, 0x99, 0xd5, 0xa3, 0x46, 0xf5, 0xe7, 0x2c, 0x6c, 0x70, 0xd1, 0x0e,
-	0x43, 0xf0, 0x2f, 0x4a, 0x6c, 0x3c, 0x9d, 0x64, 0xb7, 0xe3, 0x55, 0x26, 0xa6, 0xc8, 0xb2, 0xe3,
-	0x08, 0xd9, 0x51, 0x9e, 0xcc, 0x22, 0x15, 0x46, 0x57, 0x23, 0x9a, 0xa7, 0x89, 0xa1, 0x5d, 0x6e,
-	0x8c, 0xf7, 0xfd, 0x95, 0xfe, 0xf9, 0xa9, 0xf5, 0x2f, 0xcc, 0x34, 0x3c, 0x3b, 0x00, 0x8c, 0xdf,
-	0x3d, 0xd3, 0x9c, 0xeb, 0x35, 0x53, 0xfd, 0x46, 0x00, 0xa9, 0x4e, 0x1c, 0x07, 0xb9, 0xa6, 0x86,
-	0xa8, 0xd1, 0xbd, 0x67, 0x75, 0x5c, 0x3c, 0xd7, 0x63, 0xf2, 0x0e, 0xac, 0x19, 0xd1, 0x86, 0xad,
-	0x76, 0xb8, 0x63, 0x7c, 0x85, 0x97, 0x35, 0x69, 0x34, 0x94, 0x57, 0xd2, 0x60, 0x9a, 0x0d, 0x7d,
-	0xc5, 0x48, 0xb7, 0xcd, 0xea, 0xb7, 0x42, 0x18, 0x02, 0xe3, 0xae, 0xbd, 0x36, 0x99, 0xbc, 0x89,
-	0x17, 0x0d, 0xf0, 0x77, 0x02, 0xac, 0xef, 0x7f, 0x7c, 0xc0, 0xb2, 0xa8, 0x71, 0x12, 0x35, 0xc7,
-	0xc4, 0x61, 0x17, 0x96, 0x59, 0x51, 0x15, 0xe7, 0x0e, 0x45, 0xed, 0xfa, 0x68, 0x28, 0x17, 0x18,
-	0x80, 0x66, 0xe3, 0x6c, 0xfc, 0xa8, 0x17, 0xd8, 0xbc, 0xa6, 0x29, 0x49, 0x20, 0x86, 0x25, 0x11,
-	0xf3, 0xaa, 0xa8, 0xb3, 0xe7, 0x73, 0xb8, 0xe3, 0x04, 0x78, 0xf1, 0x71, 0x3f, 0x14, 0x60, 0x25,
-	0xc6, 0xcd, 0x6b, 0xb6, 0xc5, 0x07, 0xfd, 0xbd, 0x00, 0x1b, 0x31, 0x68, 0x1d, 0x53, 0x7f, 0xf0,
-	0xdc, 0x20, 0xff, 0x21, 0x0b, 0x9b, 0x75, 0xe2, 0x52, 0x1f, 0x19, 0xb4, 0x8e, 0x6c, 0x7b, 0xcf,
-	0xf3, 0x7c, 0xd2, 0x5b, 0x38, 0xe8, 0xef, 0x02, 0xc4, 0x31, 0x9c, 0x44, 0x6f, 0x85, 0x5f, 0x2f,
-	0x45, 0x1e, 0xc1, 0x2c, 0xff, 0x1f, 0x37, 0xf4, 0x22, 0x5f, 0xd1, 0x34, 0xa5, 0xeb, 0x90, 0x0f,
-	0xb0, 0x6b, 0x62, 0x9f, 0xdd, 0x4c, 0x45, 0x9d, 0xb7, 0x24, 0x0f, 0xd6, 0x4d, 0x1c, 0x50, 0xcb,
-	0x8d, 0x2e, 0x8d, 0xc8, 0xe1, 0xdc, 0xec, 0x1c, 0x5e, 0x4b, 0x59, 0xaf, 0xf3, 0xb2, 0x60, 0xcd,
-	0xe0, 0x74, 0x27, 0xb7, 0x65, 0x9e, 0x61, 0x5a, 0x8d, 0xfb, 0xc7, 0x29, 0x4d, 0xd9, 0x43, 0x03,
-	0x9b, 0x20, 0xb3, 0xd5, 0x45, 0x41, 0x97, 0xdd, 0x50, 0x65, 0xad, 0x9c, 0x4e, 0x0e, 0xf4, 0x12,
-	0x9f, 0x11, 0x36, 0xaa, 0x5f, 0x89, 0xf0, 0x52, 0x5a, 0xcb, 0x4f, 0x2c, 0xda, 0x3d, 0xb0, 0x5c,
-	0xfa, 0x9f, 0xa6, 0xcf, 0xad, 0xa6, 0xd2, 0xcd, 0x38, 0x91, 0x5c, 0x66, 0xf9, 0xc9, 0x0d, 0x25,
-	0xfa, 0xdc, 0xa5, 0xb4, 0x51, 0x80, 0x93, 0xb4, 0xa4, 0x4e, 0x2c, 0x97, 0x67, 0x45, 0x3c, 0xd3,
-	0xfc, 0x42, 0x84, 0x62, 0x94, 0x62, 0x62, 0x97, 0x2e, 0x98, 0xee, 0x01, 0x94, 0x28, 0xaf, 0xc3,
-	0xc7, 0xe5, 0xbf, 0x3e, 0x1a, 0xca, 0x10, 0x97, 0xe7, 0x6c, 0xe1, 0xfb, 0x4f, 0x87, 0x70, 0x6c,
-	0x43, 0x87, 0x78, 0x9b, 0x85, 0x3a, 0x2d, 0x2a, 0x6c, 0xa4, 0x77, 0x9c, 0x3c, 0x30, 0x52, 0x6a,
-	0x28, 0x3e, 0x33, 0x37, 0xd3, 0xb5, 0xc4, 0xf4, 0x47, 0xe0, 0xb7, 0x2c, 0x94, 0xc2, 0xe8, 0xe7,
-	0xc1, 0x33, 0xcf, 0x43, 0x70, 0x4e, 0xd1, 0xcc, 0x33, 0x51, 0xf4, 0x1f, 0xbe, 0x3e, 0x2e, 0x14,
-	0x5e, 0xfc, 0x17, 0x84, 0xcf, 0x5d, 0x2e, 0x7c, 0xfe, 0x6f, 0x09, 0xff, 0x38, 0x03, 0x25, 0xed,
-	0xd8, 0x77, 0x9f, 0x81, 0xf0, 0x93, 0x1a, 0x64, 0x66, 0xa2, 0x41, 0x76, 0x9e, 0x1a, 0xbc, 0xfc,
-	0xe4, 0x67, 0x89, 0xe8, 0x7d, 0x70, 0xfe, 0x2b, 0x44, 0x52, 0xc0, 0xe7, 0x52, 0x05, 0xbc, 0xf6,
-	0xd1, 0xa3, 0x51, 0x45, 0x38, 0x19, 0x55, 0x84, 0x9f, 0x46, 0x15, 0xe1, 0xeb, 0xd3, 0xca, 0xd2,
-	0xc9, 0x69, 0x65, 0xe9, 0xf1, 0x69, 0x65, 0xe9, 0xb3, 0xd7, 0xa6, 0xc4, 0x8a, 0x7b, 0x4e, 0xf4,
-	0xfb, 0xa2, 0x9d, 0x67, 0xff, 0x27, 0xde, 0xf8, 0x33, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xcb, 0x24,
-	0x1d, 0x56, 0x19, 0x00, 0x00,
+	// 1190 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xaf, 0x13, 0x27, 0x69, 0x26, 0xe9, 0x9b, 0xdb, 0xff, 0xfe, 0xbb, 0x15, 0x8a, 0xab, 0x5c,
+	0x28, 0x07, 0x6c, 0x0a, 0xac, 0x84, 0xc4, 0x6b, 0x9d, 0x14, 0x36, 0x54, 0x5d, 0xad, 0xbc, 0x15,
+	0x08, 0x84, 0x54, 0x4d, 0xec, 0x69, 0x32, 0xaa, 0xe3, 0xb1, 0xec, 0x69, 0x36, 0xb9, 0xc1, 0x8d,
+	0x23, 0x1f, 0x04, 0x38, 0xac, 0x84, 0xf8, 0x0a, 0xcb, 0xad, 0xc7, 0x15, 0x07, 0x0b, 0xa5, 0x07,
+	0x10, 0x1f, 0x80, 0x43, 0x11, 0x12, 0x9a, 0xf1, 0xd8, 0x71, 0xba, 0x45, 0x0d, 0x4b, 0xb2, 0x64,
+	0xb7, 0xdc, 0x3c, 0x33, 0xcf, 0x3c, 0xf3, 0x7b, 0x7e, 0xbf, 0x79, 0x79, 0x66, 0x0c, 0x54, 0xd8,
+	0x43, 0x0e, 0xf4, 0x75, 0xd4, 0xed, 0xe8, 0xdd, 0xed, 0x26, 0xa2, 0x70, 0x5b, 0x47, 0x5d, 0xe4,
+	0xd2, 0x40, 0xf3, 0x7c, 0x42, 0x89, 0xa2, 0x44, 0x06, 0x1a, 0xea, 0x76, 0x34, 0x61, 0xb0, 0xb1,
+	0xd6, 0x22, 0x2d, 0xc2, 0x9b, 0x75, 0xf6, 0x15, 0x59, 0x6e, 0x6c, 0x09, 0x57, 0x5d, 0x42, 0x91,
+	0x8e, 0x7a, 0x1e, 0xf1, 0x29, 0xb2, 0x13, 0xa7, 0xb4, 0xef, 0x21, 0xe1, 0x73, 0xa3, 0x72, 0xc9,
+	0xa0, 0x23, 0xed, 0x16, 0x09, 0x3a, 0x24, 0xd0, 0x9b, 0x30, 0x40, 0x89, 0x81, 0x45, 0xb0, 0x1b,
+	0xb5, 0x57, 0xcf, 0x25, 0x00, 0xee, 0x12, 0xc7, 0x79, 0x1f, 0x62, 0x07, 0xd9, 0xca, 0x4b, 0x20,
+	0x47, 0x7b, 0x87, 0xd8, 0x5e, 0x97, 0x36, 0xa5, 0xad, 0xb2, 0xb1, 0xf6, 0x30, 0x54, 0xe7, 0x7e,
+	0x0c, 0x55, 0xf9, 0x36, 0x0c, 0xda, 0x83, 0x50, 0x95, 0x0f, 0x7a, 0x8d, 0xba, 0x29, 0xd3, 0x5e,
+	0xc3, 0x56, 0x3e, 0x01, 0x39, 0xab, 0x0d, 0xb1, 0xbb, 0x9e, 0xd9, 0x94, 0xb6, 0x8a, 0x46, 0xed,
+	0x3c, 0x54, 0xdf, 0x6d, 0x61, 0xda, 0x3e, 0x69, 0x6a, 0x16, 0xe9, 0xe8, 0x11, 0x2e, 0x17, 0xd1,
+	0xfb, 0xc4, 0x3f, 0x16, 0xa5, 0x97, 0x2d, 0xe2, 0x23, 0xbd, 0xa7, 0xbb, 0xa8, 0x77, 0x12, 0x24,
+	0x71, 0x69, 0x35, 0xe6, 0xe6, 0x0e, 0xec, 0x20, 0x33, 0xf2, 0xa8, 0x1c, 0x81, 0x82, 0x47, 0x1c,
+	0x87, 0xe1, 0xc8, 0x6e, 0x4a, 0x5b, 0xb2, 0xb1, 0x2f, 0x70, 0xbc, 0x39, 0xe6, 0x00, 0x23, 0xbc,
+	0x69, 0x2c, 0xbe, 0x46, 0x7d, 0x10, 0xaa, 0xf9, 0xe8, 0xcb, 0xcc, 0x33, 0xef, 0x0d, 0xbb, 0xfa,
+	0xbb, 0x04, 0x4a, 0xac, 0x6a, 0xb7, 0xe7, 0x61, 0xff, 0xda, 0x45, 0xff, 0x87, 0x04, 0x16, 0x58,
+	0x55, 0x8d, 0x74, 0x3c, 0x07, 0xd1, 0x6b, 0x17, 0xff, 0x17, 0x19, 0xb0, 0x72, 0x87, 0xec, 0xf2,
+	0x15, 0x5a, 0x23, 0xee, 0x11, 0xf6, 0x3b, 0xd7, 0x8e, 0x83, 0x5f, 0x33, 0xe0, 0xa6, 0x88, 0x7d,
+	0x0f, 0xf5, 0x0f, 0x7c, 0xe8, 0x06, 0x47, 0xc8, 0xbf, 0x47, 0x21, 0xeb, 0x36, 0x0c, 0x50, 0x9a,
+	0x78, 0x80, 0x09, 0xcd, 0x99, 0x2b, 0x69, 0x7e, 0x03, 0x2c, 0xb5, 0x20, 0x45, 0xf7, 0x61, 0xff,
+	0x10, 0xda, 0xb6, 0x8f, 0x82, 0x80, 0x73, 0x52, 0x36, 0x96, 0x44, 0xa7, 0xc2, 0x4e, 0x54, 0x6d,
+	0x2e, 0x0a, 0x3b, 0x51, 0x56, 0x74, 0xb0, 0x6a, 0x45, 0xc1, 0x41, 0x8a, 0x89, 0x7b, 0xd8, 0x46,
+	0xb8, 0xd5, 0xa6, 0xeb, 0x32, 0x63, 0xd4, 0x54, 0xd2, 0x4d, 0xb7, 0x79, 0x8b, 0xf2, 0x19, 0x28,
+	0x7b, 0xd0, 0xa7, 0xd8, 0xc2, 0x1e, 0x74, 0x69, 0xb0, 0x9e, 0xdb, 0x94, 0xb6, 0x4a, 0xaf, 0x6a,
+	0x9a, 0xd8, 0xb8, 0x19, 0xa9, 0x5a, 0x12, 0x93, 0xd8, 0x4d, 0x39, 0xb9, 0x77, 0x53, 0xbd, 0x8c,
+	0x79, 0x86, 0xeb, 0x34, 0x54, 0x25, 0x73, 0xc4, 0x5b, 0xf5, 0x97, 0x0c, 0xf8, 0xbf, 0x20, 0xfb,
+	0x83, 0x08, 0xe8, 0x41, 0x2f, 0xa6, 0x7a, 0x36, 0xa6, 0xdd, 0x73, 0x43, 0xf5, 0x83, 0x2c, 0xf8,
+	0x9f, 0xa0, 0xba, 0x8e, 0x3c, 0x12, 0x60, 0x3a, 0x73, 0x44, 0xdb, 0x11, 0xae, 0x2b, 0x89, 0x16,
+	0x76, 0x31, 0xd1, 0xaf, 0x83, 0x05, 0x4a, 0x8e, 0x91, 0x9b, 0xf4, 0x93, 0x2f, 0xef, 0x57, 0xe6,
+	0x56, 0x57, 0xc8, 0x93, 0x1b, 0x5b, 0x9e, 0xfc, 0x24, 0xe5, 0x51, 0xd6, 0x40, 0x0e, 0x06, 0x01,
+	0xa2, 0xeb, 0x05, 0xc6, 0xac, 0x19, 0x15, 0xaa, 0x3f, 0x67, 0xc1, 0xaa, 0x10, 0xed, 0x80, 0x81,
+	0x7f, 0x5e, 0xd6, 0xc6, 0x93, 0x49, 0xb6, 0x17, 0xf7, 0xb2, 0x11, 0x85, 0xd8, 0x89, 0x57, 0xc8,
+	0xa6, 0xf6, 0x78, 0x16, 0xa9, 0x71, 0xba, 0xea, 0x91, 0x9d, 0x21, 0x33, 0xbf, 0xc2, 0x99, 0xa8,
+	0xfb, 0x2b, 0xfd, 0xf3, 0x63, 0xeb, 0x5f, 0x98, 0xe8, 0xf2, 0x6c, 0x01, 0xc0, 0xf9, 0xdd, 0xb1,
+	0xed, 0xa9, 0x1e, 0x33, 0xd5, 0x6f, 0x24, 0xa0, 0xd4, 0x48, 0xa7, 0x03, 0x5d, 0xdb, 0x80, 0xd4,
+	0x6a, 0xdf, 0xc3, 0x2d, 0x17, 0x4d, 0x75, 0x9a, 0xbc, 0x05, 0x96, 0xad, 0x68, 0xc0, 0xc3, 0x26,
+	0x1b, 0x31, 0x3e, 0xc2, 0xcb, 0x86, 0x32, 0x08, 0xd5, 0xc5, 0x34, 0x98, 0x46, 0xdd, 0x5c, 0xb4,
+	0xd2, 0x65, 0xbb, 0xfa, 0xad, 0xc4, 0x96, 0xc0, 0xb0, 0x6a, 0xa7, 0x49, 0x7c, 0x3a, 0xcb, 0x80,
+	0xbf, 0xe6, 0x67, 0xda, 0x28, 0xc1, 0x26, 0xa2, 0x3e, 0x9e, 0x6e, 0xfa, 0x70, 0x19, 0xe8, 0xcc,
+	0xb8, 0xa0, 0xd9, 0xf6, 0xe3, 0x23, 0xea, 0xf7, 0x79, 0x9c, 0x0b, 0x66, 0x54, 0x50, 0x9a, 0x20,
+	0x7f, 0x8c, 0xfa, 0xcc, 0x93, 0xcc, 0xf1, 0xee, 0x0d, 0x42, 0x35, 0xb7, 0x87, 0xfa, 0x8d, 0xfa,
+	0x79, 0xa8, 0xbe, 0x33, 0x26, 0xf0, 0xce, 0x89, 0x43, 0x71, 0x80, 0x5b, 0x43, 0xec, 0xdc, 0x83,
+	0x99, 0x3b, 0x46, 0xfd, 0x86, 0x5d, 0xfd, 0x4e, 0x02, 0x2b, 0xbb, 0x1f, 0xed, 0xf3, 0xa4, 0x73,
+	0x98, 0x73, 0x4e, 0x91, 0xa8, 0x6d, 0x30, 0xcf, 0xef, 0xa0, 0x31, 0x41, 0x45, 0xe3, 0xc6, 0x20,
+	0x54, 0x0b, 0x1c, 0x00, 0x0f, 0x2c, 0xfe, 0x34, 0x0b, 0xdc, 0xae, 0x61, 0x2b, 0x0a, 0x90, 0xd9,
+	0x0d, 0x92, 0x93, 0x53, 0x34, 0xf9, 0xf7, 0x05, 0xdc, 0xf1, 0x7d, 0x61, 0xf6, 0x71, 0x3f, 0x90,
+	0xc0, 0x62, 0x8c, 0x5b, 0x5c, 0x71, 0x67, 0x1f, 0xf4, 0xf7, 0x12, 0x58, 0x8d, 0x41, 0xb3, 0xb5,
+	0xd4, 0x7f, 0x66, 0x90, 0xff, 0x90, 0x05, 0x6b, 0x35, 0xe2, 0x52, 0x1f, 0x5a, 0xb4, 0x06, 0x1d,
+	0x67, 0xc7, 0xf3, 0x7c, 0xd2, 0x9d, 0x39, 0xe8, 0x6f, 0x03, 0x10, 0xef, 0x1e, 0xc9, 0x66, 0x57,
+	0x11, 0xa7, 0x71, 0x51, 0xec, 0x1d, 0xfc, 0xba, 0x34, 0x2c, 0x98, 0x45, 0xd1, 0xa3, 0x61, 0x2b,
+	0x37, 0x40, 0x3e, 0x40, 0xae, 0x8d, 0xfc, 0x68, 0xa3, 0x30, 0x45, 0x49, 0xf1, 0xc0, 0x8a, 0x8d,
+	0x02, 0x8a, 0xdd, 0xe8, 0x8c, 0x8d, 0x02, 0xce, 0x4d, 0x2e, 0xe0, 0xe5, 0x94, 0xf7, 0x9a, 0xb8,
+	0x45, 0x2d, 0x5b, 0x82, 0xee, 0x24, 0xb9, 0xc8, 0x73, 0x4c, 0x4b, 0x71, 0xfd, 0x30, 0x03, 0x2c,
+	0x7b, 0xb0, 0xef, 0x10, 0x68, 0x1f, 0xb6, 0x61, 0xd0, 0xe6, 0x07, 0x7a, 0xd9, 0x28, 0xa7, 0x73,
+	0x29, 0xb3, 0x24, 0x2c, 0x58, 0xa1, 0xfa, 0xa5, 0x0c, 0x5e, 0x48, 0x6b, 0xf9, 0x31, 0xa6, 0xed,
+	0x7d, 0xec, 0xd2, 0xff, 0x34, 0x7d, 0x66, 0x35, 0x55, 0x6e, 0xc5, 0x79, 0xf7, 0x3c, 0x4f, 0xe7,
+	0x6e, 0x6a, 0xd1, 0xeb, 0xa0, 0xc6, 0x5e, 0x07, 0x93, 0x2c, 0xae, 0x46, 0xb0, 0x2b, 0x92, 0x48,
+	0x91, 0x98, 0x7f, 0x2e, 0x83, 0x62, 0x94, 0x91, 0x23, 0x97, 0xce, 0x98, 0xee, 0x01, 0x28, 0x51,
+	0xf1, 0x6c, 0x31, 0x7c, 0x2d, 0x31, 0x07, 0xa1, 0x0a, 0xe2, 0xd7, 0x0c, 0xde, 0xf1, 0xbd, 0x27,
+	0x43, 0x38, 0xf4, 0x61, 0x82, 0x78, 0x98, 0x99, 0x9a, 0x2d, 0x3a, 0x58, 0x4d, 0x8f, 0x38, 0x3a,
+	0x61, 0x94, 0x54, 0x53, 0x3c, 0x67, 0x6e, 0xa5, 0xaf, 0x5e, 0xe3, 0x4f, 0x81, 0xdf, 0xb2, 0xa0,
+	0xc4, 0x56, 0xbf, 0x58, 0x3c, 0xd3, 0x9c, 0x04, 0x17, 0x14, 0xcd, 0x3c, 0x15, 0x45, 0xff, 0xe1,
+	0xf6, 0x71, 0xa9, 0xf0, 0xf2, 0xbf, 0x20, 0x7c, 0xee, 0x6a, 0xe1, 0xf3, 0x7f, 0x4b, 0xf8, 0x47,
+	0x19, 0x50, 0x32, 0x4e, 0x7c, 0xf7, 0x29, 0x08, 0x3f, 0xaa, 0x41, 0x66, 0x22, 0x1a, 0x64, 0xa7,
+	0xa9, 0xc1, 0x8b, 0x8f, 0xbf, 0xe2, 0x44, 0xfb, 0xc1, 0xc5, 0x47, 0x9b, 0xe4, 0xbd, 0x23, 0x97,
+	0x7a, 0xef, 0x30, 0x3e, 0x7c, 0x38, 0xa8, 0x48, 0xa7, 0x83, 0x8a, 0xf4, 0xd3, 0xa0, 0x22, 0x7d,
+	0x75, 0x56, 0x99, 0x3b, 0x3d, 0xab, 0xcc, 0x3d, 0x3a, 0xab, 0xcc, 0x7d, 0xfa, 0xca, 0x98, 0x58,
+	0xd9, 0x5f, 0x1f, 0xfe, 0xb7, 0xa7, 0x99, 0xe7, 0xbf, 0x73, 0x5e, 0xfb, 0x73, 0x00, 0xa0, 0xbc,
+	0xb4, 0x42, 0x85, 0x1a, 0x00, 0x00,
 }
 
 func (m *PollFailed) Marshal() (dAtA []byte, err error) {
@@ -1920,6 +1994,55 @@ func (m *CommandBatchAborted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommandBatchSignRetried) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandBatchSignRetried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommandBatchSignRetried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Retry != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Retry))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CommandBatchID) > 0 {
+		i -= len(m.CommandBatchID)
+		copy(dAtA[i:], m.CommandBatchID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CommandBatchID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EVMEventConfirmed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2693,6 +2816,30 @@ func (m *CommandBatchAborted) Size() (n int) {
 	return n
 }
 
+func (m *CommandBatchSignRetried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CommandBatchID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Retry != 0 {
+		n += 1 + sovEvents(uint64(m.Retry))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EVMEventConfirmed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4721,6 +4868,173 @@ func (m *CommandBatchAborted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommandBatchSignRetried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandBatchSignRetried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandBatchSignRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandBatchID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandBatchID = append(m.CommandBatchID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommandBatchID == nil {
+				m.CommandBatchID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			m.Retry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retry |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EVMEventConfirmed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
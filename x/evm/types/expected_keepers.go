@@ -68,13 +68,20 @@ type ChainKeeper interface {
 	GetCommand(ctx sdk.Context, id CommandID) (Command, bool)
 	GetPendingCommands(ctx sdk.Context) []Command
 	CreateNewBatchToSign(ctx sdk.Context) (CommandBatch, error)
+	RequeueCommandBatch(ctx sdk.Context, keyID multisig.KeyID) error
 	SetLatestSignedCommandBatchID(ctx sdk.Context, id []byte)
 	GetLatestCommandBatch(ctx sdk.Context) CommandBatch
 	GetBatchByID(ctx sdk.Context, id []byte) CommandBatch
+	GetBatchByCommandID(ctx sdk.Context, id CommandID) CommandBatch
+	GetCommandBatchesPaginated(ctx sdk.Context, filter func(batch CommandBatchMetadata) bool, pageRequest *query.PageRequest) ([]CommandBatch, *query.PageResponse, error)
 	DeleteUnsignedCommandBatchID(ctx sdk.Context)
 
 	GetConfirmedEventQueue(ctx sdk.Context) utils.KVQueue
 	GetEvent(ctx sdk.Context, eventID EventID) (Event, bool)
+	GetEventsPaginated(ctx sdk.Context, txID *Hash, filter func(event Event) bool, pageRequest *query.PageRequest) ([]Event, *query.PageResponse, error)
+	SetEventTransferID(ctx sdk.Context, eventID EventID, transferID nexus.TransferID)
+	GetEventTransferID(ctx sdk.Context, eventID EventID) (nexus.TransferID, bool)
+	GetTransferEventID(ctx sdk.Context, transferID nexus.TransferID) (EventID, bool)
 	SetConfirmedEvent(ctx sdk.Context, event Event) error
 	SetEventCompleted(ctx sdk.Context, eventID EventID) error
 	SetEventFailed(ctx sdk.Context, eventID EventID) error
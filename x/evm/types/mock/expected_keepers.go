@@ -13,7 +13,7 @@ import (
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	"github.com/cosmos/cosmos-sdk/codec"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/tendermint/tendermint/libs/log"
 	"sync"
 )
@@ -1468,6 +1468,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GenerateSaltFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, recipient string) types.Hash {
 // 				panic("mock out the GenerateSalt method")
 // 			},
+// 			GetBatchByCommandIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) types.CommandBatch {
+// 				panic("mock out the GetBatchByCommandID method")
+// 			},
 // 			GetBatchByIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch {
 // 				panic("mock out the GetBatchByID method")
 // 			},
@@ -1489,6 +1492,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetCommandFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) (types.Command, bool) {
 // 				panic("mock out the GetCommand method")
 // 			},
+// 			GetCommandBatchesPaginatedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, filter func(batch types.CommandBatchMetadata) bool, pageRequest *query.PageRequest) ([]types.CommandBatch, *query.PageResponse, error) {
+// 				panic("mock out the GetCommandBatchesPaginated method")
+// 			},
 // 			GetConfirmedDepositsPaginatedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, pageRequest *query.PageRequest) ([]types.ERC20Deposit, *query.PageResponse, error) {
 // 				panic("mock out the GetConfirmedDepositsPaginated method")
 // 			},
@@ -1507,6 +1513,12 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetEventFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID) (types.Event, bool) {
 // 				panic("mock out the GetEvent method")
 // 			},
+// 			GetEventTransferIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, bool) {
+// 				panic("mock out the GetEventTransferID method")
+// 			},
+// 			GetEventsPaginatedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, txID *types.Hash, filter func(event types.Event) bool, pageRequest *query.PageRequest) ([]types.Event, *query.PageResponse, error) {
+// 				panic("mock out the GetEventsPaginated method")
+// 			},
 // 			GetGatewayAddressFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.Address, bool) {
 // 				panic("mock out the GetGatewayAddress method")
 // 			},
@@ -1543,12 +1555,18 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			GetTokensFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Token {
 // 				panic("mock out the GetTokens method")
 // 			},
+// 			GetTransferEventIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID) (types.EventID, bool) {
+// 				panic("mock out the GetTransferEventID method")
+// 			},
 // 			GetVotingThresholdFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) (utils.Threshold, bool) {
 // 				panic("mock out the GetVotingThreshold method")
 // 			},
 // 			LoggerFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger {
 // 				panic("mock out the Logger method")
 // 			},
+// 			RequeueCommandBatchFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID) error {
+// 				panic("mock out the RequeueCommandBatch method")
+// 			},
 // 			SetBurnerInfoFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerInfo types.BurnerInfo)  {
 // 				panic("mock out the SetBurnerInfo method")
 // 			},
@@ -1564,6 +1582,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 // 			SetEventFailedFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID) error {
 // 				panic("mock out the SetEventFailed method")
 // 			},
+// 			SetEventTransferIDFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID)  {
+// 				panic("mock out the SetEventTransferID method")
+// 			},
 // 			SetGatewayFunc: func(ctx github_com_cosmos_cosmos_sdk_types.Context, address types.Address)  {
 // 				panic("mock out the SetGateway method")
 // 			},
@@ -1598,6 +1619,9 @@ type ChainKeeperMock struct {
 	// GenerateSaltFunc mocks the GenerateSalt method.
 	GenerateSaltFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, recipient string) types.Hash
 
+	// GetBatchByCommandIDFunc mocks the GetBatchByCommandID method.
+	GetBatchByCommandIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) types.CommandBatch
+
 	// GetBatchByIDFunc mocks the GetBatchByID method.
 	GetBatchByIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch
 
@@ -1619,6 +1643,9 @@ type ChainKeeperMock struct {
 	// GetCommandFunc mocks the GetCommand method.
 	GetCommandFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) (types.Command, bool)
 
+	// GetCommandBatchesPaginatedFunc mocks the GetCommandBatchesPaginated method.
+	GetCommandBatchesPaginatedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, filter func(batch types.CommandBatchMetadata) bool, pageRequest *query.PageRequest) ([]types.CommandBatch, *query.PageResponse, error)
+
 	// GetConfirmedDepositsPaginatedFunc mocks the GetConfirmedDepositsPaginated method.
 	GetConfirmedDepositsPaginatedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, pageRequest *query.PageRequest) ([]types.ERC20Deposit, *query.PageResponse, error)
 
@@ -1637,6 +1664,12 @@ type ChainKeeperMock struct {
 	// GetEventFunc mocks the GetEvent method.
 	GetEventFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID) (types.Event, bool)
 
+	// GetEventTransferIDFunc mocks the GetEventTransferID method.
+	GetEventTransferIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, bool)
+
+	// GetEventsPaginatedFunc mocks the GetEventsPaginated method.
+	GetEventsPaginatedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, txID *types.Hash, filter func(event types.Event) bool, pageRequest *query.PageRequest) ([]types.Event, *query.PageResponse, error)
+
 	// GetGatewayAddressFunc mocks the GetGatewayAddress method.
 	GetGatewayAddressFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.Address, bool)
 
@@ -1673,12 +1706,18 @@ type ChainKeeperMock struct {
 	// GetTokensFunc mocks the GetTokens method.
 	GetTokensFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) []types.ERC20Token
 
+	// GetTransferEventIDFunc mocks the GetTransferEventID method.
+	GetTransferEventIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID) (types.EventID, bool)
+
 	// GetVotingThresholdFunc mocks the GetVotingThreshold method.
 	GetVotingThresholdFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) (utils.Threshold, bool)
 
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context) log.Logger
 
+	// RequeueCommandBatchFunc mocks the RequeueCommandBatch method.
+	RequeueCommandBatchFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID) error
+
 	// SetBurnerInfoFunc mocks the SetBurnerInfo method.
 	SetBurnerInfoFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerInfo types.BurnerInfo)
 
@@ -1694,6 +1733,9 @@ type ChainKeeperMock struct {
 	// SetEventFailedFunc mocks the SetEventFailed method.
 	SetEventFailedFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID) error
 
+	// SetEventTransferIDFunc mocks the SetEventTransferID method.
+	SetEventTransferIDFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID)
+
 	// SetGatewayFunc mocks the SetGateway method.
 	SetGatewayFunc func(ctx github_com_cosmos_cosmos_sdk_types.Context, address types.Address)
 
@@ -1747,6 +1789,13 @@ type ChainKeeperMock struct {
 			// Recipient is the recipient argument value.
 			Recipient string
 		}
+		// GetBatchByCommandID holds details about calls to the GetBatchByCommandID method.
+		GetBatchByCommandID []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// ID is the id argument value.
+			ID types.CommandID
+		}
 		// GetBatchByID holds details about calls to the GetBatchByID method.
 		GetBatchByID []struct {
 			// Ctx is the ctx argument value.
@@ -1796,6 +1845,15 @@ type ChainKeeperMock struct {
 			// ID is the id argument value.
 			ID types.CommandID
 		}
+		// GetCommandBatchesPaginated holds details about calls to the GetCommandBatchesPaginated method.
+		GetCommandBatchesPaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// Filter is the filter argument value.
+			Filter func(batch types.CommandBatchMetadata) bool
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
 		// GetConfirmedDepositsPaginated holds details about calls to the GetConfirmedDepositsPaginated method.
 		GetConfirmedDepositsPaginated []struct {
 			// Ctx is the ctx argument value.
@@ -1838,6 +1896,24 @@ type ChainKeeperMock struct {
 			// EventID is the eventID argument value.
 			EventID types.EventID
 		}
+		// GetEventTransferID holds details about calls to the GetEventTransferID method.
+		GetEventTransferID []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// EventID is the eventID argument value.
+			EventID types.EventID
+		}
+		// GetEventsPaginated holds details about calls to the GetEventsPaginated method.
+		GetEventsPaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// TxID is the txID argument value.
+			TxID *types.Hash
+			// Filter is the filter argument value.
+			Filter func(event types.Event) bool
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
 		// GetGatewayAddress holds details about calls to the GetGatewayAddress method.
 		GetGatewayAddress []struct {
 			// Ctx is the ctx argument value.
@@ -1898,6 +1974,13 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// GetTransferEventID holds details about calls to the GetTransferEventID method.
+		GetTransferEventID []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// TransferID is the transferID argument value.
+			TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+		}
 		// GetVotingThreshold holds details about calls to the GetVotingThreshold method.
 		GetVotingThreshold []struct {
 			// Ctx is the ctx argument value.
@@ -1908,6 +1991,13 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx github_com_cosmos_cosmos_sdk_types.Context
 		}
+		// RequeueCommandBatch holds details about calls to the RequeueCommandBatch method.
+		RequeueCommandBatch []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// KeyID is the keyID argument value.
+			KeyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID
+		}
 		// SetBurnerInfo holds details about calls to the SetBurnerInfo method.
 		SetBurnerInfo []struct {
 			// Ctx is the ctx argument value.
@@ -1945,6 +2035,15 @@ type ChainKeeperMock struct {
 			// EventID is the eventID argument value.
 			EventID types.EventID
 		}
+		// SetEventTransferID holds details about calls to the SetEventTransferID method.
+		SetEventTransferID []struct {
+			// Ctx is the ctx argument value.
+			Ctx github_com_cosmos_cosmos_sdk_types.Context
+			// EventID is the eventID argument value.
+			EventID types.EventID
+			// TransferID is the transferID argument value.
+			TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+		}
 		// SetGateway holds details about calls to the SetGateway method.
 		SetGateway []struct {
 			// Ctx is the ctx argument value.
@@ -1973,6 +2072,7 @@ type ChainKeeperMock struct {
 	lockDeleteUnsignedCommandBatchID  sync.RWMutex
 	lockEnqueueCommand                sync.RWMutex
 	lockGenerateSalt                  sync.RWMutex
+	lockGetBatchByCommandID           sync.RWMutex
 	lockGetBatchByID                  sync.RWMutex
 	lockGetBurnerAddress              sync.RWMutex
 	lockGetBurnerByteCode             sync.RWMutex
@@ -1980,12 +2080,15 @@ type ChainKeeperMock struct {
 	lockGetChainID                    sync.RWMutex
 	lockGetChainIDByNetwork           sync.RWMutex
 	lockGetCommand                    sync.RWMutex
+	lockGetCommandBatchesPaginated    sync.RWMutex
 	lockGetConfirmedDepositsPaginated sync.RWMutex
 	lockGetConfirmedEventQueue        sync.RWMutex
 	lockGetDeposit                    sync.RWMutex
 	lockGetERC20TokenByAsset          sync.RWMutex
 	lockGetERC20TokenBySymbol         sync.RWMutex
 	lockGetEvent                      sync.RWMutex
+	lockGetEventTransferID            sync.RWMutex
+	lockGetEventsPaginated            sync.RWMutex
 	lockGetGatewayAddress             sync.RWMutex
 	lockGetLatestCommandBatch         sync.RWMutex
 	lockGetMinVoterCount              sync.RWMutex
@@ -1998,13 +2101,16 @@ type ChainKeeperMock struct {
 	lockGetRevoteLockingPeriod        sync.RWMutex
 	lockGetTokenByteCode              sync.RWMutex
 	lockGetTokens                     sync.RWMutex
+	lockGetTransferEventID            sync.RWMutex
 	lockGetVotingThreshold            sync.RWMutex
 	lockLogger                        sync.RWMutex
+	lockRequeueCommandBatch           sync.RWMutex
 	lockSetBurnerInfo                 sync.RWMutex
 	lockSetConfirmedEvent             sync.RWMutex
 	lockSetDeposit                    sync.RWMutex
 	lockSetEventCompleted             sync.RWMutex
 	lockSetEventFailed                sync.RWMutex
+	lockSetEventTransferID            sync.RWMutex
 	lockSetGateway                    sync.RWMutex
 	lockSetLatestSignedCommandBatchID sync.RWMutex
 	lockSetParams                     sync.RWMutex
@@ -2220,6 +2326,41 @@ func (mock *ChainKeeperMock) GenerateSaltCalls() []struct {
 	return calls
 }
 
+// GetBatchByCommandID calls GetBatchByCommandIDFunc.
+func (mock *ChainKeeperMock) GetBatchByCommandID(ctx github_com_cosmos_cosmos_sdk_types.Context, id types.CommandID) types.CommandBatch {
+	if mock.GetBatchByCommandIDFunc == nil {
+		panic("ChainKeeperMock.GetBatchByCommandIDFunc: method is nil but ChainKeeper.GetBatchByCommandID was just called")
+	}
+	callInfo := struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		ID  types.CommandID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetBatchByCommandID.Lock()
+	mock.calls.GetBatchByCommandID = append(mock.calls.GetBatchByCommandID, callInfo)
+	mock.lockGetBatchByCommandID.Unlock()
+	return mock.GetBatchByCommandIDFunc(ctx, id)
+}
+
+// GetBatchByCommandIDCalls gets all the calls that were made to GetBatchByCommandID.
+// Check the length with:
+//     len(mockedChainKeeper.GetBatchByCommandIDCalls())
+func (mock *ChainKeeperMock) GetBatchByCommandIDCalls() []struct {
+	Ctx github_com_cosmos_cosmos_sdk_types.Context
+	ID  types.CommandID
+} {
+	var calls []struct {
+		Ctx github_com_cosmos_cosmos_sdk_types.Context
+		ID  types.CommandID
+	}
+	mock.lockGetBatchByCommandID.RLock()
+	calls = mock.calls.GetBatchByCommandID
+	mock.lockGetBatchByCommandID.RUnlock()
+	return calls
+}
+
 // GetBatchByID calls GetBatchByIDFunc.
 func (mock *ChainKeeperMock) GetBatchByID(ctx github_com_cosmos_cosmos_sdk_types.Context, id []byte) types.CommandBatch {
 	if mock.GetBatchByIDFunc == nil {
@@ -2465,6 +2606,45 @@ func (mock *ChainKeeperMock) GetCommandCalls() []struct {
 	return calls
 }
 
+// GetCommandBatchesPaginated calls GetCommandBatchesPaginatedFunc.
+func (mock *ChainKeeperMock) GetCommandBatchesPaginated(ctx github_com_cosmos_cosmos_sdk_types.Context, filter func(batch types.CommandBatchMetadata) bool, pageRequest *query.PageRequest) ([]types.CommandBatch, *query.PageResponse, error) {
+	if mock.GetCommandBatchesPaginatedFunc == nil {
+		panic("ChainKeeperMock.GetCommandBatchesPaginatedFunc: method is nil but ChainKeeper.GetCommandBatchesPaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Filter      func(batch types.CommandBatchMetadata) bool
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		Filter:      filter,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetCommandBatchesPaginated.Lock()
+	mock.calls.GetCommandBatchesPaginated = append(mock.calls.GetCommandBatchesPaginated, callInfo)
+	mock.lockGetCommandBatchesPaginated.Unlock()
+	return mock.GetCommandBatchesPaginatedFunc(ctx, filter, pageRequest)
+}
+
+// GetCommandBatchesPaginatedCalls gets all the calls that were made to GetCommandBatchesPaginated.
+// Check the length with:
+//     len(mockedChainKeeper.GetCommandBatchesPaginatedCalls())
+func (mock *ChainKeeperMock) GetCommandBatchesPaginatedCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	Filter      func(batch types.CommandBatchMetadata) bool
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		Filter      func(batch types.CommandBatchMetadata) bool
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetCommandBatchesPaginated.RLock()
+	calls = mock.calls.GetCommandBatchesPaginated
+	mock.lockGetCommandBatchesPaginated.RUnlock()
+	return calls
+}
+
 // GetConfirmedDepositsPaginated calls GetConfirmedDepositsPaginatedFunc.
 func (mock *ChainKeeperMock) GetConfirmedDepositsPaginated(ctx github_com_cosmos_cosmos_sdk_types.Context, pageRequest *query.PageRequest) ([]types.ERC20Deposit, *query.PageResponse, error) {
 	if mock.GetConfirmedDepositsPaginatedFunc == nil {
@@ -2675,6 +2855,84 @@ func (mock *ChainKeeperMock) GetEventCalls() []struct {
 	return calls
 }
 
+// GetEventTransferID calls GetEventTransferIDFunc.
+func (mock *ChainKeeperMock) GetEventTransferID(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID) (github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID, bool) {
+	if mock.GetEventTransferIDFunc == nil {
+		panic("ChainKeeperMock.GetEventTransferIDFunc: method is nil but ChainKeeper.GetEventTransferID was just called")
+	}
+	callInfo := struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		EventID types.EventID
+	}{
+		Ctx:     ctx,
+		EventID: eventID,
+	}
+	mock.lockGetEventTransferID.Lock()
+	mock.calls.GetEventTransferID = append(mock.calls.GetEventTransferID, callInfo)
+	mock.lockGetEventTransferID.Unlock()
+	return mock.GetEventTransferIDFunc(ctx, eventID)
+}
+
+// GetEventTransferIDCalls gets all the calls that were made to GetEventTransferID.
+// Check the length with:
+//     len(mockedChainKeeper.GetEventTransferIDCalls())
+func (mock *ChainKeeperMock) GetEventTransferIDCalls() []struct {
+	Ctx     github_com_cosmos_cosmos_sdk_types.Context
+	EventID types.EventID
+} {
+	var calls []struct {
+		Ctx     github_com_cosmos_cosmos_sdk_types.Context
+		EventID types.EventID
+	}
+	mock.lockGetEventTransferID.RLock()
+	calls = mock.calls.GetEventTransferID
+	mock.lockGetEventTransferID.RUnlock()
+	return calls
+}
+
+// GetEventsPaginated calls GetEventsPaginatedFunc.
+func (mock *ChainKeeperMock) GetEventsPaginated(ctx github_com_cosmos_cosmos_sdk_types.Context, txID *types.Hash, filter func(event types.Event) bool, pageRequest *query.PageRequest) ([]types.Event, *query.PageResponse, error) {
+	if mock.GetEventsPaginatedFunc == nil {
+		panic("ChainKeeperMock.GetEventsPaginatedFunc: method is nil but ChainKeeper.GetEventsPaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		TxID        *types.Hash
+		Filter      func(event types.Event) bool
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		TxID:        txID,
+		Filter:      filter,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetEventsPaginated.Lock()
+	mock.calls.GetEventsPaginated = append(mock.calls.GetEventsPaginated, callInfo)
+	mock.lockGetEventsPaginated.Unlock()
+	return mock.GetEventsPaginatedFunc(ctx, txID, filter, pageRequest)
+}
+
+// GetEventsPaginatedCalls gets all the calls that were made to GetEventsPaginated.
+// Check the length with:
+//     len(mockedChainKeeper.GetEventsPaginatedCalls())
+func (mock *ChainKeeperMock) GetEventsPaginatedCalls() []struct {
+	Ctx         github_com_cosmos_cosmos_sdk_types.Context
+	TxID        *types.Hash
+	Filter      func(event types.Event) bool
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         github_com_cosmos_cosmos_sdk_types.Context
+		TxID        *types.Hash
+		Filter      func(event types.Event) bool
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetEventsPaginated.RLock()
+	calls = mock.calls.GetEventsPaginated
+	mock.lockGetEventsPaginated.RUnlock()
+	return calls
+}
+
 // GetGatewayAddress calls GetGatewayAddressFunc.
 func (mock *ChainKeeperMock) GetGatewayAddress(ctx github_com_cosmos_cosmos_sdk_types.Context) (types.Address, bool) {
 	if mock.GetGatewayAddressFunc == nil {
@@ -3046,6 +3304,41 @@ func (mock *ChainKeeperMock) GetTokensCalls() []struct {
 	return calls
 }
 
+// GetTransferEventID calls GetTransferEventIDFunc.
+func (mock *ChainKeeperMock) GetTransferEventID(ctx github_com_cosmos_cosmos_sdk_types.Context, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID) (types.EventID, bool) {
+	if mock.GetTransferEventIDFunc == nil {
+		panic("ChainKeeperMock.GetTransferEventIDFunc: method is nil but ChainKeeper.GetTransferEventID was just called")
+	}
+	callInfo := struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+	}{
+		Ctx:        ctx,
+		TransferID: transferID,
+	}
+	mock.lockGetTransferEventID.Lock()
+	mock.calls.GetTransferEventID = append(mock.calls.GetTransferEventID, callInfo)
+	mock.lockGetTransferEventID.Unlock()
+	return mock.GetTransferEventIDFunc(ctx, transferID)
+}
+
+// GetTransferEventIDCalls gets all the calls that were made to GetTransferEventID.
+// Check the length with:
+//     len(mockedChainKeeper.GetTransferEventIDCalls())
+func (mock *ChainKeeperMock) GetTransferEventIDCalls() []struct {
+	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+} {
+	var calls []struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+	}
+	mock.lockGetTransferEventID.RLock()
+	calls = mock.calls.GetTransferEventID
+	mock.lockGetTransferEventID.RUnlock()
+	return calls
+}
+
 // GetVotingThreshold calls GetVotingThresholdFunc.
 func (mock *ChainKeeperMock) GetVotingThreshold(ctx github_com_cosmos_cosmos_sdk_types.Context) (utils.Threshold, bool) {
 	if mock.GetVotingThresholdFunc == nil {
@@ -3108,6 +3401,41 @@ func (mock *ChainKeeperMock) LoggerCalls() []struct {
 	return calls
 }
 
+// RequeueCommandBatch calls RequeueCommandBatchFunc.
+func (mock *ChainKeeperMock) RequeueCommandBatch(ctx github_com_cosmos_cosmos_sdk_types.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID) error {
+	if mock.RequeueCommandBatchFunc == nil {
+		panic("ChainKeeperMock.RequeueCommandBatchFunc: method is nil but ChainKeeper.RequeueCommandBatch was just called")
+	}
+	callInfo := struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		KeyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID
+	}{
+		Ctx:   ctx,
+		KeyID: keyID,
+	}
+	mock.lockRequeueCommandBatch.Lock()
+	mock.calls.RequeueCommandBatch = append(mock.calls.RequeueCommandBatch, callInfo)
+	mock.lockRequeueCommandBatch.Unlock()
+	return mock.RequeueCommandBatchFunc(ctx, keyID)
+}
+
+// RequeueCommandBatchCalls gets all the calls that were made to RequeueCommandBatch.
+// Check the length with:
+//     len(mockedChainKeeper.RequeueCommandBatchCalls())
+func (mock *ChainKeeperMock) RequeueCommandBatchCalls() []struct {
+	Ctx   github_com_cosmos_cosmos_sdk_types.Context
+	KeyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID
+} {
+	var calls []struct {
+		Ctx   github_com_cosmos_cosmos_sdk_types.Context
+		KeyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID
+	}
+	mock.lockRequeueCommandBatch.RLock()
+	calls = mock.calls.RequeueCommandBatch
+	mock.lockRequeueCommandBatch.RUnlock()
+	return calls
+}
+
 // SetBurnerInfo calls SetBurnerInfoFunc.
 func (mock *ChainKeeperMock) SetBurnerInfo(ctx github_com_cosmos_cosmos_sdk_types.Context, burnerInfo types.BurnerInfo) {
 	if mock.SetBurnerInfoFunc == nil {
@@ -3287,6 +3615,45 @@ func (mock *ChainKeeperMock) SetEventFailedCalls() []struct {
 	return calls
 }
 
+// SetEventTransferID calls SetEventTransferIDFunc.
+func (mock *ChainKeeperMock) SetEventTransferID(ctx github_com_cosmos_cosmos_sdk_types.Context, eventID types.EventID, transferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID) {
+	if mock.SetEventTransferIDFunc == nil {
+		panic("ChainKeeperMock.SetEventTransferIDFunc: method is nil but ChainKeeper.SetEventTransferID was just called")
+	}
+	callInfo := struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		EventID    types.EventID
+		TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+	}{
+		Ctx:        ctx,
+		EventID:    eventID,
+		TransferID: transferID,
+	}
+	mock.lockSetEventTransferID.Lock()
+	mock.calls.SetEventTransferID = append(mock.calls.SetEventTransferID, callInfo)
+	mock.lockSetEventTransferID.Unlock()
+	mock.SetEventTransferIDFunc(ctx, eventID, transferID)
+}
+
+// SetEventTransferIDCalls gets all the calls that were made to SetEventTransferID.
+// Check the length with:
+//     len(mockedChainKeeper.SetEventTransferIDCalls())
+func (mock *ChainKeeperMock) SetEventTransferIDCalls() []struct {
+	Ctx        github_com_cosmos_cosmos_sdk_types.Context
+	EventID    types.EventID
+	TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+} {
+	var calls []struct {
+		Ctx        github_com_cosmos_cosmos_sdk_types.Context
+		EventID    types.EventID
+		TransferID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID
+	}
+	mock.lockSetEventTransferID.RLock()
+	calls = mock.calls.SetEventTransferID
+	mock.lockSetEventTransferID.RUnlock()
+	return calls
+}
+
 // SetGateway calls SetGatewayFunc.
 func (mock *ChainKeeperMock) SetGateway(ctx github_com_cosmos_cosmos_sdk_types.Context, address types.Address) {
 	if mock.SetGatewayFunc == nil {
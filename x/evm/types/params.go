@@ -30,6 +30,9 @@ var (
 	KeyVotingGracePeriod   = []byte("votingGracePeriod")
 	KeyEndBlockerLimit     = []byte("endBlockerLimit")
 	KeyTransferLimit       = []byte("transferLimit")
+	KeyAutoBatchInterval   = []byte("autoBatchInterval")
+	KeySignRetryLimit      = []byte("signRetryLimit")
+	KeySignRetryBackoff    = []byte("signRetryBackoff")
 )
 
 // KeyTable returns a subspace.KeyTable that has registered all parameter types in this module's parameter set
@@ -84,6 +87,9 @@ func DefaultParams() []Params {
 		CommandsGasLimit:  5000000,
 		EndBlockerLimit:   50,
 		TransferLimit:     50,
+		AutoBatchInterval: 0,
+		SignRetryLimit:    3,
+		SignRetryBackoff:  10,
 	}}
 }
 
@@ -110,6 +116,9 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyVotingGracePeriod, &m.VotingGracePeriod, validateVotingGracePeriod),
 		params.NewParamSetPair(KeyEndBlockerLimit, &m.EndBlockerLimit, validateEndBlockerLimit),
 		params.NewParamSetPair(KeyTransferLimit, &m.TransferLimit, validateTransferLimit),
+		params.NewParamSetPair(KeyAutoBatchInterval, &m.AutoBatchInterval, validateAutoBatchInterval),
+		params.NewParamSetPair(KeySignRetryLimit, &m.SignRetryLimit, validateSignRetryLimit),
+		params.NewParamSetPair(KeySignRetryBackoff, &m.SignRetryBackoff, validateSignRetryBackoff),
 	}
 }
 
@@ -279,6 +288,38 @@ func validateTransferLimit(limit interface{}) error {
 	return nil
 }
 
+func validateAutoBatchInterval(interval interface{}) error {
+	i, ok := interval.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for auto batch interval: %T", interval)
+	}
+	if i < 0 {
+		return fmt.Errorf("auto batch interval must be >=0")
+	}
+
+	return nil
+}
+
+func validateSignRetryLimit(limit interface{}) error {
+	if _, ok := limit.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type for sign retry limit: %T", limit)
+	}
+
+	return nil
+}
+
+func validateSignRetryBackoff(backoff interface{}) error {
+	b, ok := backoff.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for sign retry backoff: %T", backoff)
+	}
+	if b < 0 {
+		return fmt.Errorf("sign retry backoff must be >=0")
+	}
+
+	return nil
+}
+
 // Validate checks the validity of the values of the parameter set
 func (m Params) Validate() error {
 	if err := validateConfirmationHeight(m.ConfirmationHeight); err != nil {
@@ -342,5 +383,21 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateAutoBatchInterval(m.AutoBatchInterval); err != nil {
+		return err
+	}
+
+	if err := validateSignRetryLimit(m.SignRetryLimit); err != nil {
+		return err
+	}
+
+	if err := validateSignRetryBackoff(m.SignRetryBackoff); err != nil {
+		return err
+	}
+
+	if m.SignRetryLimit > 0 && m.SignRetryBackoff == 0 {
+		return fmt.Errorf("sign retry backoff must be >0 if signing is retried")
+	}
+
 	return nil
 }
@@ -41,6 +41,15 @@ type Params struct {
 	VotingGracePeriod   int64                                                           `protobuf:"varint,13,opt,name=voting_grace_period,json=votingGracePeriod,proto3" json:"voting_grace_period,omitempty"`
 	EndBlockerLimit     int64                                                           `protobuf:"varint,14,opt,name=end_blocker_limit,json=endBlockerLimit,proto3" json:"end_blocker_limit,omitempty"`
 	TransferLimit       uint64                                                          `protobuf:"varint,15,opt,name=transfer_limit,json=transferLimit,proto3" json:"transfer_limit,omitempty"`
+	// number of blocks between the automatic creation and signing of command
+	// batches in the end blocker, 0 disables it
+	AutoBatchInterval int64 `protobuf:"varint,16,opt,name=auto_batch_interval,json=autoBatchInterval,proto3" json:"auto_batch_interval,omitempty"`
+	// maximum number of times the signing of an aborted command batch is retried
+	// automatically, 0 disables it
+	SignRetryLimit uint32 `protobuf:"varint,17,opt,name=sign_retry_limit,json=signRetryLimit,proto3" json:"sign_retry_limit,omitempty"`
+	// number of blocks to wait before the first automatic signing retry, every
+	// further retry waits this many blocks longer than the previous one
+	SignRetryBackoff int64 `protobuf:"varint,18,opt,name=sign_retry_backoff,json=signRetryBackoff,proto3" json:"sign_retry_backoff,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -122,45 +131,49 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/params.proto", fileDescriptor_c8097febe234aa9c) }
 
 var fileDescriptor_c8097febe234aa9c = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xb6, 0xb6, 0xeb, 0xbc, 0x75, 0xed, 0x3c, 0x90, 0xa2, 0x4a, 0xa4, 0xd1, 0x34, 0x50,
-	0x41, 0x90, 0xb0, 0x71, 0xe1, 0x06, 0xb4, 0x87, 0xc1, 0x34, 0x4d, 0x55, 0x84, 0x90, 0xe0, 0x12,
-	0xb9, 0xc9, 0x5b, 0x6a, 0x2d, 0xb1, 0x2b, 0xc7, 0x2d, 0xe5, 0x2b, 0x70, 0xe2, 0xc6, 0x57, 0xda,
-	0x71, 0x47, 0x4e, 0x13, 0x6c, 0xdf, 0x82, 0x13, 0x8a, 0xed, 0x44, 0x13, 0xf4, 0xc0, 0x2d, 0xfe,
-	0xfd, 0x7b, 0x8e, 0xdf, 0x7b, 0xa8, 0x4f, 0x96, 0x90, 0x12, 0xe1, 0xc3, 0x22, 0xf3, 0x17, 0x87,
-	0x13, 0x90, 0xe4, 0xd0, 0x9f, 0x11, 0x41, 0xb2, 0xdc, 0x9b, 0x09, 0x2e, 0x39, 0xc6, 0x5a, 0xe0,
-	0xc1, 0x22, 0xf3, 0x8c, 0xa0, 0x77, 0x60, 0x4c, 0x73, 0x49, 0xd3, 0xbc, 0xb2, 0xc9, 0xa9, 0x80,
-	0x7c, 0xca, 0xd3, 0x58, 0x3b, 0x7b, 0xce, 0x8a, 0x68, 0xf9, 0x65, 0x06, 0x26, 0xb9, 0x77, 0x2f,
-	0xe1, 0x09, 0x57, 0x9f, 0x7e, 0xf1, 0x65, 0xd0, 0xc7, 0xc6, 0xc5, 0x60, 0x39, 0xcf, 0x7d, 0x58,
-	0xce, 0xb8, 0x90, 0x10, 0xaf, 0x0a, 0xd8, 0xff, 0xde, 0x40, 0xcd, 0xb1, 0xba, 0x2b, 0xfe, 0x88,
-	0x1a, 0xd1, 0x94, 0x50, 0x66, 0x5b, 0xae, 0x35, 0xd8, 0x1c, 0x8e, 0x7e, 0x5f, 0xf7, 0x5f, 0x25,
-	0x54, 0x4e, 0xe7, 0x13, 0x2f, 0xe2, 0x99, 0xaf, 0x33, 0x19, 0xc8, 0xcf, 0x5c, 0x5c, 0x98, 0xd3,
-	0xb3, 0x88, 0x0b, 0xf0, 0x97, 0x7f, 0x15, 0xf2, 0x46, 0x45, 0xcc, 0x19, 0xc9, 0x20, 0xd0, 0x89,
-	0xd8, 0x47, 0x7b, 0x11, 0x67, 0xe7, 0x54, 0x64, 0x44, 0x52, 0xce, 0xc2, 0x29, 0xd0, 0x64, 0x2a,
-	0xed, 0x35, 0xd7, 0x1a, 0xd4, 0x03, 0x7c, 0x97, 0x7a, 0xab, 0x18, 0x6c, 0xa3, 0x0d, 0x53, 0xc9,
-	0x5e, 0x2f, 0x6e, 0x13, 0x94, 0x47, 0xfc, 0x00, 0x21, 0xc9, 0x2f, 0x80, 0x85, 0x11, 0x8f, 0xc1,
-	0x6e, 0xb8, 0xd6, 0x60, 0x3b, 0xd8, 0x54, 0xc8, 0x88, 0xc7, 0x80, 0x7b, 0xa8, 0x35, 0x99, 0x0b,
-	0x46, 0x26, 0x29, 0xd8, 0x4d, 0x45, 0x56, 0x67, 0x7c, 0x84, 0xee, 0x0b, 0x58, 0x70, 0x09, 0x61,
-	0xca, 0xa3, 0x0b, 0xca, 0x92, 0x70, 0x06, 0x82, 0xf2, 0xd8, 0xde, 0x70, 0xad, 0xc1, 0x7a, 0xb0,
-	0xa7, 0xc9, 0x53, 0xcd, 0x8d, 0x15, 0x85, 0xdf, 0xa0, 0x96, 0xa9, 0x9c, 0xdb, 0x2d, 0x77, 0x7d,
-	0xb0, 0x75, 0xd4, 0xf7, 0xfe, 0xed, 0xa6, 0x77, 0xa6, 0x35, 0xef, 0xd8, 0x39, 0x1f, 0xd6, 0x2f,
-	0xaf, 0xfb, 0xb5, 0xa0, 0xb2, 0xe1, 0x31, 0xea, 0x2e, 0xb8, 0x2c, 0xca, 0x55, 0xdd, 0xb5, 0x37,
-	0x5d, 0xeb, 0x6e, 0x94, 0x1a, 0x82, 0x2a, 0xec, 0x7d, 0x29, 0x33, 0x51, 0x1d, 0x6d, 0xaf, 0x60,
-	0xfc, 0x08, 0x75, 0x32, 0xca, 0xc2, 0xe2, 0xb6, 0x22, 0x8c, 0xf8, 0x9c, 0x49, 0x1b, 0xa9, 0x5f,
-	0x68, 0x67, 0x94, 0x7d, 0x28, 0xd0, 0x51, 0x01, 0xe2, 0xa7, 0x08, 0x47, 0x3c, 0xcb, 0x08, 0x8b,
-	0xf3, 0x30, 0x21, 0x79, 0x98, 0xd2, 0x8c, 0x4a, 0x7b, 0xcb, 0xb5, 0x06, 0xed, 0xa0, 0x5b, 0x32,
-	0xc7, 0x24, 0x3f, 0x2d, 0x70, 0xec, 0xa1, 0x3d, 0x73, 0xcf, 0x44, 0x90, 0x08, 0xca, 0xc7, 0x69,
-	0xab, 0xe4, 0x5d, 0x4d, 0x1d, 0x17, 0x8c, 0x79, 0x9a, 0x27, 0x68, 0x17, 0x58, 0x1c, 0x4e, 0x8a,
-	0xc7, 0x04, 0x61, 0xc2, 0x77, 0x94, 0xba, 0x03, 0x2c, 0x1e, 0x6a, 0x5c, 0x67, 0x3f, 0x44, 0x3b,
-	0x52, 0x10, 0x96, 0x9f, 0x57, 0xc2, 0x8e, 0xea, 0x7d, 0xbb, 0x44, 0x95, 0xec, 0xa4, 0xde, 0xaa,
-	0x77, 0x1b, 0x27, 0xf5, 0xd6, 0x76, 0xb7, 0xbd, 0xff, 0xd5, 0x42, 0xdb, 0x63, 0x60, 0x31, 0x65,
-	0x89, 0x9a, 0x27, 0xfc, 0x12, 0x35, 0xf5, 0x56, 0xa9, 0x01, 0xdd, 0x3a, 0xea, 0xad, 0x6a, 0x84,
-	0x9e, 0x65, 0xf3, 0x70, 0x46, 0x8f, 0x5f, 0x97, 0x93, 0xbd, 0xa6, 0x8c, 0x07, 0xa5, 0x51, 0x8d,
-	0xad, 0x57, 0x8d, 0x6d, 0x99, 0xa1, 0xca, 0x99, 0x08, 0x6d, 0x1c, 0x9e, 0x5d, 0xfe, 0x72, 0x6a,
-	0x97, 0x37, 0x8e, 0x75, 0x75, 0xe3, 0x58, 0x3f, 0x6f, 0x1c, 0xeb, 0xdb, 0xad, 0x53, 0xbb, 0xba,
-	0x75, 0x6a, 0x3f, 0x6e, 0x9d, 0xda, 0xa7, 0xe7, 0xff, 0xb9, 0x26, 0xc5, 0x16, 0xab, 0xe5, 0x9b,
-	0x34, 0xd5, 0xf6, 0xbd, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0xec, 0x46, 0xee, 0x1d, 0x3b, 0x04,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4e, 0xdb, 0x48,
+	0x18, 0x8f, 0x21, 0x84, 0x30, 0x10, 0x12, 0x86, 0x5d, 0xc9, 0x8a, 0xb4, 0x4e, 0x84, 0xd8, 0x95,
+	0xb7, 0xa2, 0x76, 0xa1, 0x97, 0xde, 0xda, 0x26, 0x07, 0x0a, 0x42, 0x28, 0xb2, 0xaa, 0x4a, 0xed,
+	0xc5, 0x1a, 0xdb, 0x13, 0x7b, 0x14, 0x7b, 0x26, 0x1a, 0x4f, 0xd2, 0xf0, 0x0a, 0x3d, 0xf5, 0x0d,
+	0xfa, 0x3a, 0x1c, 0x39, 0xf6, 0x84, 0x5a, 0x78, 0x8b, 0x9e, 0xaa, 0xf9, 0x63, 0x0b, 0xb5, 0x1c,
+	0x7a, 0xf3, 0xfc, 0xfe, 0x7d, 0x9f, 0x67, 0xe6, 0x1b, 0x30, 0x40, 0x2b, 0x9c, 0x23, 0xee, 0xe3,
+	0x65, 0xe1, 0x2f, 0x8f, 0x23, 0x2c, 0xd0, 0xb1, 0x3f, 0x47, 0x1c, 0x15, 0xa5, 0x37, 0xe7, 0x4c,
+	0x30, 0x08, 0xb5, 0xc0, 0xc3, 0xcb, 0xc2, 0x33, 0x82, 0xfe, 0xa1, 0x31, 0x2d, 0x04, 0xc9, 0xcb,
+	0xda, 0x26, 0x32, 0x8e, 0xcb, 0x8c, 0xe5, 0x89, 0x76, 0xf6, 0x9d, 0x47, 0xa2, 0xc5, 0xd5, 0x1c,
+	0x9b, 0xe4, 0xfe, 0x5f, 0x29, 0x4b, 0x99, 0xfa, 0xf4, 0xe5, 0x97, 0x41, 0xff, 0x37, 0x2e, 0x8a,
+	0x57, 0x8b, 0xd2, 0xc7, 0xab, 0x39, 0xe3, 0x02, 0x27, 0x8f, 0x05, 0x1c, 0x7c, 0x69, 0x81, 0xd6,
+	0x44, 0xf5, 0x0a, 0xdf, 0x83, 0x8d, 0x38, 0x43, 0x84, 0xda, 0xd6, 0xd0, 0x72, 0xb7, 0x46, 0xe3,
+	0x1f, 0xb7, 0x83, 0x97, 0x29, 0x11, 0xd9, 0x22, 0xf2, 0x62, 0x56, 0xf8, 0x3a, 0x93, 0x62, 0xf1,
+	0x91, 0xf1, 0x99, 0x59, 0x3d, 0x8d, 0x19, 0xc7, 0xfe, 0xea, 0x97, 0x42, 0xde, 0x58, 0xc6, 0x5c,
+	0xa2, 0x02, 0x07, 0x3a, 0x11, 0xfa, 0x60, 0x3f, 0x66, 0x74, 0x4a, 0x78, 0x81, 0x04, 0x61, 0x34,
+	0xcc, 0x30, 0x49, 0x33, 0x61, 0xaf, 0x0d, 0x2d, 0xb7, 0x19, 0xc0, 0x87, 0xd4, 0x1b, 0xc5, 0x40,
+	0x1b, 0x6c, 0x9a, 0x4a, 0xf6, 0xba, 0xec, 0x26, 0xa8, 0x96, 0xf0, 0x1f, 0x00, 0x04, 0x9b, 0x61,
+	0x1a, 0xc6, 0x2c, 0xc1, 0xf6, 0xc6, 0xd0, 0x72, 0x77, 0x82, 0x2d, 0x85, 0x8c, 0x59, 0x82, 0x61,
+	0x1f, 0xb4, 0xa3, 0x05, 0xa7, 0x28, 0xca, 0xb1, 0xdd, 0x52, 0x64, 0xbd, 0x86, 0x27, 0xe0, 0x6f,
+	0x8e, 0x97, 0x4c, 0xe0, 0x30, 0x67, 0xf1, 0x8c, 0xd0, 0x34, 0x9c, 0x63, 0x4e, 0x58, 0x62, 0x6f,
+	0x0e, 0x2d, 0x77, 0x3d, 0xd8, 0xd7, 0xe4, 0x85, 0xe6, 0x26, 0x8a, 0x82, 0xaf, 0x41, 0xdb, 0x54,
+	0x2e, 0xed, 0xf6, 0x70, 0xdd, 0xdd, 0x3e, 0x19, 0x78, 0xbf, 0x9f, 0xa6, 0x77, 0xa9, 0x35, 0x67,
+	0x74, 0xca, 0x46, 0xcd, 0xeb, 0xdb, 0x41, 0x23, 0xa8, 0x6d, 0x70, 0x02, 0x7a, 0x4b, 0x26, 0x64,
+	0xb9, 0xfa, 0x74, 0xed, 0xad, 0xa1, 0xf5, 0x30, 0x4a, 0x5d, 0x82, 0x3a, 0xec, 0x6d, 0x25, 0x33,
+	0x51, 0x5d, 0x6d, 0xaf, 0x61, 0xf8, 0x1f, 0xe8, 0x16, 0x84, 0x86, 0xb2, 0x5b, 0x1e, 0xc6, 0x6c,
+	0x41, 0x85, 0x0d, 0xd4, 0x2f, 0x74, 0x0a, 0x42, 0xdf, 0x49, 0x74, 0x2c, 0x41, 0x78, 0x04, 0x60,
+	0xcc, 0x8a, 0x02, 0xd1, 0xa4, 0x0c, 0x53, 0x54, 0x86, 0x39, 0x29, 0x88, 0xb0, 0xb7, 0x87, 0x96,
+	0xdb, 0x09, 0x7a, 0x15, 0x73, 0x8a, 0xca, 0x0b, 0x89, 0x43, 0x0f, 0xec, 0x9b, 0x3e, 0x53, 0x8e,
+	0x62, 0x5c, 0x6d, 0x4e, 0x47, 0x25, 0xef, 0x69, 0xea, 0x54, 0x32, 0x66, 0x6b, 0x9e, 0x80, 0x3d,
+	0x4c, 0x93, 0x30, 0x92, 0x9b, 0x89, 0xb9, 0x09, 0xdf, 0x55, 0xea, 0x2e, 0xa6, 0xc9, 0x48, 0xe3,
+	0x3a, 0xfb, 0x5f, 0xb0, 0x2b, 0x38, 0xa2, 0xe5, 0xb4, 0x16, 0x76, 0xd5, 0xd9, 0x77, 0x2a, 0xb4,
+	0x6e, 0x01, 0x2d, 0x04, 0x0b, 0x23, 0x24, 0xe2, 0x2c, 0x24, 0x54, 0x60, 0xbe, 0x44, 0xb9, 0xdd,
+	0xd3, 0x2d, 0x48, 0x6a, 0x24, 0x99, 0x33, 0x43, 0x40, 0x17, 0xf4, 0x4a, 0x92, 0xd2, 0x90, 0x63,
+	0xc1, 0xaf, 0x4c, 0xf0, 0x9e, 0xfa, 0xbd, 0x5d, 0x89, 0x07, 0x12, 0xd6, 0xc9, 0x47, 0x00, 0x3e,
+	0x50, 0x46, 0x28, 0x9e, 0xb1, 0xe9, 0xd4, 0x86, 0x2a, 0xb8, 0x57, 0x6b, 0x47, 0x1a, 0x3f, 0x6f,
+	0xb6, 0x9b, 0xbd, 0x8d, 0xf3, 0x66, 0x7b, 0xa7, 0xd7, 0x39, 0xf8, 0x64, 0x81, 0x9d, 0x09, 0xa6,
+	0x09, 0xa1, 0xa9, 0xba, 0xd7, 0xf0, 0x05, 0x68, 0xe9, 0xe9, 0x56, 0x83, 0xb2, 0x7d, 0xd2, 0x7f,
+	0xec, 0x42, 0xe8, 0x99, 0x32, 0x07, 0x68, 0xf4, 0xf0, 0x55, 0x35, 0x61, 0x6b, 0xca, 0x78, 0x58,
+	0x19, 0xd5, 0xf8, 0x78, 0xf5, 0xf8, 0x54, 0x19, 0xaa, 0x9c, 0x89, 0xd0, 0xc6, 0xd1, 0xe5, 0xf5,
+	0x77, 0xa7, 0x71, 0x7d, 0xe7, 0x58, 0x37, 0x77, 0x8e, 0xf5, 0xed, 0xce, 0xb1, 0x3e, 0xdf, 0x3b,
+	0x8d, 0x9b, 0x7b, 0xa7, 0xf1, 0xf5, 0xde, 0x69, 0x7c, 0x78, 0xf6, 0x87, 0xe3, 0x2a, 0x5f, 0x13,
+	0xf5, 0x08, 0x44, 0x2d, 0xf5, 0x0a, 0x3c, 0xff, 0x39, 0x00, 0x89, 0x62, 0xec, 0xd7, 0xc3, 0x04,
 	0x00, 0x00,
 }
 
@@ -184,6 +197,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignRetryBackoff != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignRetryBackoff))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SignRetryLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignRetryLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AutoBatchInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoBatchInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.TransferLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TransferLimit))
 		i--
@@ -379,6 +413,15 @@ func (m *Params) Size() (n int) {
 	if m.TransferLimit != 0 {
 		n += 1 + sovParams(uint64(m.TransferLimit))
 	}
+	if m.AutoBatchInterval != 0 {
+		n += 2 + sovParams(uint64(m.AutoBatchInterval))
+	}
+	if m.SignRetryLimit != 0 {
+		n += 2 + sovParams(uint64(m.SignRetryLimit))
+	}
+	if m.SignRetryBackoff != 0 {
+		n += 2 + sovParams(uint64(m.SignRetryBackoff))
+	}
 	return n
 }
 
@@ -762,6 +805,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchInterval", wireType)
+			}
+			m.AutoBatchInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoBatchInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRetryLimit", wireType)
+			}
+			m.SignRetryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignRetryLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRetryBackoff", wireType)
+			}
+			m.SignRetryBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignRetryBackoff |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -143,6 +145,7 @@ type BatchedCommandsResponse struct {
 	PrevBatchedCommandsID string                                                         `protobuf:"bytes,7,opt,name=prev_batched_commands_id,json=prevBatchedCommandsId,proto3" json:"prev_batched_commands_id,omitempty"`
 	CommandIDs            []string                                                       `protobuf:"bytes,8,rep,name=command_ids,json=commandIds,proto3" json:"command_ids,omitempty"`
 	Proof                 *Proof                                                         `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
+	Height                int64                                                          `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *BatchedCommandsResponse) Reset()         { *m = BatchedCommandsResponse{} }
@@ -178,6 +181,133 @@ func (m *BatchedCommandsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BatchedCommandsResponse proto.InternalMessageInfo
 
+// CommandBatchesRequest represents a message that queries the command batches
+// of the specified chain in order of the block height at which they were
+// created. Batches can be filtered by status, key ID and the range of block
+// heights at which they were created
+type CommandBatchesRequest struct {
+	Chain  string                                                         `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Status BatchedCommandsStatus                                          `protobuf:"varint,2,opt,name=status,proto3,enum=axelar.evm.v1beta1.BatchedCommandsStatus" json:"status,omitempty"`
+	KeyID  github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
+	// min_height and max_height bound the block heights at which the batches
+	// were created, they are ignored if set to 0. Batches created before the
+	// height was recorded have height 0 and are excluded if either bound is set
+	MinHeight  int64              `protobuf:"varint,4,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight  int64              `protobuf:"varint,5,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *CommandBatchesRequest) Reset()         { *m = CommandBatchesRequest{} }
+func (m *CommandBatchesRequest) String() string { return proto.CompactTextString(m) }
+func (*CommandBatchesRequest) ProtoMessage()    {}
+func (*CommandBatchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{3}
+}
+func (m *CommandBatchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandBatchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandBatchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandBatchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandBatchesRequest.Merge(m, src)
+}
+func (m *CommandBatchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandBatchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandBatchesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandBatchesRequest proto.InternalMessageInfo
+
+type CommandBatchesResponse struct {
+	Batches    []BatchedCommandsResponse `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches"`
+	Pagination *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *CommandBatchesResponse) Reset()         { *m = CommandBatchesResponse{} }
+func (m *CommandBatchesResponse) String() string { return proto.CompactTextString(m) }
+func (*CommandBatchesResponse) ProtoMessage()    {}
+func (*CommandBatchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{4}
+}
+func (m *CommandBatchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandBatchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandBatchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandBatchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandBatchesResponse.Merge(m, src)
+}
+func (m *CommandBatchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandBatchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandBatchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandBatchesResponse proto.InternalMessageInfo
+
+// BatchedCommandsByCommandRequest represents a message that queries the batch
+// that contains the specified command
+type BatchedCommandsByCommandRequest struct {
+	Chain     string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	CommandId string `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+}
+
+func (m *BatchedCommandsByCommandRequest) Reset()         { *m = BatchedCommandsByCommandRequest{} }
+func (m *BatchedCommandsByCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchedCommandsByCommandRequest) ProtoMessage()    {}
+func (*BatchedCommandsByCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{5}
+}
+func (m *BatchedCommandsByCommandRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchedCommandsByCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchedCommandsByCommandRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchedCommandsByCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchedCommandsByCommandRequest.Merge(m, src)
+}
+func (m *BatchedCommandsByCommandRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchedCommandsByCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchedCommandsByCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchedCommandsByCommandRequest proto.InternalMessageInfo
+
 type KeyAddressRequest struct {
 	Chain string                                                         `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
 	KeyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
@@ -187,7 +317,7 @@ func (m *KeyAddressRequest) Reset()         { *m = KeyAddressRequest{} }
 func (m *KeyAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*KeyAddressRequest) ProtoMessage()    {}
 func (*KeyAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{3}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{6}
 }
 func (m *KeyAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +356,7 @@ func (m *KeyAddressResponse) Reset()         { *m = KeyAddressResponse{} }
 func (m *KeyAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyAddressResponse) ProtoMessage()    {}
 func (*KeyAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{4}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{7}
 }
 func (m *KeyAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +394,7 @@ func (m *KeyAddressResponse_WeightedAddress) Reset()         { *m = KeyAddressRe
 func (m *KeyAddressResponse_WeightedAddress) String() string { return proto.CompactTextString(m) }
 func (*KeyAddressResponse_WeightedAddress) ProtoMessage()    {}
 func (*KeyAddressResponse_WeightedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{4, 0}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{7, 0}
 }
 func (m *KeyAddressResponse_WeightedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +433,7 @@ func (m *QueryTokenAddressResponse) Reset()         { *m = QueryTokenAddressResp
 func (m *QueryTokenAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenAddressResponse) ProtoMessage()    {}
 func (*QueryTokenAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{5}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{8}
 }
 func (m *QueryTokenAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +471,7 @@ func (m *QueryDepositStateParams) Reset()         { *m = QueryDepositStateParams
 func (m *QueryDepositStateParams) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositStateParams) ProtoMessage()    {}
 func (*QueryDepositStateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{6}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{9}
 }
 func (m *QueryDepositStateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +509,7 @@ func (m *DepositStateRequest) Reset()         { *m = DepositStateRequest{} }
 func (m *DepositStateRequest) String() string { return proto.CompactTextString(m) }
 func (*DepositStateRequest) ProtoMessage()    {}
 func (*DepositStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{7}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{10}
 }
 func (m *DepositStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +546,7 @@ func (m *DepositStateResponse) Reset()         { *m = DepositStateResponse{} }
 func (m *DepositStateResponse) String() string { return proto.CompactTextString(m) }
 func (*DepositStateResponse) ProtoMessage()    {}
 func (*DepositStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{8}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{11}
 }
 func (m *DepositStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +584,7 @@ func (m *EventRequest) Reset()         { *m = EventRequest{} }
 func (m *EventRequest) String() string { return proto.CompactTextString(m) }
 func (*EventRequest) ProtoMessage()    {}
 func (*EventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{9}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{12}
 }
 func (m *EventRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +621,7 @@ func (m *EventResponse) Reset()         { *m = EventResponse{} }
 func (m *EventResponse) String() string { return proto.CompactTextString(m) }
 func (*EventResponse) ProtoMessage()    {}
 func (*EventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{10}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{13}
 }
 func (m *EventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,6 +650,89 @@ func (m *EventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventResponse proto.InternalMessageInfo
 
+// EventsRequest represents a message that queries the events of the specified
+// chain. Events can be filtered by status, type, destination chain and tx ID
+type EventsRequest struct {
+	Chain  string       `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Status Event_Status `protobuf:"varint,2,opt,name=status,proto3,enum=axelar.evm.v1beta1.Event_Status" json:"status,omitempty"`
+	// type is the name of the event field, e.g. token_sent or contract_call
+	Type             string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DestinationChain string             `protobuf:"bytes,4,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	TxID             string             `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{14}
+}
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+type EventsResponse struct {
+	Events     []Event             `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
+func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()    {}
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{15}
+}
+func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsResponse.Merge(m, src)
+}
+func (m *EventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+
 type QueryBurnerAddressResponse struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -528,7 +741,7 @@ func (m *QueryBurnerAddressResponse) Reset()         { *m = QueryBurnerAddressRe
 func (m *QueryBurnerAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBurnerAddressResponse) ProtoMessage()    {}
 func (*QueryBurnerAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{11}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{16}
 }
 func (m *QueryBurnerAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,7 +777,7 @@ func (m *ChainsRequest) Reset()         { *m = ChainsRequest{} }
 func (m *ChainsRequest) String() string { return proto.CompactTextString(m) }
 func (*ChainsRequest) ProtoMessage()    {}
 func (*ChainsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{12}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{17}
 }
 func (m *ChainsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,7 +814,7 @@ func (m *ChainsResponse) Reset()         { *m = ChainsResponse{} }
 func (m *ChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainsResponse) ProtoMessage()    {}
 func (*ChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{13}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{18}
 }
 func (m *ChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +851,7 @@ func (m *PendingCommandsRequest) Reset()         { *m = PendingCommandsRequest{}
 func (m *PendingCommandsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingCommandsRequest) ProtoMessage()    {}
 func (*PendingCommandsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{14}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{19}
 }
 func (m *PendingCommandsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,7 +888,7 @@ func (m *PendingCommandsResponse) Reset()         { *m = PendingCommandsResponse
 func (m *PendingCommandsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingCommandsResponse) ProtoMessage()    {}
 func (*PendingCommandsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{15}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{20}
 }
 func (m *PendingCommandsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +929,7 @@ func (m *QueryCommandResponse) Reset()         { *m = QueryCommandResponse{} }
 func (m *QueryCommandResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommandResponse) ProtoMessage()    {}
 func (*QueryCommandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{16}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{21}
 }
 func (m *QueryCommandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +966,7 @@ func (m *BurnerInfoRequest) Reset()         { *m = BurnerInfoRequest{} }
 func (m *BurnerInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*BurnerInfoRequest) ProtoMessage()    {}
 func (*BurnerInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{17}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{22}
 }
 func (m *BurnerInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +1004,7 @@ func (m *BurnerInfoResponse) Reset()         { *m = BurnerInfoResponse{} }
 func (m *BurnerInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BurnerInfoResponse) ProtoMessage()    {}
 func (*BurnerInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{18}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{23}
 }
 func (m *BurnerInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +1041,7 @@ func (m *ConfirmationHeightRequest) Reset()         { *m = ConfirmationHeightReq
 func (m *ConfirmationHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationHeightRequest) ProtoMessage()    {}
 func (*ConfirmationHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{19}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{24}
 }
 func (m *ConfirmationHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +1078,7 @@ func (m *ConfirmationHeightResponse) Reset()         { *m = ConfirmationHeightRe
 func (m *ConfirmationHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationHeightResponse) ProtoMessage()    {}
 func (*ConfirmationHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{20}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{25}
 }
 func (m *ConfirmationHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,6 +1107,80 @@ func (m *ConfirmationHeightResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConfirmationHeightResponse proto.InternalMessageInfo
 
+type ChainIDRequest struct {
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *ChainIDRequest) Reset()         { *m = ChainIDRequest{} }
+func (m *ChainIDRequest) String() string { return proto.CompactTextString(m) }
+func (*ChainIDRequest) ProtoMessage()    {}
+func (*ChainIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{26}
+}
+func (m *ChainIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainIDRequest.Merge(m, src)
+}
+func (m *ChainIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainIDRequest proto.InternalMessageInfo
+
+type ChainIDResponse struct {
+	Id github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"id"`
+}
+
+func (m *ChainIDResponse) Reset()         { *m = ChainIDResponse{} }
+func (m *ChainIDResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainIDResponse) ProtoMessage()    {}
+func (*ChainIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{27}
+}
+func (m *ChainIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainIDResponse.Merge(m, src)
+}
+func (m *ChainIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainIDResponse proto.InternalMessageInfo
+
 type GatewayAddressRequest struct {
 	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
 }
@@ -902,7 +1189,7 @@ func (m *GatewayAddressRequest) Reset()         { *m = GatewayAddressRequest{} }
 func (m *GatewayAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*GatewayAddressRequest) ProtoMessage()    {}
 func (*GatewayAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{21}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{28}
 }
 func (m *GatewayAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,7 +1226,7 @@ func (m *GatewayAddressResponse) Reset()         { *m = GatewayAddressResponse{}
 func (m *GatewayAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*GatewayAddressResponse) ProtoMessage()    {}
 func (*GatewayAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{22}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{29}
 }
 func (m *GatewayAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,7 +1264,7 @@ func (m *BytecodeRequest) Reset()         { *m = BytecodeRequest{} }
 func (m *BytecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*BytecodeRequest) ProtoMessage()    {}
 func (*BytecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{23}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{30}
 }
 func (m *BytecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1014,7 +1301,7 @@ func (m *BytecodeResponse) Reset()         { *m = BytecodeResponse{} }
 func (m *BytecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*BytecodeResponse) ProtoMessage()    {}
 func (*BytecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{24}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{31}
 }
 func (m *BytecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1341,7 @@ func (m *ERC20TokensRequest) Reset()         { *m = ERC20TokensRequest{} }
 func (m *ERC20TokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensRequest) ProtoMessage()    {}
 func (*ERC20TokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{25}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{32}
 }
 func (m *ERC20TokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1093,7 +1380,7 @@ func (m *ERC20TokensResponse) Reset()         { *m = ERC20TokensResponse{} }
 func (m *ERC20TokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensResponse) ProtoMessage()    {}
 func (*ERC20TokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{26}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{33}
 }
 func (m *ERC20TokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1418,7 @@ func (m *ERC20TokensResponse_Token) Reset()         { *m = ERC20TokensResponse_T
 func (m *ERC20TokensResponse_Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensResponse_Token) ProtoMessage()    {}
 func (*ERC20TokensResponse_Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{26, 0}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{33, 0}
 }
 func (m *ERC20TokensResponse_Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1172,7 +1459,7 @@ func (m *TokenInfoRequest) Reset()         { *m = TokenInfoRequest{} }
 func (m *TokenInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*TokenInfoRequest) ProtoMessage()    {}
 func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{27}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{34}
 }
 func (m *TokenInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1259,7 +1546,7 @@ func (m *TokenInfoResponse) Reset()         { *m = TokenInfoResponse{} }
 func (m *TokenInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TokenInfoResponse) ProtoMessage()    {}
 func (*TokenInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{28}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{35}
 }
 func (m *TokenInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1586,7 @@ func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{29}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{36}
 }
 func (m *Proof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,6 +1620,9 @@ func init() {
 	proto.RegisterType((*DepositQueryParams)(nil), "axelar.evm.v1beta1.DepositQueryParams")
 	proto.RegisterType((*BatchedCommandsRequest)(nil), "axelar.evm.v1beta1.BatchedCommandsRequest")
 	proto.RegisterType((*BatchedCommandsResponse)(nil), "axelar.evm.v1beta1.BatchedCommandsResponse")
+	proto.RegisterType((*CommandBatchesRequest)(nil), "axelar.evm.v1beta1.CommandBatchesRequest")
+	proto.RegisterType((*CommandBatchesResponse)(nil), "axelar.evm.v1beta1.CommandBatchesResponse")
+	proto.RegisterType((*BatchedCommandsByCommandRequest)(nil), "axelar.evm.v1beta1.BatchedCommandsByCommandRequest")
 	proto.RegisterType((*KeyAddressRequest)(nil), "axelar.evm.v1beta1.KeyAddressRequest")
 	proto.RegisterType((*KeyAddressResponse)(nil), "axelar.evm.v1beta1.KeyAddressResponse")
 	proto.RegisterType((*KeyAddressResponse_WeightedAddress)(nil), "axelar.evm.v1beta1.KeyAddressResponse.WeightedAddress")
@@ -1342,6 +1632,8 @@ func init() {
 	proto.RegisterType((*DepositStateResponse)(nil), "axelar.evm.v1beta1.DepositStateResponse")
 	proto.RegisterType((*EventRequest)(nil), "axelar.evm.v1beta1.EventRequest")
 	proto.RegisterType((*EventResponse)(nil), "axelar.evm.v1beta1.EventResponse")
+	proto.RegisterType((*EventsRequest)(nil), "axelar.evm.v1beta1.EventsRequest")
+	proto.RegisterType((*EventsResponse)(nil), "axelar.evm.v1beta1.EventsResponse")
 	proto.RegisterType((*QueryBurnerAddressResponse)(nil), "axelar.evm.v1beta1.QueryBurnerAddressResponse")
 	proto.RegisterType((*ChainsRequest)(nil), "axelar.evm.v1beta1.ChainsRequest")
 	proto.RegisterType((*ChainsResponse)(nil), "axelar.evm.v1beta1.ChainsResponse")
@@ -1353,6 +1645,8 @@ func init() {
 	proto.RegisterType((*BurnerInfoResponse)(nil), "axelar.evm.v1beta1.BurnerInfoResponse")
 	proto.RegisterType((*ConfirmationHeightRequest)(nil), "axelar.evm.v1beta1.ConfirmationHeightRequest")
 	proto.RegisterType((*ConfirmationHeightResponse)(nil), "axelar.evm.v1beta1.ConfirmationHeightResponse")
+	proto.RegisterType((*ChainIDRequest)(nil), "axelar.evm.v1beta1.ChainIDRequest")
+	proto.RegisterType((*ChainIDResponse)(nil), "axelar.evm.v1beta1.ChainIDResponse")
 	proto.RegisterType((*GatewayAddressRequest)(nil), "axelar.evm.v1beta1.GatewayAddressRequest")
 	proto.RegisterType((*GatewayAddressResponse)(nil), "axelar.evm.v1beta1.GatewayAddressResponse")
 	proto.RegisterType((*BytecodeRequest)(nil), "axelar.evm.v1beta1.BytecodeRequest")
@@ -1368,102 +1662,119 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/query.proto", fileDescriptor_8fa0caa3a44d5acb) }
 
 var fileDescriptor_8fa0caa3a44d5acb = []byte{
-	// 1508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x13, 0x47,
-	0x14, 0xf7, 0xfa, 0x2b, 0xf6, 0x73, 0x3e, 0xcc, 0x10, 0x8c, 0x63, 0x51, 0xdb, 0xac, 0x54, 0x29,
-	0x14, 0x61, 0x13, 0x97, 0xa2, 0xc2, 0x81, 0x0f, 0x7f, 0x14, 0x9c, 0x54, 0x69, 0x58, 0x82, 0x28,
-	0x54, 0x95, 0x35, 0xf6, 0x4e, 0xec, 0x55, 0xe2, 0x5d, 0xb3, 0x33, 0x0e, 0xb6, 0xd4, 0xde, 0x2b,
-	0x4e, 0x5c, 0x7a, 0xe8, 0x01, 0xf5, 0xd0, 0x1e, 0x7a, 0xec, 0xa5, 0xea, 0xbf, 0x80, 0xd4, 0x0b,
-	0xc7, 0xaa, 0x07, 0xab, 0x35, 0xf7, 0xfe, 0x01, 0x9c, 0xaa, 0x9d, 0x99, 0xb5, 0x37, 0xc9, 0xc6,
-	0xe4, 0x00, 0xbd, 0xed, 0x7b, 0xfb, 0xde, 0x9b, 0xdf, 0xbc, 0x79, 0xef, 0x37, 0x6f, 0x20, 0x8b,
-	0x07, 0x64, 0x0f, 0xdb, 0x45, 0xb2, 0xdf, 0x2d, 0xee, 0xaf, 0x35, 0x09, 0xc3, 0x6b, 0xc5, 0x27,
-	0x7d, 0x62, 0x0f, 0x0b, 0x3d, 0xdb, 0x62, 0x16, 0x42, 0xe2, 0x7f, 0x81, 0xec, 0x77, 0x0b, 0xf2,
-	0x7f, 0x66, 0xb9, 0x6d, 0xb5, 0x2d, 0xfe, 0xbb, 0xe8, 0x7c, 0x09, 0xcb, 0x8c, 0x5f, 0x24, 0x36,
-	0xec, 0x11, 0x2a, 0xfe, 0xab, 0x3f, 0x2a, 0x80, 0xaa, 0xa4, 0x67, 0x51, 0x83, 0xdd, 0x73, 0x16,
-	0xd8, 0xc2, 0x36, 0xee, 0x52, 0x94, 0x86, 0x39, 0xac, 0xeb, 0x36, 0xa1, 0x34, 0xad, 0xe4, 0x95,
-	0xd5, 0xb8, 0xe6, 0x8a, 0x68, 0x19, 0x22, 0x98, 0x52, 0xc2, 0xd2, 0x41, 0xae, 0x17, 0x02, 0x7a,
-	0x04, 0x91, 0x56, 0x07, 0x1b, 0x66, 0x3a, 0xe4, 0x68, 0xcb, 0x95, 0x37, 0xa3, 0xdc, 0xcd, 0xb6,
-	0xc1, 0x3a, 0xfd, 0x66, 0xa1, 0x65, 0x75, 0x8b, 0x02, 0x84, 0x49, 0xd8, 0x53, 0xcb, 0xde, 0x95,
-	0xd2, 0xa5, 0x96, 0x65, 0x93, 0xe2, 0xa0, 0x68, 0x92, 0x41, 0x9f, 0x16, 0xc9, 0xa0, 0x67, 0xd9,
-	0x8c, 0xe8, 0x85, 0x8a, 0x13, 0x66, 0x13, 0x77, 0x89, 0x26, 0x22, 0xaa, 0x37, 0x20, 0x55, 0xc6,
-	0xac, 0xd5, 0x21, 0x7a, 0xc5, 0xea, 0x76, 0xb1, 0xa9, 0x53, 0x8d, 0x3c, 0xe9, 0x13, 0xca, 0x1c,
-	0x28, 0x62, 0x51, 0x01, 0x51, 0x08, 0x68, 0x11, 0x82, 0x86, 0x2e, 0xd1, 0x05, 0x0d, 0x5d, 0xfd,
-	0x23, 0x04, 0x67, 0x8f, 0x04, 0xa0, 0x3d, 0xcb, 0xa4, 0x04, 0xa5, 0xb8, 0x2d, 0x77, 0x2f, 0x47,
-	0xc7, 0xa3, 0x5c, 0xb0, 0x5e, 0x75, 0x7c, 0x10, 0x82, 0xb0, 0x8e, 0x19, 0x96, 0x51, 0xf8, 0x37,
-	0xba, 0x0d, 0x51, 0xca, 0x30, 0xeb, 0x53, 0xbe, 0xc7, 0xc5, 0xd2, 0x85, 0xc2, 0xd1, 0x43, 0x28,
-	0x1c, 0x5a, 0xe8, 0x3e, 0x77, 0xd0, 0xa4, 0x23, 0x6a, 0x42, 0x74, 0x97, 0x0c, 0x1b, 0x86, 0x9e,
-	0x0e, 0xf3, 0x25, 0x37, 0xc6, 0xa3, 0x5c, 0x64, 0x83, 0x0c, 0xeb, 0xd5, 0x37, 0xa3, 0xdc, 0x8d,
-	0x13, 0xe6, 0xab, 0xdb, 0xdf, 0x63, 0x06, 0x35, 0xda, 0xd3, 0x94, 0xf1, 0x08, 0x5a, 0x64, 0x97,
-	0x0c, 0xeb, 0x3a, 0x3a, 0x0f, 0xf3, 0x64, 0x40, 0x5a, 0x7d, 0x46, 0x1a, 0x7c, 0x0b, 0x51, 0xbe,
-	0x85, 0x84, 0xd4, 0x55, 0x9d, 0x9d, 0x68, 0x90, 0xee, 0xd9, 0x64, 0xbf, 0xd1, 0x14, 0x60, 0x1b,
-	0x2d, 0x89, 0xd6, 0x01, 0x36, 0xc7, 0x81, 0xad, 0x8c, 0x47, 0xb9, 0x33, 0x5b, 0x36, 0xd9, 0x3f,
-	0xb4, 0x9f, 0x7a, 0x55, 0x3b, 0xd3, 0xf3, 0x51, 0xeb, 0xa8, 0x08, 0x09, 0x19, 0xa6, 0x61, 0xe8,
-	0x34, 0x1d, 0xcb, 0x87, 0x56, 0xe3, 0xe5, 0xc5, 0xf1, 0x28, 0x07, 0xd2, 0xa8, 0x5e, 0xa5, 0x1a,
-	0x48, 0x93, 0xba, 0x4e, 0x51, 0x11, 0x22, 0x3d, 0xdb, 0xb2, 0x76, 0xd2, 0xf1, 0xbc, 0xb2, 0x9a,
-	0x28, 0xad, 0xf8, 0x65, 0x73, 0xcb, 0x31, 0xd0, 0x84, 0xdd, 0x7a, 0x38, 0x16, 0x49, 0x46, 0xd5,
-	0x1f, 0x14, 0x38, 0xb5, 0x41, 0x86, 0xb7, 0x45, 0x35, 0xce, 0xae, 0x84, 0xff, 0x21, 0xdd, 0xeb,
-	0xe1, 0x58, 0x30, 0x19, 0x5a, 0x0f, 0xc7, 0x42, 0xc9, 0xb0, 0xfa, 0x7b, 0x10, 0x90, 0x17, 0x9b,
-	0x2c, 0xb2, 0x29, 0x0c, 0xe5, 0xbd, 0x9d, 0xfa, 0x63, 0x88, 0xcb, 0x06, 0x25, 0x34, 0x1d, 0xcc,
-	0x87, 0x56, 0x13, 0xa5, 0xab, 0x7e, 0x19, 0x3d, 0x0a, 0xaf, 0xf0, 0x90, 0x18, 0xed, 0x0e, 0x23,
-	0xba, 0xd4, 0x97, 0xc3, 0x2f, 0x47, 0xb9, 0x80, 0x36, 0x0d, 0x87, 0xce, 0x41, 0x9c, 0x75, 0x6c,
-	0x42, 0x3b, 0xd6, 0x9e, 0x2e, 0xfa, 0x5b, 0x9b, 0x2a, 0x32, 0x15, 0x58, 0x3a, 0x14, 0x61, 0x06,
-	0x79, 0xa4, 0x20, 0xfa, 0x94, 0x1b, 0xcb, 0xce, 0x92, 0x92, 0xfa, 0x10, 0x56, 0x38, 0xfb, 0x6c,
-	0x5b, 0xbb, 0xc4, 0x3c, 0x9c, 0xbf, 0xe3, 0xc3, 0x9d, 0x83, 0x78, 0xcb, 0x32, 0x77, 0x0c, 0xbb,
-	0x4b, 0x44, 0xc7, 0xc7, 0xb4, 0xa9, 0xe2, 0x7a, 0x30, 0xad, 0xa8, 0xdf, 0xc0, 0x59, 0x1e, 0x58,
-	0x52, 0x9c, 0xd3, 0x8f, 0x44, 0x52, 0xdc, 0x05, 0x88, 0xb0, 0x81, 0x7b, 0x2a, 0xf3, 0xe5, 0x65,
-	0x67, 0xdb, 0x7f, 0x8d, 0x72, 0xe1, 0xbb, 0x98, 0x76, 0xc6, 0xa3, 0x5c, 0x78, 0x7b, 0x50, 0xaf,
-	0x6a, 0x61, 0x36, 0xa8, 0xeb, 0xe8, 0x2a, 0x2c, 0x36, 0xfb, 0xb6, 0x49, 0xec, 0x86, 0x0b, 0x24,
-	0xc8, 0x7d, 0x96, 0xa4, 0xcf, 0x9c, 0x0b, 0x79, 0x41, 0x98, 0x49, 0x51, 0xfd, 0x4d, 0x81, 0xd3,
-	0xde, 0x95, 0xdd, 0x72, 0x7d, 0x74, 0xa0, 0x5c, 0xdf, 0x25, 0x5b, 0xa2, 0x0a, 0x44, 0x7b, 0x7c,
-	0x7f, 0x1c, 0x62, 0xa2, 0x74, 0xd1, 0xaf, 0x0a, 0x8e, 0x49, 0x89, 0x26, 0x5d, 0xd5, 0x7b, 0xb0,
-	0x7c, 0x10, 0xb6, 0x3c, 0x89, 0x6b, 0x13, 0x0a, 0x0c, 0x72, 0x0a, 0x3c, 0xef, 0x17, 0xdc, 0xe3,
-	0x39, 0xa5, 0x3e, 0xf5, 0x26, 0xcc, 0xd7, 0xf6, 0x89, 0xc9, 0x66, 0x77, 0xec, 0x0a, 0xc4, 0x88,
-	0x63, 0xd5, 0x98, 0x30, 0xf8, 0x1c, 0x97, 0xeb, 0xba, 0x7a, 0x0b, 0x16, 0x64, 0x00, 0x09, 0xa6,
-	0x08, 0x11, 0xfe, 0x8f, 0x47, 0x38, 0x86, 0x40, 0x84, 0x87, 0xb0, 0x53, 0xaf, 0x42, 0x86, 0x6f,
-	0xbc, 0xec, 0x3d, 0xa3, 0xb7, 0x57, 0x99, 0xba, 0x04, 0x0b, 0x3c, 0xcd, 0x2e, 0xdb, 0xa8, 0x5d,
-	0x58, 0x74, 0x15, 0xd2, 0xf9, 0x2b, 0x88, 0xf2, 0x0d, 0x38, 0xbe, 0xa1, 0x77, 0x75, 0xa2, 0x32,
-	0xa4, 0x5a, 0x80, 0xd4, 0x16, 0x31, 0x75, 0xc3, 0x6c, 0x9f, 0xe8, 0x02, 0x54, 0x09, 0x9c, 0x3d,
-	0x62, 0x2f, 0x71, 0xae, 0x43, 0xcc, 0x25, 0x7b, 0x8e, 0x34, 0x51, 0x5a, 0x3d, 0xb6, 0x3e, 0xa4,
-	0xb3, 0xeb, 0x2b, 0x79, 0x61, 0xe2, 0xaf, 0x7e, 0x1f, 0x84, 0x65, 0x3f, 0xc3, 0x59, 0x97, 0xaa,
-	0x33, 0x79, 0xb8, 0x97, 0xaa, 0xf3, 0x8d, 0xb4, 0x49, 0xb9, 0x86, 0x38, 0x9c, 0x2b, 0x27, 0x85,
-	0x53, 0x10, 0x25, 0x5b, 0x33, 0x99, 0x3d, 0x94, 0xd0, 0x64, 0x24, 0x94, 0x3f, 0x44, 0xfb, 0xf1,
-	0x09, 0xdf, 0xba, 0x6c, 0x99, 0x87, 0xf9, 0x2e, 0x1e, 0x34, 0xda, 0x98, 0x36, 0x5a, 0x16, 0x65,
-	0xe9, 0x48, 0x5e, 0x59, 0x5d, 0xd0, 0xa0, 0x8b, 0x07, 0x77, 0x30, 0xad, 0x58, 0x94, 0x65, 0xae,
-	0x41, 0xc2, 0xb3, 0x00, 0x4a, 0x42, 0x68, 0x97, 0x0c, 0x65, 0x9a, 0x9d, 0x4f, 0x27, 0xf5, 0xfb,
-	0x78, 0xaf, 0xef, 0xee, 0x46, 0x08, 0xd7, 0x83, 0x9f, 0x2a, 0xea, 0x0d, 0x38, 0x25, 0x2a, 0xac,
-	0x6e, 0xee, 0x58, 0xee, 0x49, 0x5d, 0x38, 0x58, 0x5d, 0x3e, 0xd4, 0x31, 0x29, 0xb7, 0x5f, 0x15,
-	0x40, 0xde, 0x00, 0x32, 0xab, 0xef, 0x91, 0x33, 0x6e, 0x42, 0x42, 0xd2, 0x9b, 0x61, 0xee, 0x58,
-	0x92, 0x38, 0xb2, 0xbe, 0xe3, 0xcd, 0x14, 0x17, 0x34, 0x27, 0xdf, 0xea, 0x1a, 0xac, 0x54, 0x04,
-	0xed, 0x62, 0x66, 0x58, 0xe6, 0x5d, 0x4e, 0xea, 0xb3, 0x8b, 0xf4, 0x0a, 0x64, 0xfc, 0x5c, 0x26,
-	0x25, 0x14, 0xed, 0x88, 0x7b, 0xc2, 0x71, 0x0a, 0x6b, 0x52, 0x52, 0x2f, 0xc1, 0x99, 0x3b, 0x98,
-	0x91, 0xa7, 0xf8, 0x44, 0x03, 0x80, 0x5a, 0x82, 0xd4, 0x61, 0xf3, 0xb7, 0x76, 0x7b, 0x05, 0x96,
-	0xca, 0x43, 0x46, 0x5a, 0x96, 0x4e, 0x66, 0x73, 0x55, 0xc6, 0xe9, 0x25, 0x93, 0xd9, 0xb8, 0xe5,
-	0xde, 0x66, 0x13, 0x59, 0x2d, 0x40, 0x72, 0x1a, 0x44, 0x2e, 0x99, 0x81, 0x58, 0x53, 0xea, 0x64,
-	0xa0, 0x89, 0xac, 0x7e, 0x0d, 0xa8, 0xa6, 0x55, 0x4a, 0x97, 0xf9, 0xfd, 0xf7, 0x96, 0xa9, 0x66,
-	0xcd, 0xd3, 0x46, 0x8b, 0xa5, 0x0f, 0xfc, 0x8e, 0x89, 0x87, 0xd9, 0x1e, 0xf6, 0x88, 0xe8, 0x32,
-	0x67, 0x68, 0x3a, 0x7d, 0x20, 0xbe, 0x84, 0xb4, 0x01, 0x51, 0xc6, 0x35, 0x92, 0x0c, 0x2e, 0xf9,
-	0x72, 0xe8, 0x51, 0x47, 0xb1, 0x80, 0xdb, 0x76, 0x22, 0x44, 0xe6, 0x13, 0x88, 0x70, 0xf5, 0xf4,
-	0x85, 0xa0, 0x78, 0x5f, 0x08, 0x29, 0x88, 0xd2, 0x61, 0xb7, 0x69, 0xed, 0xb9, 0x57, 0xbf, 0x90,
-	0x54, 0x02, 0x49, 0xee, 0xe6, 0xed, 0x16, 0xff, 0x8d, 0xa7, 0x0e, 0xbc, 0x3c, 0xee, 0x06, 0xdc,
-	0xc8, 0xe9, 0x49, 0xe4, 0x90, 0xfc, 0x21, 0xe5, 0x72, 0x1c, 0xe6, 0x76, 0x0c, 0x53, 0x6f, 0x34,
-	0x87, 0xea, 0xbf, 0x0a, 0x9c, 0xf2, 0xac, 0x23, 0x13, 0xe0, 0x0f, 0xf5, 0x16, 0xcc, 0xe9, 0x84,
-	0x61, 0x63, 0xcf, 0xbd, 0x44, 0xf3, 0xc7, 0x26, 0xb9, 0x2a, 0xec, 0x64, 0x2a, 0x5c, 0x37, 0x6f,
-	0x79, 0x85, 0x66, 0x8c, 0x2c, 0xe1, 0x43, 0x23, 0x0b, 0xca, 0x41, 0xc2, 0xa0, 0x0d, 0x32, 0x60,
-	0xc4, 0x36, 0xf1, 0x1e, 0xe7, 0xa5, 0x98, 0x06, 0x06, 0xad, 0x49, 0x0d, 0x5a, 0x85, 0xa4, 0x6c,
-	0x55, 0xa7, 0x6e, 0x1a, 0x1d, 0x4c, 0x3b, 0x72, 0xc2, 0x97, 0x13, 0x4a, 0xc5, 0xd2, 0x89, 0x33,
-	0xc1, 0xa8, 0xdf, 0x42, 0x84, 0x8f, 0xcf, 0xce, 0x8a, 0xd3, 0xd1, 0x90, 0x5f, 0x4f, 0xde, 0xe1,
-	0x2e, 0x0d, 0x73, 0x62, 0x06, 0x13, 0x63, 0x63, 0x5c, 0x73, 0xc5, 0xd9, 0x63, 0x1f, 0xca, 0x02,
-	0x50, 0xa3, 0x6d, 0x62, 0xd6, 0xb7, 0x09, 0x4d, 0x87, 0xb9, 0xab, 0x47, 0xf3, 0xd1, 0x73, 0x05,
-	0xe2, 0x93, 0x32, 0x44, 0x17, 0x21, 0xb5, 0xfd, 0xc5, 0x46, 0x6d, 0xb3, 0xb1, 0xfd, 0x68, 0xab,
-	0xd6, 0x78, 0xb0, 0x79, 0x7f, 0xab, 0x56, 0xa9, 0x7f, 0x56, 0xaf, 0x55, 0x93, 0x81, 0xcc, 0xd2,
-	0xb3, 0x17, 0xf9, 0xc4, 0x03, 0x93, 0xf6, 0x48, 0xcb, 0xd8, 0x31, 0x88, 0x8e, 0x3e, 0x84, 0xd3,
-	0x1e, 0xe3, 0xfa, 0xe6, 0x76, 0x4d, 0xdb, 0xbc, 0xfd, 0x79, 0x52, 0xc9, 0xcc, 0x3f, 0x7b, 0x91,
-	0x8f, 0xd5, 0x4d, 0x99, 0x8a, 0x83, 0x66, 0xb5, 0x2f, 0xa5, 0x59, 0x50, 0x98, 0xb9, 0x19, 0xcb,
-	0xc4, 0xbe, 0xfb, 0x29, 0x1b, 0xf8, 0xe5, 0xe7, 0xac, 0x52, 0xde, 0x7c, 0xf9, 0x4f, 0x36, 0xf0,
-	0x72, 0x9c, 0x55, 0x5e, 0x8d, 0xb3, 0xca, 0xdf, 0xe3, 0xac, 0xf2, 0xfc, 0x75, 0x36, 0xf0, 0xea,
-	0x75, 0x36, 0xf0, 0xe7, 0xeb, 0x6c, 0xe0, 0xf1, 0xe5, 0x13, 0x92, 0xa9, 0xf3, 0x90, 0xe6, 0x0f,
-	0xe8, 0x66, 0x94, 0xbf, 0xa0, 0x3f, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x1a, 0x3d, 0xb3,
-	0xad, 0x0f, 0x00, 0x00,
+	// 1792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf2, 0x4b, 0xe4, 0xa3, 0x2c, 0xd1, 0x63, 0x99, 0xa6, 0x88, 0x98, 0x64, 0x16, 0xa8,
+	0x2b, 0xc7, 0xf0, 0x32, 0x56, 0x53, 0x37, 0xc9, 0xc1, 0x8e, 0xf9, 0x11, 0x7b, 0xad, 0x42, 0x95,
+	0x37, 0x4a, 0x53, 0xa7, 0x28, 0x88, 0x25, 0x77, 0x44, 0x2e, 0x24, 0xee, 0x32, 0x3b, 0x43, 0x99,
+	0x04, 0xda, 0x73, 0x8b, 0x9c, 0x82, 0x02, 0x3d, 0xf4, 0x10, 0xf4, 0xd0, 0x1e, 0x7a, 0x2a, 0x7a,
+	0x29, 0xfa, 0x2f, 0xf8, 0x98, 0x63, 0x91, 0x03, 0xd1, 0xca, 0xf7, 0xfe, 0x01, 0x39, 0x15, 0x3b,
+	0xf3, 0x76, 0xb9, 0xa4, 0x28, 0x4a, 0x40, 0xed, 0x9c, 0xc8, 0x99, 0x79, 0xef, 0x37, 0x6f, 0xde,
+	0xfc, 0xde, 0xc7, 0x2c, 0x94, 0xcc, 0x11, 0x3d, 0x36, 0xbd, 0x2a, 0x3d, 0xe9, 0x57, 0x4f, 0xee,
+	0xb5, 0x29, 0x37, 0xef, 0x55, 0xbf, 0x18, 0x52, 0x6f, 0xac, 0x0d, 0x3c, 0x97, 0xbb, 0x84, 0xc8,
+	0x75, 0x8d, 0x9e, 0xf4, 0x35, 0x5c, 0x2f, 0x6e, 0x76, 0xdd, 0xae, 0x2b, 0x96, 0xab, 0xfe, 0x3f,
+	0x29, 0x59, 0x5c, 0x84, 0xc4, 0xc7, 0x03, 0xca, 0x70, 0xfd, 0x9d, 0x8e, 0xcb, 0xfa, 0x2e, 0xab,
+	0xb6, 0x4d, 0x46, 0xe5, 0x16, 0xa1, 0xd8, 0xc0, 0xec, 0xda, 0x8e, 0xc9, 0x6d, 0xd7, 0x91, 0xb2,
+	0xea, 0x9f, 0x14, 0x20, 0x0d, 0x3a, 0x70, 0x99, 0xcd, 0x9f, 0xf9, 0x92, 0xfb, 0xa6, 0x67, 0xf6,
+	0x19, 0x29, 0xc0, 0xaa, 0x69, 0x59, 0x1e, 0x65, 0xac, 0xa0, 0x54, 0x94, 0xed, 0x8c, 0x11, 0x0c,
+	0xc9, 0x26, 0x24, 0x4d, 0xc6, 0x28, 0x2f, 0xc4, 0xc4, 0xbc, 0x1c, 0x90, 0xe7, 0x90, 0xec, 0xf4,
+	0x4c, 0xdb, 0x29, 0xc4, 0xfd, 0xd9, 0x5a, 0xfd, 0xbb, 0x49, 0xf9, 0x61, 0xd7, 0xe6, 0xbd, 0x61,
+	0x5b, 0xeb, 0xb8, 0xfd, 0xaa, 0x34, 0xd8, 0xa1, 0xfc, 0x85, 0xeb, 0x1d, 0xe1, 0xe8, 0x6e, 0xc7,
+	0xf5, 0x68, 0x75, 0x54, 0x75, 0xe8, 0x68, 0xc8, 0xaa, 0x74, 0x34, 0x70, 0x3d, 0x4e, 0x2d, 0xad,
+	0xee, 0xc3, 0xec, 0x99, 0x7d, 0x6a, 0x48, 0x44, 0xf5, 0x01, 0xe4, 0x6b, 0x26, 0xef, 0xf4, 0xa8,
+	0x55, 0x77, 0xfb, 0x7d, 0xd3, 0xb1, 0x98, 0x41, 0xbf, 0x18, 0x52, 0xc6, 0x7d, 0x53, 0xe4, 0xa6,
+	0xd2, 0x44, 0x39, 0x20, 0xeb, 0x10, 0xb3, 0x2d, 0xb4, 0x2e, 0x66, 0x5b, 0xea, 0xab, 0x38, 0xdc,
+	0x38, 0x03, 0xc0, 0x06, 0xae, 0xc3, 0x28, 0xc9, 0x0b, 0x59, 0xa1, 0x5e, 0x4b, 0x9d, 0x4e, 0xca,
+	0x31, 0xbd, 0xe1, 0xeb, 0x10, 0x02, 0x09, 0xcb, 0xe4, 0x26, 0xa2, 0x88, 0xff, 0xe4, 0x11, 0xa4,
+	0x18, 0x37, 0xf9, 0x90, 0x89, 0x33, 0xae, 0xef, 0xdc, 0xd6, 0xce, 0x5e, 0x98, 0x36, 0xb7, 0xd1,
+	0x27, 0x42, 0xc1, 0x40, 0x45, 0xd2, 0x86, 0xd4, 0x11, 0x1d, 0xb7, 0x6c, 0xab, 0x90, 0x10, 0x5b,
+	0xee, 0x9e, 0x4e, 0xca, 0xc9, 0x5d, 0x3a, 0xd6, 0x1b, 0xdf, 0x4d, 0xca, 0x0f, 0x2e, 0xe9, 0xaf,
+	0xfe, 0xf0, 0x98, 0xdb, 0xcc, 0xee, 0x4e, 0x5d, 0x26, 0x10, 0x8c, 0xe4, 0x11, 0x1d, 0xeb, 0x16,
+	0x79, 0x1b, 0xd6, 0xe8, 0x88, 0x76, 0x86, 0x9c, 0xb6, 0xc4, 0x11, 0x52, 0xe2, 0x08, 0x59, 0x9c,
+	0x6b, 0xf8, 0x27, 0x31, 0xa0, 0x30, 0xf0, 0xe8, 0x49, 0xab, 0x2d, 0x8d, 0x6d, 0x75, 0xd0, 0x5a,
+	0xdf, 0xb0, 0x55, 0x61, 0xd8, 0xd6, 0xe9, 0xa4, 0x7c, 0x7d, 0xdf, 0xa3, 0x27, 0x73, 0xe7, 0xd1,
+	0x1b, 0xc6, 0xf5, 0xc1, 0x82, 0x69, 0x8b, 0x54, 0x21, 0x8b, 0x30, 0x2d, 0xdb, 0x62, 0x85, 0x74,
+	0x25, 0xbe, 0x9d, 0xa9, 0xad, 0x9f, 0x4e, 0xca, 0x80, 0x42, 0x7a, 0x83, 0x19, 0x80, 0x22, 0xba,
+	0xc5, 0x48, 0x15, 0x92, 0x03, 0xcf, 0x75, 0x0f, 0x0b, 0x99, 0x8a, 0xb2, 0x9d, 0xdd, 0xd9, 0x5a,
+	0xe4, 0xcd, 0x7d, 0x5f, 0xc0, 0x90, 0x72, 0x24, 0x0f, 0xa9, 0x1e, 0xb5, 0xbb, 0x3d, 0x5e, 0x80,
+	0x8a, 0xb2, 0x1d, 0x37, 0x70, 0xf4, 0x34, 0x91, 0x4e, 0xe6, 0x52, 0xea, 0xb7, 0x31, 0xb8, 0x8e,
+	0x3b, 0x49, 0xe3, 0x2e, 0x60, 0xc9, 0xf4, 0x36, 0x63, 0xff, 0xff, 0x6d, 0xc6, 0xdf, 0xd8, 0x6d,
+	0xde, 0x04, 0xe8, 0xdb, 0x4e, 0x0b, 0x0f, 0x9e, 0x10, 0x07, 0xcf, 0xf4, 0x6d, 0xe7, 0x89, 0x98,
+	0x10, 0xcb, 0xe6, 0x28, 0x58, 0x4e, 0xe2, 0xb2, 0x39, 0xc2, 0xe5, 0x8f, 0x01, 0xa6, 0x01, 0x2f,
+	0x98, 0x90, 0xdd, 0xb9, 0xa5, 0xc9, 0xec, 0xa0, 0xf9, 0xd9, 0x41, 0x93, 0x09, 0x28, 0xf4, 0xb7,
+	0xd9, 0xa5, 0xe8, 0x36, 0x23, 0xa2, 0xa9, 0xfe, 0x4d, 0x81, 0xfc, 0xbc, 0x73, 0x31, 0x82, 0x76,
+	0x61, 0x55, 0xd2, 0xc8, 0x4f, 0x14, 0xf1, 0xed, 0xec, 0xce, 0x9d, 0x4b, 0x38, 0x32, 0xd0, 0xae,
+	0x25, 0x5e, 0x4e, 0xca, 0x2b, 0x46, 0x80, 0x40, 0x1e, 0xcf, 0xd8, 0x1b, 0x13, 0xf6, 0xfe, 0xf0,
+	0x42, 0x7b, 0x25, 0xd6, 0x8c, 0xc1, 0x3f, 0x87, 0xf2, 0xdc, 0x96, 0xb5, 0x31, 0xfe, 0x5b, 0x4e,
+	0x8b, 0x9b, 0x00, 0x53, 0x1a, 0x63, 0xf8, 0x67, 0x42, 0xd6, 0xaa, 0x7f, 0x54, 0xe0, 0xea, 0x2e,
+	0x1d, 0x3f, 0x92, 0xb9, 0x70, 0x39, 0xd4, 0xf7, 0x10, 0xec, 0x4f, 0x13, 0xe9, 0x58, 0x2e, 0xfe,
+	0x34, 0x91, 0x8e, 0xe7, 0x12, 0xea, 0x3f, 0x63, 0x40, 0xa2, 0xb6, 0xe1, 0x05, 0x4d, 0xcd, 0x50,
+	0xde, 0x18, 0x4b, 0x3f, 0x87, 0x0c, 0x96, 0x07, 0xea, 0xc7, 0x93, 0x4f, 0x83, 0xfb, 0x8b, 0x68,
+	0x70, 0xd6, 0x3c, 0xed, 0x33, 0xc1, 0x54, 0x6a, 0xe1, 0x3c, 0x32, 0x62, 0x0a, 0x47, 0xde, 0x82,
+	0x0c, 0xef, 0x79, 0x94, 0xf5, 0xdc, 0x63, 0x0c, 0x34, 0x63, 0x3a, 0x51, 0xac, 0xc3, 0xc6, 0x1c,
+	0xc2, 0x92, 0xd2, 0x95, 0x87, 0xd4, 0x0b, 0x19, 0x29, 0xf2, 0x62, 0x71, 0xa4, 0x7e, 0x06, 0x5b,
+	0xa2, 0xf6, 0x1d, 0xb8, 0x47, 0xd4, 0x99, 0xf7, 0xdf, 0xf9, 0x70, 0x6f, 0x41, 0xa6, 0xe3, 0x3a,
+	0x87, 0xb6, 0xd7, 0xa7, 0x92, 0x2a, 0x69, 0x63, 0x3a, 0xf1, 0x61, 0xac, 0xa0, 0xa8, 0xbf, 0x86,
+	0x1b, 0x02, 0x18, 0x0b, 0xac, 0x9f, 0x3f, 0x28, 0x16, 0xd8, 0xdb, 0x90, 0xe4, 0xa3, 0xe0, 0x56,
+	0xd6, 0x6a, 0x9b, 0xfe, 0xb1, 0xbf, 0x9d, 0x94, 0x13, 0x4f, 0x4c, 0xd6, 0x3b, 0x9d, 0x94, 0x13,
+	0x07, 0x23, 0xbd, 0x61, 0x24, 0xf8, 0x48, 0xb7, 0xc8, 0x7d, 0x58, 0x6f, 0x0f, 0x3d, 0x87, 0x7a,
+	0xad, 0xc0, 0x90, 0x98, 0xd0, 0xd9, 0x40, 0x9d, 0xd5, 0xc0, 0xe4, 0x2b, 0x52, 0x0c, 0x87, 0xea,
+	0x3f, 0x14, 0xb8, 0x16, 0xdd, 0x39, 0xa0, 0xeb, 0xf3, 0x19, 0xba, 0xbe, 0xce, 0x5a, 0x4d, 0xea,
+	0x90, 0x1a, 0x88, 0xf3, 0x61, 0xf0, 0x2e, 0x4c, 0x06, 0xe7, 0xb8, 0xc4, 0x40, 0x55, 0xf5, 0x19,
+	0x6c, 0xce, 0x9a, 0x8d, 0x37, 0xf1, 0xc1, 0x5c, 0xca, 0x7e, 0x7b, 0x11, 0x78, 0x44, 0x73, 0x9a,
+	0xaa, 0xd5, 0x87, 0xb0, 0xd6, 0x3c, 0xa1, 0x0e, 0x5f, 0x1e, 0xb1, 0x5b, 0x90, 0xa6, 0xbe, 0xd4,
+	0x34, 0xf4, 0x57, 0xc5, 0x58, 0xb7, 0xd4, 0x8f, 0xe0, 0x0a, 0x02, 0xa0, 0x31, 0x55, 0x48, 0x8a,
+	0xb5, 0x82, 0x72, 0x7e, 0xf9, 0x92, 0x1a, 0x52, 0x4e, 0xfd, 0x6d, 0x0c, 0x21, 0x2e, 0x48, 0x1b,
+	0xef, 0xcf, 0x9d, 0xb2, 0x72, 0x2e, 0xb2, 0x36, 0x57, 0x8f, 0x08, 0x24, 0xfc, 0x2e, 0x10, 0x83,
+	0x44, 0xfc, 0x27, 0x77, 0xe0, 0xaa, 0x45, 0x19, 0xc7, 0xbc, 0xd8, 0x92, 0xfb, 0x89, 0x7c, 0x64,
+	0xe4, 0x22, 0x0b, 0x75, 0x4c, 0x7e, 0xc8, 0xc9, 0xa4, 0x20, 0x46, 0x7a, 0x8e, 0x87, 0xaf, 0xab,
+	0x9a, 0xfc, 0x5e, 0x81, 0xf5, 0xc0, 0x13, 0xe8, 0xcd, 0x9f, 0x40, 0x4a, 0x78, 0x29, 0x28, 0x22,
+	0xe7, 0xbb, 0x13, 0x13, 0x04, 0x8a, 0xbf, 0xbe, 0x8a, 0x71, 0x1f, 0x8a, 0x82, 0x97, 0xb5, 0x68,
+	0x08, 0x5d, 0x9c, 0x04, 0xd4, 0x0d, 0xb8, 0x22, 0x9c, 0x17, 0xdc, 0xaa, 0xda, 0x87, 0xf5, 0x60,
+	0x02, 0x95, 0x7f, 0x09, 0x29, 0xe1, 0x77, 0x79, 0xb8, 0xd7, 0x14, 0x70, 0x08, 0xa9, 0x6a, 0x90,
+	0xdf, 0xa7, 0x8e, 0x65, 0x3b, 0xdd, 0x4b, 0x75, 0xc7, 0x2a, 0x85, 0x1b, 0x67, 0xe4, 0xd1, 0xce,
+	0xa7, 0x90, 0x0e, 0x3a, 0x41, 0xbc, 0x86, 0xed, 0x73, 0xc3, 0x37, 0x2c, 0xa6, 0x33, 0x85, 0x3c,
+	0xd4, 0x57, 0xff, 0x10, 0x83, 0xcd, 0x45, 0x82, 0xcb, 0x3a, 0x6e, 0x41, 0xde, 0x58, 0x84, 0xbc,
+	0x46, 0x98, 0x4d, 0xe2, 0xc2, 0x9c, 0xf7, 0x2e, 0x6b, 0x8e, 0x26, 0x33, 0x4a, 0xd3, 0xe1, 0xde,
+	0x38, 0x20, 0x8c, 0x44, 0x22, 0x95, 0xb9, 0xaa, 0x9c, 0x09, 0xcb, 0x61, 0x50, 0xcc, 0x2a, 0xb0,
+	0xe6, 0xf7, 0x54, 0x5d, 0x93, 0xb5, 0x3a, 0x2e, 0x93, 0x5d, 0xd5, 0x15, 0xc3, 0xef, 0xb3, 0x1e,
+	0x9b, 0xac, 0xee, 0x32, 0x5e, 0xfc, 0x00, 0xb2, 0x91, 0x0d, 0x48, 0x0e, 0xe2, 0x47, 0x74, 0x8c,
+	0x6e, 0xf6, 0xff, 0xfa, 0xae, 0x3f, 0x31, 0x8f, 0x87, 0xc1, 0x69, 0xe4, 0xe0, 0xc3, 0xd8, 0xfb,
+	0x8a, 0xfa, 0x00, 0xae, 0x4a, 0x86, 0xe9, 0xce, 0xa1, 0x1b, 0xdc, 0xd4, 0xed, 0x59, 0x76, 0x2d,
+	0xc8, 0xec, 0x21, 0xdd, 0xfe, 0xae, 0x00, 0x89, 0x02, 0xa0, 0x57, 0xdf, 0x60, 0x4a, 0x7f, 0x08,
+	0x59, 0xac, 0x3e, 0xb6, 0x73, 0xe8, 0x62, 0x88, 0x95, 0x16, 0x36, 0x79, 0x53, 0xbb, 0xa0, 0x1d,
+	0xfe, 0x57, 0xef, 0xc1, 0x56, 0x5d, 0x56, 0x45, 0x11, 0x69, 0xb2, 0x35, 0x5d, 0x4e, 0xd2, 0xf7,
+	0xa0, 0xb8, 0x48, 0x25, 0xa4, 0x50, 0xf0, 0x10, 0xf0, 0x95, 0x12, 0xc1, 0x43, 0x40, 0xbd, 0x85,
+	0x91, 0xa7, 0x37, 0x96, 0xa3, 0x3f, 0x83, 0x8d, 0x50, 0x0e, 0x21, 0x1f, 0x84, 0xac, 0x5c, 0xab,
+	0x69, 0xe8, 0xfc, 0x5b, 0x11, 0x07, 0xe2, 0x83, 0x5a, 0xfe, 0xdc, 0x65, 0xd6, 0x11, 0xbe, 0xb7,
+	0x75, 0x87, 0x8b, 0x37, 0xe6, 0x5d, 0xb8, 0xfe, 0xd8, 0xe4, 0xf4, 0x85, 0x79, 0xa9, 0xd6, 0x50,
+	0xdd, 0x81, 0xfc, 0xbc, 0xf8, 0x85, 0x89, 0xa6, 0x0e, 0x1b, 0xb5, 0x31, 0xa7, 0x1d, 0xd7, 0xa2,
+	0xcb, 0x0b, 0x48, 0xd1, 0x0f, 0x63, 0x87, 0x7b, 0x66, 0x27, 0xe8, 0x73, 0xc2, 0xb1, 0xaa, 0x41,
+	0x6e, 0x0a, 0x82, 0x5b, 0x16, 0x21, 0xdd, 0xc6, 0x39, 0x04, 0x0a, 0xc7, 0xea, 0xaf, 0x80, 0x34,
+	0x8d, 0xfa, 0xce, 0xbb, 0xa2, 0x33, 0xba, 0xa0, 0x70, 0xdd, 0x8b, 0x44, 0xf0, 0xfa, 0xce, 0xcd,
+	0x45, 0x0c, 0x11, 0x30, 0x07, 0xe3, 0x01, 0x95, 0x01, 0xee, 0xb7, 0xd3, 0xd7, 0x66, 0xf0, 0xc3,
+	0x47, 0x45, 0x8a, 0x8b, 0x19, 0xcc, 0x43, 0x77, 0x17, 0x96, 0x83, 0xb3, 0x8a, 0x72, 0x83, 0x20,
+	0xe2, 0x25, 0x44, 0xf1, 0xc7, 0x90, 0x14, 0xd3, 0xd3, 0x2f, 0x17, 0x4a, 0xf4, 0xcb, 0x45, 0x1e,
+	0x52, 0x6c, 0xdc, 0x6f, 0xbb, 0xc7, 0x41, 0x53, 0x28, 0x47, 0x2a, 0x85, 0x9c, 0x50, 0x8b, 0x06,
+	0xea, 0xe2, 0x83, 0xe7, 0x67, 0xbe, 0x88, 0x3c, 0x59, 0x09, 0x90, 0x0b, 0x21, 0x72, 0x1c, 0x17,
+	0x70, 0x5c, 0xcb, 0xc0, 0xea, 0xa1, 0xed, 0x58, 0xad, 0xf6, 0x58, 0xfd, 0xaf, 0x02, 0x57, 0x23,
+	0xfb, 0xa0, 0x03, 0x16, 0x9b, 0xfa, 0x11, 0xac, 0x5a, 0x94, 0x9b, 0xf6, 0x71, 0xd0, 0x5e, 0x55,
+	0xce, 0x75, 0x72, 0x43, 0xca, 0x05, 0x0f, 0x2c, 0x54, 0x8b, 0xd2, 0x2b, 0xbe, 0xa4, 0x99, 0x4d,
+	0xcc, 0x35, 0xb3, 0xa4, 0x0c, 0x59, 0x9b, 0xb5, 0xe8, 0x88, 0x53, 0xcf, 0x31, 0x8f, 0x45, 0x4a,
+	0x4c, 0x1b, 0x60, 0xb3, 0x26, 0xce, 0x90, 0x6d, 0xc8, 0x61, 0x96, 0xf0, 0x79, 0xd3, 0xea, 0x99,
+	0xac, 0x87, 0x5f, 0x1e, 0xb0, 0x77, 0xad, 0xbb, 0x16, 0xf5, 0x7b, 0x5b, 0xf5, 0x37, 0x90, 0x14,
+	0xcf, 0x7a, 0x7f, 0xc7, 0xe9, 0xa3, 0x41, 0x54, 0xc6, 0x68, 0xdb, 0x5f, 0x80, 0x55, 0xd9, 0x9d,
+	0xcb, 0x07, 0x45, 0xc6, 0x08, 0x86, 0xcb, 0x1f, 0x04, 0xa4, 0x04, 0xc0, 0xec, 0xae, 0x63, 0xf2,
+	0xa1, 0x47, 0x59, 0x21, 0x21, 0x54, 0x23, 0x33, 0xef, 0x7c, 0xa5, 0x40, 0x26, 0xa4, 0x21, 0xb9,
+	0x03, 0xf9, 0x83, 0x9f, 0xed, 0x36, 0xf7, 0x5a, 0x07, 0xcf, 0xf7, 0x9b, 0xad, 0x4f, 0xf7, 0x3e,
+	0xd9, 0x6f, 0xd6, 0xf5, 0x8f, 0xf5, 0x66, 0x23, 0xb7, 0x52, 0xdc, 0xf8, 0xf2, 0xeb, 0x4a, 0xf6,
+	0x53, 0x87, 0x0d, 0x68, 0xc7, 0x3e, 0xb4, 0xa9, 0x45, 0x7e, 0x00, 0xd7, 0x22, 0xc2, 0xfa, 0xde,
+	0x41, 0xd3, 0xd8, 0x7b, 0xf4, 0xd3, 0x9c, 0x52, 0x5c, 0xfb, 0xf2, 0xeb, 0x4a, 0x5a, 0x77, 0xd0,
+	0x15, 0xb3, 0x62, 0xcd, 0x5f, 0xa0, 0x58, 0x4c, 0x8a, 0x05, 0x1e, 0x2b, 0xa6, 0x7f, 0xf7, 0xe7,
+	0xd2, 0xca, 0x5f, 0xff, 0x52, 0x52, 0x6a, 0x7b, 0x2f, 0xff, 0x53, 0x5a, 0x79, 0x79, 0x5a, 0x52,
+	0xbe, 0x39, 0x2d, 0x29, 0xff, 0x3e, 0x2d, 0x29, 0x5f, 0xbd, 0x2a, 0xad, 0x7c, 0xf3, 0xaa, 0xb4,
+	0xf2, 0xaf, 0x57, 0xa5, 0x95, 0xcf, 0xdf, 0xbd, 0x64, 0x1e, 0xf7, 0x3f, 0x06, 0x8a, 0xa4, 0xd4,
+	0x4e, 0x89, 0x2f, 0x7b, 0x3f, 0xfa, 0xdf, 0x00, 0xfc, 0x43, 0x40, 0xc7, 0x71, 0x14, 0x00, 0x00,
 }
 
 func (m *DepositQueryParams) Marshal() (dAtA []byte, err error) {
@@ -1567,6 +1878,11 @@ func (m *BatchedCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Proof != nil {
 		{
 			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
@@ -1631,7 +1947,7 @@ func (m *BatchedCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *KeyAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommandBatchesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1641,22 +1957,49 @@ func (m *KeyAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KeyAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommandBatchesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KeyAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommandBatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.KeyID) > 0 {
 		i -= len(m.KeyID)
 		copy(dAtA[i:], m.KeyID)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Chain) > 0 {
 		i -= len(m.Chain)
@@ -1668,7 +2011,7 @@ func (m *KeyAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *KeyAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *CommandBatchesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1678,27 +2021,32 @@ func (m *KeyAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KeyAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommandBatchesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KeyAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommandBatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Threshold)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1706,16 +2054,134 @@ func (m *KeyAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.KeyID) > 0 {
-		i -= len(m.KeyID)
-		copy(dAtA[i:], m.KeyID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
-		i--
-		dAtA[i] = 0xa
-	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedCommandsByCommandRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedCommandsByCommandRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedCommandsByCommandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommandId) > 0 {
+		i -= len(m.CommandId)
+		copy(dAtA[i:], m.CommandId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CommandId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1981,6 +2447,123 @@ func (m *EventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBurnerAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2334,7 +2917,7 @@ func (m *ConfirmationHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *GatewayAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *ChainIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2344,12 +2927,12 @@ func (m *GatewayAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GatewayAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GatewayAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2364,7 +2947,7 @@ func (m *GatewayAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GatewayAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *ChainIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2374,27 +2957,30 @@ func (m *GatewayAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GatewayAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GatewayAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *BytecodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *GatewayAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2404,23 +2990,16 @@ func (m *BytecodeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BytecodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GatewayAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BytecodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GatewayAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.Chain) > 0 {
 		i -= len(m.Chain)
 		copy(dAtA[i:], m.Chain)
@@ -2431,7 +3010,7 @@ func (m *BytecodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BytecodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *GatewayAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2441,21 +3020,88 @@ func (m *BytecodeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BytecodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GatewayAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BytecodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GatewayAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Bytecode) > 0 {
-		i -= len(m.Bytecode)
-		copy(dAtA[i:], m.Bytecode)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bytecode)))
-		i--
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BytecodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BytecodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BytecodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BytecodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BytecodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BytecodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bytecode) > 0 {
+		i -= len(m.Bytecode)
+		copy(dAtA[i:], m.Bytecode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bytecode)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -2856,6 +3502,75 @@ func (m *BatchedCommandsResponse) Size() (n int) {
 		l = m.Proof.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CommandBatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CommandBatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchedCommandsByCommandRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CommandId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3004,6 +3719,57 @@ func (m *EventResponse) Size() (n int) {
 	return n
 }
 
+func (m *EventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBurnerAddressResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3154,6 +3920,30 @@ func (m *ConfirmationHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *ChainIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *GatewayAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3896,6 +4686,25 @@ func (m *BatchedCommandsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3917,7 +4726,7 @@ func (m *BatchedCommandsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *CommandBatchesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3940,10 +4749,10 @@ func (m *KeyAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandBatchesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandBatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3978,7 +4787,26 @@ func (m *KeyAddressRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BatchedCommandsStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
@@ -4010,19 +4838,93 @@ func (m *KeyAddressRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
 			}
-			iNdEx += skippy
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -4031,7 +4933,7 @@ func (m *KeyAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *CommandBatchesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4054,17 +4956,17 @@ func (m *KeyAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandBatchesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandBatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4074,27 +4976,29 @@ func (m *KeyAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.Batches = append(m.Batches, BatchedCommandsResponse{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4121,42 +5025,12 @@ func (m *KeyAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, KeyAddressResponse_WeightedAddress{})
-			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4179,7 +5053,7 @@ func (m *KeyAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAddressResponse_WeightedAddress) Unmarshal(dAtA []byte) error {
+func (m *BatchedCommandsByCommandRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4202,15 +5076,15 @@ func (m *KeyAddressResponse_WeightedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchedCommandsByCommandRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchedCommandsByCommandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4238,11 +5112,11 @@ func (m *KeyAddressResponse_WeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4270,7 +5144,7 @@ func (m *KeyAddressResponse_WeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.CommandId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4293,7 +5167,7 @@ func (m *KeyAddressResponse_WeightedAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *KeyAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4316,15 +5190,15 @@ func (m *QueryTokenAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4352,13 +5226,13 @@ func (m *QueryTokenAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4368,12 +5242,24 @@ func (m *QueryTokenAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Confirmed = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4395,7 +5281,7 @@ func (m *QueryTokenAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositStateParams) Unmarshal(dAtA []byte) error {
+func (m *KeyAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4418,17 +5304,17 @@ func (m *QueryDepositStateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositStateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositStateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4438,30 +5324,29 @@ func (m *QueryDepositStateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4471,25 +5356,58 @@ func (m *QueryDepositStateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Addresses = append(m.Addresses, KeyAddressResponse_WeightedAddress{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4511,7 +5429,7 @@ func (m *QueryDepositStateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositStateRequest) Unmarshal(dAtA []byte) error {
+func (m *KeyAddressResponse_WeightedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4534,15 +5452,15 @@ func (m *DepositStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4570,13 +5488,13 @@ func (m *DepositStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4586,27 +5504,23 @@ func (m *DepositStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &QueryDepositStateParams{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4629,7 +5543,7 @@ func (m *DepositStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4652,17 +5566,422 @@ func (m *DepositStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositStateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositStateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositStateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &QueryDepositStateParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DepositStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.Status = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4672,11 +5991,56 @@ func (m *DepositStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= DepositStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4698,7 +6062,93 @@ func (m *DepositStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRequest) Unmarshal(dAtA []byte) error {
+func (m *EventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4711,25 +6161,108 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Event_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4757,11 +6290,11 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
+			m.DestinationChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4789,7 +6322,43 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventId = string(dAtA[iNdEx:postIndex])
+			m.TxID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4812,7 +6381,7 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventResponse) Unmarshal(dAtA []byte) error {
+func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4835,15 +6404,15 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4870,10 +6439,44 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &Event{}
+			m.Events = append(m.Events, Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5922,6 +7525,171 @@ func (m *ConfirmationHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChainIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GatewayAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
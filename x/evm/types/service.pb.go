@@ -35,79 +35,86 @@ func init() {
 }
 
 var fileDescriptor_69cc2ee75499a0b3 = []byte{
-	// 1142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0x3b, 0x08, 0xaa, 0x32, 0x84, 0xa6, 0x8c, 0x28, 0x08, 0xa7, 0x72, 0x92, 0x6d, 0xbe,
-	0x53, 0x7b, 0xf3, 0xc1, 0x87, 0xe8, 0xad, 0x49, 0xcb, 0x87, 0xca, 0x67, 0xd2, 0x13, 0x17, 0x6b,
-	0xbd, 0xfb, 0xb2, 0x5e, 0xd9, 0x9e, 0x71, 0x67, 0x26, 0x89, 0xad, 0x2a, 0x42, 0xaa, 0x90, 0xe0,
-	0x50, 0x41, 0x25, 0x2e, 0x1c, 0x8a, 0xd4, 0x0b, 0x37, 0x0e, 0xfc, 0x09, 0x3d, 0x72, 0xac, 0xc4,
-	0x85, 0x23, 0x4a, 0xf8, 0x17, 0xb8, 0xa3, 0x9d, 0x9d, 0xb1, 0x77, 0x37, 0x93, 0x89, 0x7b, 0x8b,
-	0xf5, 0x7e, 0xb3, 0xef, 0xb7, 0x3b, 0xf3, 0xde, 0xbc, 0xe0, 0x99, 0xa0, 0x0f, 0x9d, 0x80, 0xfb,
-	0x70, 0xd0, 0xf5, 0x0f, 0xd6, 0x9b, 0x20, 0x83, 0x75, 0x5f, 0x00, 0x3f, 0x48, 0x42, 0xa8, 0xf7,
-	0x38, 0x93, 0x8c, 0x90, 0x8c, 0xa8, 0xc3, 0x41, 0xb7, 0xae, 0x89, 0xca, 0x9b, 0x31, 0x8b, 0x99,
-	0x0a, 0xfb, 0xe9, 0x5f, 0x19, 0x59, 0xb9, 0x16, 0x33, 0x16, 0x77, 0xc0, 0x0f, 0x7a, 0x89, 0x1f,
-	0x50, 0xca, 0x64, 0x20, 0x13, 0x46, 0x85, 0x8e, 0x4e, 0x59, 0x32, 0xc9, 0xbe, 0x0e, 0x56, 0x2d,
-	0xc1, 0xfb, 0xfb, 0xc0, 0x07, 0x59, 0x7c, 0xe3, 0xd7, 0x49, 0x8c, 0x3f, 0x17, 0xf1, 0x6e, 0x66,
-	0x46, 0xbe, 0xc5, 0x78, 0x17, 0xe4, 0xc7, 0x81, 0x84, 0xc3, 0x60, 0x40, 0xe6, 0xeb, 0xa7, 0x15,
-	0xeb, 0xa3, 0xf8, 0x0e, 0xdc, 0xdf, 0x07, 0x21, 0x2b, 0x0b, 0xe7, 0x61, 0xa2, 0xc7, 0xa8, 0x00,
-	0xcf, 0x7b, 0xf8, 0xd7, 0xbf, 0x3f, 0xbf, 0x74, 0xcd, 0x7b, 0xdb, 0xcf, 0x49, 0x09, 0x90, 0x8d,
-	0x38, 0x03, 0x6f, 0xa2, 0x15, 0xf2, 0x0b, 0xc2, 0x57, 0xb6, 0x19, 0xdd, 0x4b, 0x78, 0x57, 0x2f,
-	0xbf, 0xd7, 0x27, 0xab, 0xb6, 0x04, 0x65, 0xca, 0xd8, 0xdc, 0x18, 0x0f, 0xd6, 0x4e, 0xcb, 0xca,
-	0xe9, 0xba, 0x57, 0xcd, 0x3b, 0x85, 0x19, 0x6d, 0xbc, 0x1a, 0xb2, 0x9f, 0xaa, 0xed, 0xe1, 0x97,
-	0x3f, 0x4b, 0x68, 0x9b, 0x4c, 0xdb, 0x12, 0xa4, 0x11, 0x63, 0x30, 0x73, 0x36, 0xa0, 0xb3, 0x4e,
-	0xa9, 0xac, 0x57, 0xbd, 0x2b, 0xf9, 0xac, 0x9d, 0x84, 0xb6, 0xd3, 0x3c, 0xdf, 0x23, 0x3c, 0xa1,
-	0x7d, 0xef, 0xb1, 0x36, 0x50, 0xb2, 0xe8, 0x78, 0x23, 0x45, 0x98, 0xc4, 0x4b, 0xe7, 0x83, 0x5a,
-	0x60, 0x4e, 0x09, 0x54, 0xbd, 0x77, 0x6c, 0xaf, 0x2d, 0x53, 0x34, 0x35, 0xf9, 0x09, 0xe1, 0xcb,
-	0x7a, 0xf9, 0x6d, 0xe8, 0x31, 0x91, 0x48, 0xb2, 0xec, 0x48, 0xa1, 0x19, 0x63, 0xb3, 0x32, 0x0e,
-	0xaa, 0x7d, 0x16, 0x94, 0xcf, 0x8c, 0x37, 0x65, 0xf3, 0x89, 0x32, 0x38, 0x35, 0x7a, 0x8a, 0x30,
-	0x31, 0x2f, 0xc4, 0x03, 0x2a, 0xf6, 0x80, 0xdf, 0x85, 0x01, 0xa9, 0xb9, 0x5e, 0x7c, 0xc4, 0x19,
-	0xb3, 0xfa, 0xb8, 0xb8, 0xb6, 0x5b, 0x55, 0x76, 0xf3, 0xde, 0x8c, 0xf5, 0x6b, 0xe9, 0x05, 0x8d,
-	0x36, 0xa8, 0x13, 0xfc, 0x04, 0xe1, 0x37, 0xb6, 0x39, 0x04, 0x12, 0x6e, 0x43, 0xaf, 0xc3, 0x06,
-	0xd9, 0x1e, 0xda, 0x4f, 0x65, 0x19, 0x33, 0x82, 0xb5, 0x31, 0x69, 0xed, 0xb7, 0xa2, 0xfc, 0xe6,
-	0xbc, 0xe9, 0x82, 0x9f, 0xc2, 0xd3, 0x8f, 0xd7, 0x61, 0x83, 0xd1, 0x9e, 0xaa, 0x02, 0x53, 0xa1,
-	0xad, 0x7d, 0x4e, 0xd5, 0x73, 0xc4, 0x19, 0x05, 0x56, 0xa2, 0xdc, 0x05, 0x76, 0x0a, 0x76, 0x16,
-	0x58, 0xe6, 0xd6, 0xdc, 0xe7, 0x34, 0x33, 0x13, 0xa9, 0xda, 0x1f, 0x08, 0xbf, 0x95, 0x3d, 0xe7,
-	0x2b, 0xa0, 0x51, 0x42, 0x63, 0xb3, 0x17, 0x82, 0xac, 0x9f, 0x9d, 0xb3, 0xcc, 0x1a, 0xcd, 0x8d,
-	0x17, 0x59, 0xa2, 0x65, 0x7d, 0x25, 0xbb, 0xec, 0xcd, 0x59, 0x64, 0x7b, 0xd9, 0xa2, 0xe1, 0x7e,
-	0x2b, 0xe5, 0x67, 0x08, 0x57, 0xb2, 0x67, 0x9a, 0x87, 0x7d, 0xd9, 0x03, 0x1e, 0x48, 0xc6, 0x45,
-	0x2b, 0xe9, 0x91, 0xf7, 0xce, 0x76, 0xb0, 0xf1, 0x46, 0xfd, 0xfd, 0x17, 0x5d, 0xa6, 0xf5, 0x37,
-	0x95, 0x7e, 0xcd, 0x5b, 0xb2, 0xe8, 0x0f, 0x8f, 0x29, 0xcb, 0xad, 0x34, 0xed, 0x66, 0x37, 0x89,
-	0xe9, 0x36, 0xeb, 0x76, 0x03, 0x1a, 0x09, 0x7b, 0xbb, 0xc9, 0x13, 0xce, 0x76, 0x53, 0x04, 0x5d,
-	0xed, 0x46, 0x24, 0x31, 0x6d, 0x84, 0x1a, 0x4d, 0x4d, 0x0e, 0xf1, 0xa5, 0x5b, 0x51, 0xb4, 0xdd,
-	0x0a, 0x12, 0x4a, 0xae, 0xdb, 0x9e, 0x6d, 0xa2, 0x46, 0x60, 0xce, 0x0d, 0xe9, 0xe4, 0x33, 0x2a,
-	0x79, 0xc5, 0xbb, 0x9a, 0x4f, 0x1e, 0x44, 0x51, 0x23, 0x4c, 0x31, 0x53, 0x13, 0x3b, 0x20, 0xf9,
-	0xe0, 0xa3, 0x20, 0xe9, 0x40, 0x74, 0xe7, 0x00, 0xa8, 0xb4, 0xd7, 0x44, 0x99, 0x72, 0xd6, 0xc4,
-	0x69, 0xd8, 0x55, 0x13, 0x3c, 0xa5, 0x6b, 0x7b, 0x0a, 0xaf, 0x41, 0xca, 0xdf, 0x44, 0x2b, 0x1b,
-	0xff, 0xbd, 0x8e, 0x27, 0xbe, 0x4e, 0xef, 0x6b, 0x73, 0x43, 0xff, 0x86, 0xf0, 0xe4, 0x56, 0x20,
-	0xc3, 0x16, 0x44, 0xc3, 0x1d, 0xb3, 0x76, 0xda, 0x12, 0x64, 0x4c, 0x57, 0xc7, 0x62, 0xb5, 0xe8,
-	0x87, 0x4a, 0x74, 0x93, 0xac, 0xfb, 0x96, 0x31, 0xa2, 0x99, 0x2d, 0x1a, 0x6e, 0xa1, 0xff, 0x40,
-	0x7d, 0xd0, 0x23, 0xff, 0x41, 0x12, 0x1d, 0x91, 0xef, 0x10, 0xc6, 0x69, 0x3b, 0x00, 0xfe, 0x29,
-	0xdd, 0x63, 0xf6, 0x51, 0x62, 0x14, 0x77, 0x8e, 0x12, 0x79, 0x4c, 0x8b, 0x2d, 0x2a, 0xb1, 0x59,
-	0x32, 0x6d, 0x15, 0x53, 0x7c, 0x23, 0x49, 0xf3, 0xfe, 0x3e, 0xba, 0x30, 0xd4, 0xd0, 0xf4, 0x09,
-	0x24, 0x71, 0x4b, 0x3a, 0x2f, 0x8c, 0x1c, 0x37, 0xce, 0x85, 0x51, 0xc0, 0xb5, 0xde, 0x07, 0x4a,
-	0x6f, 0x9d, 0xf8, 0x36, 0xbd, 0x30, 0xb7, 0xae, 0xd1, 0x52, 0x0b, 0xcd, 0xa7, 0x23, 0x8f, 0x10,
-	0x9e, 0xd0, 0x77, 0xe3, 0xae, 0x0c, 0x24, 0xd8, 0x8b, 0x31, 0x4f, 0x38, 0x8b, 0xb1, 0x08, 0x16,
-	0x4f, 0x1f, 0x99, 0xb5, 0xc9, 0xe9, 0xbb, 0xb6, 0x21, 0x54, 0xf6, 0xa7, 0x08, 0x4f, 0xea, 0x66,
-	0xe9, 0x3e, 0x6c, 0x25, 0xc8, 0x79, 0xd8, 0x4e, 0xb1, 0xda, 0xeb, 0x5d, 0xe5, 0x55, 0x27, 0x37,
-	0x6c, 0x5e, 0xa6, 0xfb, 0x96, 0x0f, 0x1b, 0x11, 0xf8, 0xa2, 0x2a, 0x77, 0x41, 0x66, 0xad, 0x9b,
-	0xa4, 0x62, 0xc6, 0xc7, 0x73, 0x21, 0xc5, 0x29, 0x95, 0x54, 0xac, 0x7b, 0x97, 0xa5, 0x7a, 0x84,
-	0x30, 0xbe, 0x0b, 0x83, 0x5b, 0x51, 0xc4, 0x41, 0x08, 0xfb, 0xe1, 0x1e, 0xc5, 0x9d, 0x87, 0x3b,
-	0x8f, 0x15, 0x6f, 0x21, 0xb2, 0x68, 0x33, 0x68, 0xc3, 0xa0, 0x11, 0x64, 0x0b, 0x86, 0xdf, 0xe0,
-	0x09, 0xc2, 0x97, 0xf5, 0x68, 0x6b, 0x94, 0xac, 0x73, 0x5a, 0x91, 0x71, 0xce, 0x69, 0x65, 0xb4,
-	0x78, 0xc3, 0x90, 0x55, 0x9b, 0x9a, 0x19, 0x97, 0xcb, 0x7a, 0x3f, 0x22, 0x7c, 0x69, 0x6b, 0x20,
-	0x21, 0x64, 0x11, 0xd8, 0x1b, 0xbb, 0x89, 0x3a, 0x1b, 0xfb, 0x08, 0x1a, 0xa7, 0xca, 0x9a, 0x9a,
-	0x1e, 0x75, 0xa5, 0x90, 0x51, 0xc9, 0x83, 0x50, 0x1e, 0x91, 0x87, 0x08, 0xbf, 0x92, 0x35, 0x79,
-	0xeb, 0xa8, 0x5e, 0xe8, 0xec, 0xb3, 0x0e, 0x62, 0x9c, 0x83, 0xab, 0x3a, 0xf9, 0x48, 0x42, 0xfd,
-	0x6c, 0xa4, 0x0d, 0xf2, 0x31, 0xc2, 0xaf, 0xdd, 0xd9, 0xd9, 0xde, 0x58, 0xd3, 0x33, 0x98, 0xf5,
-	0x74, 0xe4, 0x00, 0x23, 0xb4, 0x78, 0x2e, 0xa7, 0xb5, 0xd6, 0x94, 0xd6, 0x0a, 0x59, 0xb2, 0x6a,
-	0xf1, 0x70, 0x63, 0x4d, 0xcf, 0x5e, 0xc3, 0x8d, 0xfa, 0x01, 0xe1, 0x57, 0xd5, 0x43, 0x54, 0xcb,
-	0xb6, 0x6e, 0xc2, 0x30, 0x6c, 0x74, 0xe6, 0xcf, 0xa1, 0xb4, 0x4c, 0x5d, 0xc9, 0x2c, 0x91, 0x05,
-	0x9b, 0x8c, 0xd2, 0x50, 0xfd, 0xda, 0xa8, 0x6c, 0x7d, 0xf1, 0xe7, 0x71, 0x15, 0x3d, 0x3f, 0xae,
-	0xa2, 0x7f, 0x8e, 0xab, 0xe8, 0xf1, 0x49, 0xf5, 0xc2, 0xb3, 0x93, 0x2a, 0x7a, 0x7e, 0x52, 0xbd,
-	0xf0, 0xf7, 0x49, 0xf5, 0xc2, 0x37, 0x6b, 0x71, 0x22, 0x5b, 0xfb, 0xcd, 0x7a, 0xc8, 0xba, 0xfa,
-	0x79, 0x14, 0xe4, 0x21, 0xe3, 0x6d, 0xfd, 0xab, 0x16, 0x32, 0x0e, 0x7e, 0x5f, 0x25, 0x91, 0x83,
-	0x1e, 0x88, 0xe6, 0x45, 0xf5, 0xef, 0xee, 0xe6, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x13,
-	0x61, 0xef, 0x97, 0x0f, 0x00, 0x00,
+	// 1252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x98, 0x49, 0x6f, 0x1c, 0xc5,
+	0x17, 0xc0, 0x5d, 0x7f, 0xfd, 0x31, 0xa1, 0xb0, 0xe2, 0x50, 0x22, 0x2c, 0xe3, 0x68, 0x6c, 0xb7,
+	0xf7, 0x65, 0xa6, 0xbd, 0xb0, 0x88, 0xdc, 0x62, 0x3b, 0x81, 0x28, 0x61, 0xb3, 0x73, 0xe2, 0xd2,
+	0xea, 0x99, 0x2e, 0xcf, 0xb4, 0x66, 0xa6, 0x6b, 0xd2, 0x55, 0x5e, 0x5a, 0x96, 0x05, 0x8a, 0x90,
+	0xe0, 0x80, 0x20, 0x12, 0x17, 0x0e, 0x20, 0xe5, 0xc2, 0x8d, 0x03, 0x1f, 0x21, 0x47, 0xc4, 0x29,
+	0x12, 0x17, 0x8e, 0x60, 0xf3, 0x41, 0x50, 0xbf, 0xae, 0xea, 0xe9, 0x6e, 0xd7, 0x94, 0x3b, 0xdc,
+	0xc6, 0xaa, 0x5f, 0xd5, 0xfb, 0xd5, 0xf6, 0x5e, 0xb5, 0xf1, 0x94, 0x7b, 0x4c, 0xbb, 0x6e, 0x68,
+	0xd3, 0xc3, 0x9e, 0x7d, 0xb8, 0xde, 0xa0, 0xc2, 0x5d, 0xb7, 0x39, 0x0d, 0x0f, 0xfd, 0x26, 0xad,
+	0xf7, 0x43, 0x26, 0x18, 0x21, 0x09, 0x51, 0xa7, 0x87, 0xbd, 0xba, 0x24, 0x2a, 0xaf, 0xb6, 0x58,
+	0x8b, 0x41, 0xb3, 0x1d, 0xff, 0x4a, 0xc8, 0xca, 0x8d, 0x16, 0x63, 0xad, 0x2e, 0xb5, 0xdd, 0xbe,
+	0x6f, 0xbb, 0x41, 0xc0, 0x84, 0x2b, 0x7c, 0x16, 0x70, 0xd9, 0x3a, 0xa1, 0x89, 0x24, 0x8e, 0x65,
+	0x63, 0x55, 0xd3, 0xf8, 0xf0, 0x80, 0x86, 0x51, 0xd2, 0xbe, 0xf1, 0xd3, 0x38, 0xc6, 0x1f, 0xf2,
+	0xd6, 0x5e, 0x62, 0x46, 0x3e, 0xc7, 0x78, 0x8f, 0x8a, 0xf7, 0x5d, 0x41, 0x8f, 0xdc, 0x88, 0xcc,
+	0xd5, 0x2f, 0x2a, 0xd6, 0x07, 0xed, 0xbb, 0xf4, 0xe1, 0x01, 0xe5, 0xa2, 0x32, 0x7f, 0x19, 0xc6,
+	0xfb, 0x2c, 0xe0, 0xd4, 0xb2, 0x1e, 0xfd, 0xf1, 0xcf, 0xf7, 0xff, 0xbb, 0x61, 0xbd, 0x6e, 0x67,
+	0xa4, 0x38, 0x15, 0x4e, 0x2b, 0x01, 0x6f, 0xa2, 0x65, 0xf2, 0x03, 0xc2, 0xd7, 0xb6, 0x59, 0xb0,
+	0xef, 0x87, 0x3d, 0xd9, 0xfd, 0xc1, 0x31, 0x59, 0xd1, 0x05, 0x28, 0x52, 0xca, 0x66, 0xb5, 0x1c,
+	0x2c, 0x9d, 0x96, 0xc0, 0x69, 0xc6, 0xaa, 0x66, 0x9d, 0x9a, 0x09, 0xad, 0xbc, 0x1c, 0x71, 0x1c,
+	0xab, 0xed, 0xe3, 0xff, 0xdf, 0xf7, 0x83, 0x0e, 0x99, 0xd4, 0x05, 0x88, 0x5b, 0x94, 0xc1, 0xd4,
+	0x70, 0x40, 0x46, 0x9d, 0x80, 0xa8, 0xd7, 0xad, 0x6b, 0xd9, 0xa8, 0x5d, 0x3f, 0xe8, 0xc4, 0x71,
+	0xbe, 0x42, 0x78, 0x4c, 0xfa, 0x3e, 0x60, 0x1d, 0x1a, 0x90, 0x05, 0xc3, 0x8c, 0x80, 0x50, 0x81,
+	0x17, 0x2f, 0x07, 0xa5, 0xc0, 0x2c, 0x08, 0x54, 0xad, 0x37, 0x75, 0xd3, 0x16, 0x31, 0x1a, 0x9b,
+	0x7c, 0x87, 0xf0, 0x55, 0xd9, 0x7d, 0x87, 0xf6, 0x19, 0xf7, 0x05, 0x59, 0x32, 0x84, 0x90, 0x8c,
+	0xb2, 0x59, 0x2e, 0x83, 0x4a, 0x9f, 0x79, 0xf0, 0x99, 0xb2, 0x26, 0x74, 0x3e, 0x5e, 0x02, 0xc7,
+	0x46, 0x4f, 0x10, 0x26, 0x6a, 0x42, 0xa1, 0x1b, 0xf0, 0x7d, 0x1a, 0xde, 0xa3, 0x11, 0xa9, 0x99,
+	0x26, 0x3e, 0xe0, 0x94, 0x59, 0xbd, 0x2c, 0x2e, 0xed, 0x56, 0xc0, 0x6e, 0xce, 0x9a, 0xd2, 0xae,
+	0x96, 0xec, 0xe0, 0x74, 0x28, 0x9c, 0xe0, 0x1f, 0x11, 0x7e, 0x65, 0x3b, 0xa4, 0xae, 0xa0, 0x3b,
+	0xb4, 0xdf, 0x65, 0x51, 0xb2, 0x87, 0xfa, 0x53, 0x59, 0xc4, 0x94, 0x60, 0xad, 0x24, 0x2d, 0xfd,
+	0x96, 0xc1, 0x6f, 0xd6, 0x9a, 0xcc, 0xf9, 0x01, 0x1e, 0x2f, 0x5e, 0x97, 0x45, 0x83, 0x3d, 0x85,
+	0x0b, 0x06, 0x4d, 0x5b, 0x07, 0x61, 0x00, 0xe3, 0xf0, 0x21, 0x17, 0xac, 0x40, 0x99, 0x2f, 0xd8,
+	0x05, 0xd8, 0x78, 0xc1, 0x12, 0xb7, 0xc6, 0x41, 0x18, 0x24, 0x66, 0x3c, 0x56, 0xfb, 0x15, 0xe1,
+	0xd7, 0x92, 0x71, 0x3e, 0xa1, 0x81, 0xe7, 0x07, 0x2d, 0xb5, 0x17, 0x9c, 0xac, 0x0f, 0x8f, 0x59,
+	0x64, 0x95, 0xe6, 0xc6, 0xf3, 0x74, 0x91, 0xb2, 0x36, 0xc8, 0x2e, 0x59, 0xb3, 0x1a, 0xd9, 0x7e,
+	0xd2, 0x29, 0xdd, 0x6f, 0x50, 0x7e, 0x8a, 0x70, 0x25, 0x19, 0x53, 0x0d, 0xf6, 0x71, 0x9f, 0x86,
+	0xae, 0x60, 0x21, 0x6f, 0xfb, 0x7d, 0xf2, 0xf6, 0x70, 0x07, 0x1d, 0xaf, 0xd4, 0xdf, 0x79, 0xde,
+	0x6e, 0x52, 0x7f, 0x13, 0xf4, 0x6b, 0xd6, 0xa2, 0x46, 0x3f, 0x3d, 0xa6, 0x2c, 0xd3, 0x53, 0xa5,
+	0x9b, 0x3d, 0xbf, 0x15, 0x6c, 0xb3, 0x5e, 0xcf, 0x0d, 0x3c, 0xae, 0x4f, 0x37, 0x59, 0xc2, 0x98,
+	0x6e, 0xf2, 0xa0, 0x29, 0xdd, 0x70, 0xbf, 0x15, 0x38, 0x4d, 0x89, 0xc6, 0x26, 0x47, 0xf8, 0xca,
+	0x2d, 0xcf, 0xdb, 0x6e, 0xbb, 0x7e, 0x40, 0x66, 0x74, 0x63, 0xab, 0x56, 0x25, 0x30, 0x6b, 0x86,
+	0x64, 0xf0, 0x29, 0x08, 0x5e, 0xb1, 0xae, 0x67, 0x83, 0xbb, 0x9e, 0xe7, 0x34, 0x63, 0x4c, 0xdd,
+	0x89, 0x5d, 0x2a, 0xc2, 0xe8, 0x8e, 0xeb, 0x77, 0xa9, 0x77, 0xfb, 0x90, 0x06, 0x42, 0x7f, 0x27,
+	0x8a, 0x94, 0xf1, 0x4e, 0x5c, 0x84, 0x4d, 0x77, 0x22, 0x8c, 0xe9, 0xda, 0x3e, 0xe0, 0x35, 0x1a,
+	0xf3, 0x37, 0xd1, 0xf2, 0xc6, 0xdf, 0x04, 0x8f, 0x7d, 0x1a, 0xd7, 0x6b, 0x55, 0xa1, 0x7f, 0x46,
+	0x78, 0x7c, 0xcb, 0x15, 0xcd, 0x36, 0xf5, 0xd2, 0x1d, 0xd3, 0x66, 0xda, 0x02, 0xa4, 0x4c, 0x57,
+	0x4a, 0xb1, 0x52, 0xf4, 0x3d, 0x10, 0xdd, 0x24, 0xeb, 0xb6, 0xe6, 0x19, 0xd1, 0x48, 0x3a, 0xa5,
+	0x5b, 0x68, 0x9f, 0xc0, 0x82, 0x9e, 0xda, 0x27, 0xbe, 0x77, 0x1a, 0xa7, 0xc1, 0xab, 0x72, 0xbc,
+	0x64, 0x74, 0x3e, 0xac, 0x76, 0x64, 0x99, 0x4b, 0x6a, 0x47, 0x1e, 0xcd, 0x9f, 0x7a, 0xb2, 0xa2,
+	0x93, 0x94, 0x72, 0x4e, 0x22, 0x9b, 0x3a, 0x92, 0xdf, 0x11, 0x7e, 0xa3, 0x30, 0xeb, 0xad, 0x48,
+	0xfe, 0x22, 0x9b, 0x25, 0xd6, 0x28, 0xa5, 0xff, 0xd3, 0xc2, 0xde, 0x07, 0xe7, 0x3b, 0x64, 0xa7,
+	0xcc, 0xc2, 0x3a, 0x8d, 0x48, 0xfd, 0x1e, 0xac, 0xb1, 0x9a, 0x58, 0xbc, 0xd6, 0x5f, 0x22, 0x8c,
+	0xe3, 0xd4, 0x4b, 0xc3, 0xbb, 0xc1, 0x3e, 0xd3, 0x3f, 0xdb, 0x06, 0xed, 0xc6, 0x67, 0x5b, 0x16,
+	0x93, 0xae, 0x0b, 0xe0, 0x3a, 0x4d, 0x26, 0xb5, 0xae, 0xc0, 0x3b, 0x7e, 0x1c, 0xf7, 0x97, 0x41,
+	0x71, 0x86, 0x07, 0xea, 0x07, 0xd4, 0x6f, 0xb5, 0x85, 0xb1, 0x38, 0x67, 0xb8, 0x32, 0xc5, 0x39,
+	0x87, 0x4b, 0xbd, 0x77, 0x41, 0x6f, 0x9d, 0xd8, 0xfa, 0xed, 0x1f, 0xf4, 0x73, 0xda, 0xd0, 0x31,
+	0x3d, 0x02, 0x5f, 0x20, 0xfc, 0x22, 0x64, 0x8a, 0xbb, 0x3b, 0xc4, 0xd2, 0x06, 0x4d, 0x1a, 0x95,
+	0xd8, 0x8c, 0x91, 0x91, 0x36, 0xab, 0x60, 0x33, 0x4f, 0x66, 0xb5, 0x36, 0x31, 0xec, 0xf8, 0xe9,
+	0x2e, 0x92, 0x6f, 0x10, 0x1e, 0x93, 0x4f, 0xa1, 0x3d, 0xe1, 0x0a, 0xaa, 0xcf, 0xbd, 0x59, 0xc2,
+	0x98, 0x7b, 0xf3, 0x60, 0x3e, 0xd9, 0x90, 0x69, 0x9d, 0x91, 0x7c, 0x5a, 0x39, 0x1c, 0xa2, 0x3f,
+	0x41, 0x78, 0x5c, 0xd6, 0x46, 0x73, 0x6e, 0x29, 0x40, 0xc6, 0x2b, 0x70, 0x81, 0x95, 0x5e, 0x6f,
+	0x81, 0x57, 0x9d, 0xac, 0xea, 0xbc, 0x54, 0xb1, 0x2d, 0xe6, 0x16, 0xc2, 0xf1, 0x28, 0x2c, 0x39,
+	0x27, 0xd3, 0x43, 0xb7, 0x23, 0xf5, 0xb1, 0x4c, 0x48, 0xfe, 0xa3, 0x84, 0x54, 0x86, 0x6e, 0x18,
+	0x8f, 0xb7, 0x09, 0xdf, 0xa3, 0xd1, 0x2d, 0xcf, 0x0b, 0x29, 0xe7, 0xfa, 0xfb, 0x35, 0x68, 0x37,
+	0xde, 0xaf, 0x2c, 0x96, 0x7f, 0x74, 0x90, 0x05, 0x9d, 0x41, 0x87, 0x46, 0x8e, 0x9b, 0x74, 0x48,
+	0xd7, 0x20, 0x4e, 0xad, 0xf2, 0x4b, 0x46, 0x29, 0x69, 0x53, 0x6b, 0x9e, 0x31, 0xa6, 0xd6, 0x22,
+	0x5a, 0x26, 0xb5, 0xaa, 0xaf, 0xa3, 0xa2, 0xde, 0xb7, 0x08, 0x5f, 0xd9, 0x8a, 0x04, 0x6d, 0x32,
+	0x8f, 0xea, 0xeb, 0xb8, 0x6a, 0x35, 0xd6, 0xf1, 0x01, 0x54, 0xe6, 0xa2, 0x37, 0x24, 0x9d, 0x4d,
+	0x90, 0x81, 0x08, 0xdd, 0xa6, 0x38, 0x25, 0x8f, 0x10, 0x7e, 0x21, 0xa9, 0xe9, 0xda, 0x2f, 0xb3,
+	0x5c, 0x21, 0x9f, 0x36, 0x10, 0x65, 0x0e, 0x2e, 0x14, 0xee, 0x81, 0x04, 0xfc, 0x09, 0x39, 0xfa,
+	0x04, 0x8f, 0xc2, 0x30, 0x43, 0x0e, 0x6e, 0xd2, 0x66, 0x3c, 0xb8, 0x0a, 0xc9, 0x3f, 0xfa, 0x89,
+	0x35, 0x54, 0x63, 0xb0, 0x25, 0x8f, 0x11, 0x7e, 0xf9, 0xf6, 0xee, 0xf6, 0xc6, 0x9a, 0x7c, 0xef,
+	0x6b, 0x8f, 0x66, 0x06, 0x50, 0x1e, 0x0b, 0x97, 0x72, 0x52, 0x66, 0x0d, 0x64, 0x96, 0xc9, 0xa2,
+	0x56, 0x26, 0x6c, 0x6e, 0xac, 0xc9, 0x77, 0x7e, 0xaa, 0xf4, 0x35, 0xc2, 0x2f, 0xc1, 0x20, 0x50,
+	0xb2, 0xb4, 0x27, 0x20, 0x6d, 0x56, 0x3a, 0x73, 0x97, 0x50, 0x52, 0xa6, 0x0e, 0x32, 0x8b, 0x64,
+	0x5e, 0x27, 0x03, 0x1a, 0x50, 0xaf, 0x94, 0xca, 0xd6, 0x47, 0xbf, 0x9d, 0x55, 0xd1, 0xb3, 0xb3,
+	0x2a, 0xfa, 0xeb, 0xac, 0x8a, 0x1e, 0x9f, 0x57, 0x47, 0x9e, 0x9e, 0x57, 0xd1, 0xb3, 0xf3, 0xea,
+	0xc8, 0x9f, 0xe7, 0xd5, 0x91, 0xcf, 0xd6, 0x5a, 0xbe, 0x68, 0x1f, 0x34, 0xea, 0x4d, 0xd6, 0x93,
+	0xe3, 0x05, 0x54, 0x1c, 0xb1, 0xb0, 0x23, 0xff, 0xaa, 0x35, 0x59, 0x48, 0xed, 0x63, 0x08, 0x22,
+	0xa2, 0x3e, 0xe5, 0x8d, 0x51, 0xf8, 0xd7, 0xca, 0xe6, 0xbf, 0x03, 0x00, 0x1f, 0x42, 0xd7, 0x77,
+	0x03, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -630,10 +637,17 @@ type QueryServiceClient interface {
 	// BatchedCommandsID if no BatchedCommandsID is specified, then it returns the
 	// latest batched commands
 	BatchedCommands(ctx context.Context, in *BatchedCommandsRequest, opts ...grpc.CallOption) (*BatchedCommandsResponse, error)
+	// CommandBatches queries the command batches for the specified chain
+	CommandBatches(ctx context.Context, in *CommandBatchesRequest, opts ...grpc.CallOption) (*CommandBatchesResponse, error)
+	// BatchedCommandsByCommand queries the batched commands that contain the
+	// specified command
+	BatchedCommandsByCommand(ctx context.Context, in *BatchedCommandsByCommandRequest, opts ...grpc.CallOption) (*BatchedCommandsResponse, error)
 	// BurnerInfo queries the burner info for the specified address
 	BurnerInfo(ctx context.Context, in *BurnerInfoRequest, opts ...grpc.CallOption) (*BurnerInfoResponse, error)
 	// ConfirmationHeight queries the confirmation height for the specified chain
 	ConfirmationHeight(ctx context.Context, in *ConfirmationHeightRequest, opts ...grpc.CallOption) (*ConfirmationHeightResponse, error)
+	// ChainID queries the ID of the network the specified chain is connected to
+	ChainID(ctx context.Context, in *ChainIDRequest, opts ...grpc.CallOption) (*ChainIDResponse, error)
 	// DepositState queries the state of the specified deposit
 	DepositState(ctx context.Context, in *DepositStateRequest, opts ...grpc.CallOption) (*DepositStateResponse, error)
 	// PendingCommands queries the pending commands for the specified chain
@@ -648,6 +662,8 @@ type QueryServiceClient interface {
 	Bytecode(ctx context.Context, in *BytecodeRequest, opts ...grpc.CallOption) (*BytecodeResponse, error)
 	// Event queries an event at the specified chain
 	Event(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EventResponse, error)
+	// Events queries the events at the specified chain
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (*EventsResponse, error)
 	// ERC20Tokens queries the ERC20 tokens registered for a chain
 	ERC20Tokens(ctx context.Context, in *ERC20TokensRequest, opts ...grpc.CallOption) (*ERC20TokensResponse, error)
 	// TokenInfo queries the token info for a registered ERC20 Token
@@ -671,6 +687,24 @@ func (c *queryServiceClient) BatchedCommands(ctx context.Context, in *BatchedCom
 	return out, nil
 }
 
+func (c *queryServiceClient) CommandBatches(ctx context.Context, in *CommandBatchesRequest, opts ...grpc.CallOption) (*CommandBatchesResponse, error) {
+	out := new(CommandBatchesResponse)
+	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/CommandBatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) BatchedCommandsByCommand(ctx context.Context, in *BatchedCommandsByCommandRequest, opts ...grpc.CallOption) (*BatchedCommandsResponse, error) {
+	out := new(BatchedCommandsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/BatchedCommandsByCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) BurnerInfo(ctx context.Context, in *BurnerInfoRequest, opts ...grpc.CallOption) (*BurnerInfoResponse, error) {
 	out := new(BurnerInfoResponse)
 	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/BurnerInfo", in, out, opts...)
@@ -689,6 +723,15 @@ func (c *queryServiceClient) ConfirmationHeight(ctx context.Context, in *Confirm
 	return out, nil
 }
 
+func (c *queryServiceClient) ChainID(ctx context.Context, in *ChainIDRequest, opts ...grpc.CallOption) (*ChainIDResponse, error) {
+	out := new(ChainIDResponse)
+	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/ChainID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) DepositState(ctx context.Context, in *DepositStateRequest, opts ...grpc.CallOption) (*DepositStateResponse, error) {
 	out := new(DepositStateResponse)
 	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/DepositState", in, out, opts...)
@@ -752,6 +795,15 @@ func (c *queryServiceClient) Event(ctx context.Context, in *EventRequest, opts .
 	return out, nil
 }
 
+func (c *queryServiceClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/Events", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) ERC20Tokens(ctx context.Context, in *ERC20TokensRequest, opts ...grpc.CallOption) (*ERC20TokensResponse, error) {
 	out := new(ERC20TokensResponse)
 	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/ERC20Tokens", in, out, opts...)
@@ -776,10 +828,17 @@ type QueryServiceServer interface {
 	// BatchedCommandsID if no BatchedCommandsID is specified, then it returns the
 	// latest batched commands
 	BatchedCommands(context.Context, *BatchedCommandsRequest) (*BatchedCommandsResponse, error)
+	// CommandBatches queries the command batches for the specified chain
+	CommandBatches(context.Context, *CommandBatchesRequest) (*CommandBatchesResponse, error)
+	// BatchedCommandsByCommand queries the batched commands that contain the
+	// specified command
+	BatchedCommandsByCommand(context.Context, *BatchedCommandsByCommandRequest) (*BatchedCommandsResponse, error)
 	// BurnerInfo queries the burner info for the specified address
 	BurnerInfo(context.Context, *BurnerInfoRequest) (*BurnerInfoResponse, error)
 	// ConfirmationHeight queries the confirmation height for the specified chain
 	ConfirmationHeight(context.Context, *ConfirmationHeightRequest) (*ConfirmationHeightResponse, error)
+	// ChainID queries the ID of the network the specified chain is connected to
+	ChainID(context.Context, *ChainIDRequest) (*ChainIDResponse, error)
 	// DepositState queries the state of the specified deposit
 	DepositState(context.Context, *DepositStateRequest) (*DepositStateResponse, error)
 	// PendingCommands queries the pending commands for the specified chain
@@ -794,6 +853,8 @@ type QueryServiceServer interface {
 	Bytecode(context.Context, *BytecodeRequest) (*BytecodeResponse, error)
 	// Event queries an event at the specified chain
 	Event(context.Context, *EventRequest) (*EventResponse, error)
+	// Events queries the events at the specified chain
+	Events(context.Context, *EventsRequest) (*EventsResponse, error)
 	// ERC20Tokens queries the ERC20 tokens registered for a chain
 	ERC20Tokens(context.Context, *ERC20TokensRequest) (*ERC20TokensResponse, error)
 	// TokenInfo queries the token info for a registered ERC20 Token
@@ -807,12 +868,21 @@ type UnimplementedQueryServiceServer struct {
 func (*UnimplementedQueryServiceServer) BatchedCommands(ctx context.Context, req *BatchedCommandsRequest) (*BatchedCommandsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchedCommands not implemented")
 }
+func (*UnimplementedQueryServiceServer) CommandBatches(ctx context.Context, req *CommandBatchesRequest) (*CommandBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommandBatches not implemented")
+}
+func (*UnimplementedQueryServiceServer) BatchedCommandsByCommand(ctx context.Context, req *BatchedCommandsByCommandRequest) (*BatchedCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchedCommandsByCommand not implemented")
+}
 func (*UnimplementedQueryServiceServer) BurnerInfo(ctx context.Context, req *BurnerInfoRequest) (*BurnerInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnerInfo not implemented")
 }
 func (*UnimplementedQueryServiceServer) ConfirmationHeight(ctx context.Context, req *ConfirmationHeightRequest) (*ConfirmationHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmationHeight not implemented")
 }
+func (*UnimplementedQueryServiceServer) ChainID(ctx context.Context, req *ChainIDRequest) (*ChainIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainID not implemented")
+}
 func (*UnimplementedQueryServiceServer) DepositState(ctx context.Context, req *DepositStateRequest) (*DepositStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositState not implemented")
 }
@@ -834,6 +904,9 @@ func (*UnimplementedQueryServiceServer) Bytecode(ctx context.Context, req *Bytec
 func (*UnimplementedQueryServiceServer) Event(ctx context.Context, req *EventRequest) (*EventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Event not implemented")
 }
+func (*UnimplementedQueryServiceServer) Events(ctx context.Context, req *EventsRequest) (*EventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
 func (*UnimplementedQueryServiceServer) ERC20Tokens(ctx context.Context, req *ERC20TokensRequest) (*ERC20TokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ERC20Tokens not implemented")
 }
@@ -863,6 +936,42 @@ func _QueryService_BatchedCommands_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_CommandBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).CommandBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.evm.v1beta1.QueryService/CommandBatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).CommandBatches(ctx, req.(*CommandBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_BatchedCommandsByCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchedCommandsByCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).BatchedCommandsByCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.evm.v1beta1.QueryService/BatchedCommandsByCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).BatchedCommandsByCommand(ctx, req.(*BatchedCommandsByCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_BurnerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BurnerInfoRequest)
 	if err := dec(in); err != nil {
@@ -899,6 +1008,24 @@ func _QueryService_ConfirmationHeight_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_ChainID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ChainID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.evm.v1beta1.QueryService/ChainID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ChainID(ctx, req.(*ChainIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_DepositState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DepositStateRequest)
 	if err := dec(in); err != nil {
@@ -1025,6 +1152,24 @@ func _QueryService_Event_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_Events_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Events(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.evm.v1beta1.QueryService/Events",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Events(ctx, req.(*EventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_ERC20Tokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ERC20TokensRequest)
 	if err := dec(in); err != nil {
@@ -1069,6 +1214,14 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BatchedCommands",
 			Handler:    _QueryService_BatchedCommands_Handler,
 		},
+		{
+			MethodName: "CommandBatches",
+			Handler:    _QueryService_CommandBatches_Handler,
+		},
+		{
+			MethodName: "BatchedCommandsByCommand",
+			Handler:    _QueryService_BatchedCommandsByCommand_Handler,
+		},
 		{
 			MethodName: "BurnerInfo",
 			Handler:    _QueryService_BurnerInfo_Handler,
@@ -1077,6 +1230,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmationHeight",
 			Handler:    _QueryService_ConfirmationHeight_Handler,
 		},
+		{
+			MethodName: "ChainID",
+			Handler:    _QueryService_ChainID_Handler,
+		},
 		{
 			MethodName: "DepositState",
 			Handler:    _QueryService_DepositState_Handler,
@@ -1105,6 +1262,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Event",
 			Handler:    _QueryService_Event_Handler,
 		},
+		{
+			MethodName: "Events",
+			Handler:    _QueryService_Events_Handler,
+		},
 		{
 			MethodName: "ERC20Tokens",
 			Handler:    _QueryService_ERC20Tokens_Handler,
@@ -327,6 +327,8 @@ func RandomParams() types.Params {
 		CommandsGasLimit:    uint32(rand.I64Between(0, 10000000)),
 		EndBlockerLimit:     rand.PosI64(),
 		TransferLimit:       uint64(rand.PosI64()),
+		SignRetryLimit:      uint32(rand.I64Between(0, 10)),
+		SignRetryBackoff:    rand.I64Between(1, 100),
 	}
 
 	params.Network = params.Networks[int(rand.I64Between(0, int64(len(params.Networks))))].Name
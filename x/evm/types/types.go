@@ -212,11 +212,11 @@ func (t *ERC20Token) CreateMintCommand(keyID multisig.KeyID, transfer nexus.Cros
 		return Command{}, err
 	}
 
-	return CreateMintTokenCommand(keyID, transferIDtoCommandID(transfer.ID), t.metadata.Details.Symbol, common.HexToAddress(transfer.Recipient.Address), transfer.Asset.Amount.BigInt())
+	return CreateMintTokenCommand(keyID, TransferIDToCommandID(transfer.ID), t.metadata.Details.Symbol, common.HexToAddress(transfer.Recipient.Address), transfer.Asset.Amount.BigInt())
 }
 
-// transferIDtoCommandID converts a transferID to a commandID
-func transferIDtoCommandID(transferID nexus.TransferID) CommandID {
+// TransferIDToCommandID converts a transferID to the ID of the command that mints the transferred assets
+func TransferIDToCommandID(transferID nexus.TransferID) CommandID {
 	var commandID CommandID
 	copy(commandID[:], common.LeftPadBytes(transferID.Bytes(), 32)[:32])
 
@@ -491,6 +491,14 @@ func GetSignHash(commandData []byte) common.Hash {
 	return crypto.Keccak256Hash([]byte(msg))
 }
 
+// NewApproveContractCallCommandID returns the ID of the command that approves the contract call of the given source event
+func NewApproveContractCallCommandID(chainID sdk.Int, sourceTxID Hash, sourceEventIndex uint64) CommandID {
+	sourceEventIndexBz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(sourceEventIndexBz, sourceEventIndex)
+
+	return NewCommandID(append(sourceTxID.Bytes(), sourceEventIndexBz...), chainID)
+}
+
 // CreateApproveContractCallCommand creates a command to approve contract call
 func CreateApproveContractCallCommand(
 	chainID sdk.Int,
@@ -505,11 +513,8 @@ func CreateApproveContractCallCommand(
 		return Command{}, err
 	}
 
-	sourceEventIndexBz := make([]byte, 8)
-	binary.LittleEndian.PutUint64(sourceEventIndexBz, sourceEventIndex)
-
 	return Command{
-		ID:         NewCommandID(append(sourceTxID.Bytes(), sourceEventIndexBz...), chainID),
+		ID:         NewApproveContractCallCommandID(chainID, sourceTxID, sourceEventIndex),
 		Command:    AxelarGatewayCommandApproveContractCall,
 		Params:     params,
 		KeyID:      keyID,
@@ -533,11 +538,8 @@ func CreateApproveContractCallWithMintCommand(
 		return Command{}, err
 	}
 
-	sourceEventIndexBz := make([]byte, 8)
-	binary.LittleEndian.PutUint64(sourceEventIndexBz, sourceEventIndex)
-
 	return Command{
-		ID:         NewCommandID(append(sourceTxID.Bytes(), sourceEventIndexBz...), chainID),
+		ID:         NewApproveContractCallCommandID(chainID, sourceTxID, sourceEventIndex),
 		Command:    AxelarGatewayCommandApproveContractCallWithMint,
 		Params:     params,
 		KeyID:      keyID,
@@ -884,6 +886,21 @@ func (b CommandBatch) GetSigHash() Hash {
 
 }
 
+// GetHeight returns the block height at which the batch was created
+func (b CommandBatch) GetHeight() int64 {
+	return b.metadata.Height
+}
+
+// GetSignRetries returns the number of times signing of the batch was retried automatically
+func (b CommandBatch) GetSignRetries() uint32 {
+	return b.metadata.SignRetries
+}
+
+// GetSignRetryHeight returns the block height at which signing of the batch is retried automatically, 0 if no retry is scheduled
+func (b CommandBatch) GetSignRetryHeight() int64 {
+	return b.metadata.SignRetryHeight
+}
+
 // GetCommandIDs returns the IDs of the commands included in the batch
 func (b CommandBatch) GetCommandIDs() []CommandID {
 	return b.metadata.CommandIDs
@@ -929,6 +946,51 @@ func (b *CommandBatch) SetSigned(signature codec.ProtoMarshaler) error {
 	return nil
 }
 
+// ScheduleSignRetry schedules an automatic signing retry of the aborted batch at the given block height
+func (b *CommandBatch) ScheduleSignRetry(height int64) error {
+	if b.metadata.Status != BatchAborted {
+		return fmt.Errorf("command batch %s is not aborted", hex.EncodeToString(b.GetID()))
+	}
+
+	b.metadata.SignRetryHeight = height
+	b.setter(b.metadata)
+
+	return nil
+}
+
+// SetSignRetried sets the aborted batch to be signed again and increments its retry count
+func (b *CommandBatch) SetSignRetried() error {
+	if b.metadata.Status != BatchAborted {
+		return fmt.Errorf("command batch %s is not aborted", hex.EncodeToString(b.GetID()))
+	}
+
+	b.metadata.Status = BatchSigning
+	b.metadata.SignRetries++
+	b.metadata.SignRetryHeight = 0
+	b.setter(b.metadata)
+
+	return nil
+}
+
+// SetSignRetries sets the retry count of the batch, e.g. when it replaces an aborted batch whose retries it continues
+func (b *CommandBatch) SetSignRetries(retries uint32) {
+	b.metadata.SignRetries = retries
+	b.setter(b.metadata)
+}
+
+// SetSignRetryFailed increments the retry count of the aborted batch and clears its scheduled retry
+func (b *CommandBatch) SetSignRetryFailed() error {
+	if b.metadata.Status != BatchAborted {
+		return fmt.Errorf("command batch %s is not aborted", hex.EncodeToString(b.GetID()))
+	}
+
+	b.metadata.SignRetries++
+	b.metadata.SignRetryHeight = 0
+	b.setter(b.metadata)
+
+	return nil
+}
+
 // NewCommandBatchMetadata assembles a CommandBatchMetadata struct from the provided arguments
 func NewCommandBatchMetadata(blockHeight int64, chainID sdk.Int, keyID multisig.KeyID, cmds []Command) (CommandBatchMetadata, error) {
 	var commandIDs []CommandID
@@ -956,6 +1018,7 @@ func NewCommandBatchMetadata(blockHeight int64, chainID sdk.Int, keyID multisig.
 		SigHash:    Hash(GetSignHash(data)),
 		Status:     BatchSigning,
 		KeyID:      keyID,
+		Height:     blockHeight,
 	}, nil
 }
 
@@ -1459,6 +1522,43 @@ func (m Event) GetEventType() string {
 	return getType(m.GetEvent())
 }
 
+// EventTypeNames are the names of the event types events can be queried by
+var EventTypeNames = []string{"token_sent", "contract_call", "contract_call_with_token", "transfer", "token_deployed", "multisig_operatorship_transferred"}
+
+// GetEventTypeName returns the name of the event field of the event, e.g. token_sent or contract_call
+func (m Event) GetEventTypeName() string {
+	switch m.GetEvent().(type) {
+	case *Event_TokenSent:
+		return "token_sent"
+	case *Event_ContractCall:
+		return "contract_call"
+	case *Event_ContractCallWithToken:
+		return "contract_call_with_token"
+	case *Event_Transfer:
+		return "transfer"
+	case *Event_TokenDeployed:
+		return "token_deployed"
+	case *Event_MultisigOperatorshipTransferred:
+		return "multisig_operatorship_transferred"
+	default:
+		return ""
+	}
+}
+
+// GetDestinationChain returns the destination chain of the event if the event is sent to another chain
+func (m Event) GetDestinationChain() (nexus.ChainName, bool) {
+	switch event := m.GetEvent().(type) {
+	case *Event_TokenSent:
+		return event.TokenSent.DestinationChain, true
+	case *Event_ContractCall:
+		return event.ContractCall.DestinationChain, true
+	case *Event_ContractCallWithToken:
+		return event.ContractCallWithToken.DestinationChain, true
+	default:
+		return "", false
+	}
+}
+
 // ValidateBasic returns an error if the event token sent is invalid
 func (m EventTokenSent) ValidateBasic() error {
 	if m.Sender.IsZeroAddress() {
@@ -942,6 +942,14 @@ type CommandBatchMetadata struct {
 	KeyID                 github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
 	PrevBatchedCommandsID []byte                                                         `protobuf:"bytes,7,opt,name=prev_batched_commands_id,json=prevBatchedCommandsId,proto3" json:"prev_batched_commands_id,omitempty"`
 	Signature             *types.Any                                                     `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// height is the block height at which the batch was created, it is 0 for
+	// batches created before the height was recorded
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// sign_retries is the number of times signing was retried automatically
+	SignRetries uint32 `protobuf:"varint,10,opt,name=sign_retries,json=signRetries,proto3" json:"sign_retries,omitempty"`
+	// sign_retry_height is the block height at which signing of the aborted
+	// batch is retried automatically, 0 if no retry is scheduled
+	SignRetryHeight int64 `protobuf:"varint,11,opt,name=sign_retry_height,json=signRetryHeight,proto3" json:"sign_retry_height,omitempty"`
 }
 
 func (m *CommandBatchMetadata) Reset()         { *m = CommandBatchMetadata{} }
@@ -1245,154 +1253,157 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/types.proto", fileDescriptor_ea224848ef0a2f28) }
 
 var fileDescriptor_ea224848ef0a2f28 = []byte{
-	// 2351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3d, 0x6c, 0x23, 0xc7,
-	0xf5, 0xe7, 0xae, 0xf8, 0xf9, 0xf8, 0x61, 0x7a, 0x2c, 0x9d, 0x79, 0xfc, 0xff, 0x43, 0xae, 0xd7,
-	0x3e, 0x4b, 0x77, 0xf1, 0x91, 0xb6, 0x1c, 0x27, 0x4e, 0x90, 0xd8, 0x16, 0x3f, 0x4e, 0x5a, 0xdd,
-	0x89, 0x12, 0x96, 0x3c, 0x7f, 0x15, 0x21, 0x86, 0xdc, 0x39, 0x72, 0x21, 0x72, 0x97, 0xd8, 0x1d,
-	0x49, 0x64, 0xba, 0x34, 0x81, 0xc1, 0xca, 0x45, 0x8a, 0x00, 0x01, 0x81, 0x04, 0x49, 0x11, 0xa4,
-	0x49, 0x93, 0x00, 0x2e, 0x12, 0x20, 0x48, 0x65, 0xb8, 0x72, 0x97, 0x20, 0x05, 0x91, 0xe8, 0xba,
-	0xd4, 0xa9, 0xae, 0x49, 0xb0, 0xb3, 0x43, 0x72, 0x29, 0x91, 0x3a, 0xdd, 0xf9, 0x04, 0x18, 0x48,
-	0x45, 0xce, 0xce, 0x6f, 0xde, 0xfb, 0xbd, 0x37, 0x6f, 0xde, 0x9b, 0x79, 0x90, 0xc1, 0x7d, 0xd2,
-	0xc1, 0x56, 0x9e, 0x1c, 0x77, 0xf3, 0xc7, 0x6f, 0x34, 0x08, 0xc5, 0x6f, 0xe4, 0xe9, 0xa0, 0x47,
-	0xec, 0x5c, 0xcf, 0x32, 0xa9, 0x89, 0x90, 0x3b, 0x9f, 0x23, 0xc7, 0xdd, 0x1c, 0x9f, 0x4f, 0x5f,
-	0x6f, 0x99, 0x66, 0xab, 0x43, 0xf2, 0x0c, 0xd1, 0x38, 0x7a, 0x90, 0xc7, 0xc6, 0xc0, 0x85, 0xa7,
-	0x57, 0x5b, 0x66, 0xcb, 0x64, 0x7f, 0xf3, 0xce, 0x3f, 0xfe, 0xf5, 0x7a, 0xd3, 0xb4, 0xbb, 0xa6,
-	0x5d, 0x77, 0x27, 0xdc, 0x01, 0x9f, 0xba, 0xc9, 0xf5, 0x1b, 0xa4, 0x7f, 0x64, 0xe7, 0x49, 0xbf,
-	0x67, 0x5a, 0x94, 0x68, 0x8b, 0xa8, 0xa4, 0x5f, 0xe3, 0xd0, 0xee, 0x51, 0x87, 0xea, 0xb6, 0xde,
-	0xba, 0x10, 0x2d, 0xff, 0x42, 0x00, 0x78, 0xdf, 0xa4, 0xa4, 0x7c, 0x4c, 0x0c, 0x6a, 0xa3, 0x8f,
-	0x20, 0xd0, 0x6c, 0x63, 0xdd, 0x48, 0x09, 0x92, 0xb0, 0x11, 0x29, 0x14, 0x1f, 0x8d, 0xb3, 0xef,
-	0xb6, 0x74, 0xda, 0x3e, 0x6a, 0xe4, 0x9a, 0x66, 0x37, 0xef, 0x8a, 0x36, 0x08, 0x3d, 0x31, 0xad,
-	0x43, 0x3e, 0xba, 0xdd, 0x34, 0x2d, 0x92, 0xef, 0x9f, 0xa1, 0x96, 0x2b, 0x3a, 0x62, 0x2a, 0xb8,
-	0x4b, 0x54, 0x57, 0x22, 0xfa, 0x0e, 0x04, 0x09, 0x53, 0x92, 0x12, 0xa5, 0x95, 0x8d, 0xe8, 0xe6,
-	0xf5, 0xdc, 0x79, 0x9f, 0xe5, 0x18, 0x8d, 0x82, 0xff, 0xf3, 0x71, 0xd6, 0xa7, 0x72, 0xb8, 0xfc,
-	0x45, 0x18, 0x02, 0xec, 0xfb, 0x55, 0xb2, 0xbb, 0x09, 0x01, 0xda, 0xaf, 0xeb, 0x5a, 0x4a, 0x94,
-	0x84, 0x8d, 0x58, 0x61, 0xd5, 0x61, 0xf0, 0xf7, 0x71, 0xd6, 0xbf, 0x83, 0xed, 0xf6, 0xe9, 0x38,
-	0xeb, 0xaf, 0xf5, 0x95, 0x92, 0xea, 0xa7, 0x7d, 0x45, 0x43, 0xab, 0x10, 0xd0, 0x0d, 0x8d, 0xf4,
-	0x53, 0x2b, 0x92, 0xb0, 0xe1, 0x57, 0xdd, 0x01, 0x7a, 0x1b, 0x82, 0x36, 0xc5, 0xf4, 0xc8, 0x4e,
-	0xf9, 0x25, 0x61, 0x23, 0xb1, 0x29, 0x2d, 0x35, 0x2f, 0x57, 0x65, 0x38, 0x95, 0xe3, 0x51, 0x11,
-	0x80, 0x9a, 0x87, 0xc4, 0xa8, 0xdb, 0xc4, 0xa0, 0xa9, 0x80, 0x24, 0x6c, 0x44, 0x37, 0xe5, 0xa5,
-	0xab, 0x6b, 0x0e, 0xb4, 0x4a, 0x0c, 0xba, 0xe3, 0x53, 0x23, 0x74, 0x32, 0x40, 0xf7, 0x20, 0xde,
-	0x34, 0x0d, 0x6a, 0xe1, 0x26, 0xad, 0x37, 0x71, 0xa7, 0x93, 0x0a, 0x32, 0x39, 0x37, 0x96, 0xca,
-	0x29, 0x72, 0x74, 0x11, 0x77, 0x3a, 0x3b, 0x3e, 0x35, 0xd6, 0xf4, 0x8c, 0x91, 0x0e, 0xa9, 0x39,
-	0x69, 0xf5, 0x13, 0x9d, 0xb6, 0xeb, 0x4c, 0x5b, 0x2a, 0xc4, 0x04, 0xe7, 0x2e, 0x25, 0xf8, 0x03,
-	0x9d, 0xb6, 0x19, 0xe1, 0x1d, 0x9f, 0xba, 0xd6, 0x5c, 0x34, 0x81, 0xde, 0x85, 0x30, 0xb5, 0xb0,
-	0x61, 0x3f, 0x20, 0x56, 0x2a, 0xcc, 0x44, 0xbf, 0xb4, 0xdc, 0x76, 0x0e, 0xdc, 0xf1, 0xa9, 0xd3,
-	0x45, 0x68, 0x1f, 0x12, 0xae, 0xfb, 0x34, 0xd2, 0xeb, 0x98, 0x03, 0xa2, 0xa5, 0x22, 0x4c, 0xcc,
-	0xab, 0x17, 0xbb, 0xb0, 0xc4, 0xd1, 0x3b, 0x3e, 0x35, 0x4e, 0xbd, 0x1f, 0xd0, 0x8f, 0x05, 0xc8,
-	0x4c, 0x0e, 0x4f, 0xdd, 0x3c, 0x31, 0x88, 0x65, 0xb7, 0xf5, 0x5e, 0x7d, 0xa2, 0xd0, 0x22, 0x5a,
-	0x0a, 0x98, 0x86, 0xb7, 0x96, 0x6a, 0xd8, 0xe3, 0xcb, 0xf7, 0x27, 0xab, 0x6b, 0xb3, 0xc5, 0x05,
-	0x31, 0x25, 0xec, 0xf8, 0xd4, 0xff, 0xef, 0x5e, 0x80, 0x41, 0x3f, 0x11, 0xe0, 0xa5, 0x19, 0x87,
-	0x1e, 0xb1, 0x30, 0x35, 0xcf, 0xd3, 0x88, 0x32, 0x1a, 0x6f, 0x3f, 0x9e, 0x86, 0x47, 0x80, 0x47,
-	0xcb, 0x8e, 0x4f, 0xcd, 0x76, 0x2f, 0x86, 0xc8, 0x9f, 0x09, 0x10, 0x74, 0xe3, 0x15, 0xbd, 0x06,
-	0xa8, 0x5a, 0xdb, 0xaa, 0xdd, 0xaf, 0xd6, 0xef, 0x57, 0xaa, 0x07, 0xe5, 0xa2, 0x72, 0x47, 0x29,
-	0x97, 0x92, 0xbe, 0xf4, 0xea, 0x70, 0x24, 0x25, 0x99, 0xbe, 0x8a, 0x69, 0x94, 0xfb, 0xba, 0x4d,
-	0x9d, 0x80, 0xdc, 0x80, 0x24, 0x47, 0x17, 0xf7, 0x2b, 0x77, 0x14, 0x75, 0xaf, 0x5c, 0x4a, 0x0a,
-	0x69, 0x34, 0x1c, 0x49, 0x89, 0x49, 0x98, 0x3c, 0xd0, 0xad, 0x2e, 0xd1, 0xe6, 0x90, 0x7b, 0x07,
-	0xf7, 0xca, 0xb5, 0x72, 0x29, 0x29, 0xce, 0x21, 0xbb, 0xbd, 0x0e, 0xa1, 0x44, 0x43, 0x32, 0xc4,
-	0x39, 0xf2, 0xce, 0x96, 0x72, 0xaf, 0x5c, 0x4a, 0xae, 0xa4, 0x9f, 0x1b, 0x8e, 0xa4, 0x28, 0x83,
-	0xdd, 0xc1, 0x7a, 0x87, 0x68, 0xe9, 0xf0, 0x27, 0xbf, 0xca, 0xf8, 0x7e, 0xf3, 0xeb, 0x8c, 0x50,
-	0x08, 0x41, 0x80, 0x65, 0x90, 0x5d, 0x7f, 0x38, 0x96, 0x8c, 0xef, 0xfa, 0xc3, 0xf1, 0x64, 0x42,
-	0xfe, 0x93, 0x08, 0x89, 0xf9, 0x73, 0x84, 0xd6, 0x21, 0x68, 0x13, 0x43, 0x23, 0x16, 0x4b, 0x2b,
-	0xb1, 0xc2, 0x73, 0xfc, 0xec, 0x87, 0xb6, 0x34, 0xcd, 0x22, 0xb6, 0x73, 0x50, 0xd9, 0x34, 0xea,
-	0xc1, 0xf3, 0x1a, 0xb1, 0xa9, 0x6e, 0x60, 0xaa, 0x9b, 0x46, 0xdd, 0x4d, 0x45, 0xe2, 0xb3, 0x4b,
-	0x45, 0x49, 0x8f, 0x74, 0xf6, 0x15, 0xe5, 0xe1, 0x05, 0xaf, 0x46, 0xec, 0x12, 0x62, 0x89, 0x27,
-	0xa2, 0x22, 0xcf, 0x14, 0xa7, 0x8a, 0xae, 0x41, 0xd0, 0x1e, 0x74, 0x1b, 0x66, 0x87, 0x65, 0xa1,
-	0x88, 0xca, 0x47, 0x68, 0x1b, 0x82, 0xb8, 0x6b, 0x1e, 0xf1, 0xfc, 0x12, 0x2b, 0xe4, 0xb9, 0x8d,
-	0xeb, 0x1e, 0xce, 0x6e, 0xc1, 0xe1, 0x3f, 0xb7, 0x6d, 0xed, 0x90, 0x17, 0x8a, 0xfb, 0xba, 0x41,
-	0x55, 0xbe, 0x5c, 0x1e, 0x8a, 0xf0, 0xfc, 0xb9, 0x63, 0xfe, 0x75, 0x76, 0xe1, 0x4d, 0x48, 0x4e,
-	0x53, 0xd9, 0xbc, 0xff, 0x9e, 0x9b, 0x7c, 0x9f, 0x38, 0x2f, 0x0f, 0xb1, 0x1e, 0x1e, 0x74, 0x4c,
-	0xac, 0xd5, 0xdb, 0xd8, 0x6e, 0x33, 0x17, 0xc6, 0x0a, 0x31, 0x6f, 0x29, 0x50, 0xa3, 0x1c, 0xe1,
-	0x0c, 0xe4, 0x7f, 0x8b, 0x90, 0x5e, 0x9e, 0xf3, 0xfe, 0x47, 0xbd, 0xe2, 0x89, 0xc1, 0xc0, 0x92,
-	0x18, 0x0c, 0x7e, 0xb5, 0x18, 0x1c, 0x40, 0x7c, 0xae, 0x1c, 0xa0, 0x2c, 0x88, 0xd4, 0x5c, 0xe6,
-	0x64, 0x91, 0x9a, 0x1e, 0xd5, 0xe2, 0x57, 0x53, 0xdd, 0x00, 0x74, 0xbe, 0x84, 0x78, 0x2c, 0x16,
-	0xe6, 0x2c, 0xfe, 0x16, 0xb8, 0xa5, 0x65, 0xea, 0x62, 0x71, 0x31, 0xc5, 0x18, 0x43, 0xf1, 0x91,
-	0xfc, 0x07, 0x11, 0x5e, 0x7a, 0x6c, 0x15, 0x41, 0x39, 0x80, 0x9e, 0x45, 0x78, 0x7d, 0x4a, 0x09,
-	0xd2, 0xca, 0x22, 0xc1, 0x91, 0x9e, 0x45, 0xdc, 0xd5, 0xe8, 0x7d, 0x48, 0xf4, 0x2c, 0x72, 0x5c,
-	0xa7, 0x6d, 0x8b, 0xd8, 0x6d, 0xb3, 0xa3, 0x3d, 0xad, 0x2b, 0xe2, 0x8e, 0x98, 0xda, 0x44, 0x8a,
-	0xc3, 0xc3, 0x20, 0x27, 0x13, 0x1e, 0x2b, 0x4b, 0x78, 0x18, 0xe4, 0x84, 0xf3, 0xa8, 0x41, 0xdc,
-	0xc1, 0xcf, 0x68, 0xf8, 0x9f, 0x8e, 0x46, 0xcc, 0x20, 0x27, 0x53, 0x16, 0xdf, 0x13, 0x53, 0x82,
-	0xfc, 0xa9, 0x08, 0xaf, 0x5c, 0xa6, 0xec, 0x39, 0xdb, 0xc2, 0x28, 0x4f, 0xa6, 0x97, 0xb1, 0x76,
-	0x54, 0x4c, 0x65, 0x5c, 0x0d, 0x71, 0x74, 0x00, 0x51, 0x47, 0xea, 0x09, 0xd1, 0x5b, 0x6d, 0x6a,
-	0xa7, 0x02, 0x8c, 0xc9, 0x13, 0xcb, 0x74, 0xb6, 0xe0, 0x03, 0x57, 0xc4, 0xae, 0x3f, 0x2c, 0x24,
-	0xc5, 0x5d, 0x7f, 0x58, 0x4c, 0xae, 0xc8, 0x18, 0xa2, 0x15, 0x37, 0x55, 0x28, 0xc6, 0x03, 0x13,
-	0x21, 0xf0, 0x1b, 0xb8, 0x4b, 0x78, 0x94, 0xb2, 0xff, 0xe8, 0x1d, 0x10, 0xa7, 0xb7, 0xde, 0x1c,
-	0xd7, 0xfb, 0xea, 0x25, 0xf4, 0x2a, 0x06, 0x55, 0x45, 0x5d, 0x93, 0xff, 0x28, 0x02, 0x14, 0x8e,
-	0x2c, 0x83, 0x58, 0x4c, 0xc5, 0xb7, 0x21, 0xd1, 0x60, 0xa3, 0x69, 0xcc, 0x2f, 0x39, 0x96, 0x71,
-	0x17, 0x36, 0xc9, 0x32, 0x4f, 0x75, 0x54, 0x16, 0x27, 0xce, 0x95, 0xab, 0x4c, 0x9c, 0xcb, 0x0a,
-	0xec, 0x2a, 0x04, 0xb0, 0x6d, 0x13, 0xca, 0x73, 0x9e, 0x3b, 0x40, 0x12, 0xf8, 0x6d, 0xdc, 0x99,
-	0x24, 0xbc, 0xf9, 0x9c, 0xc9, 0x66, 0xe4, 0xbf, 0x88, 0x10, 0x2b, 0xab, 0xc5, 0xcd, 0xd7, 0x4b,
-	0xa4, 0x67, 0xda, 0x3a, 0x9d, 0x3d, 0x44, 0x84, 0xc7, 0x3e, 0x44, 0x9e, 0x55, 0x56, 0x9b, 0x91,
-	0x5f, 0xf1, 0x92, 0x5f, 0xe8, 0x5c, 0xff, 0x55, 0x3a, 0xf7, 0x7c, 0xf0, 0x04, 0x2e, 0x13, 0x3c,
-	0xf2, 0xcf, 0x57, 0x00, 0x31, 0x27, 0xb2, 0xb4, 0xbc, 0x47, 0x28, 0xd6, 0x30, 0xc5, 0x33, 0xb3,
-	0x04, 0xaf, 0x59, 0x35, 0x08, 0x33, 0x53, 0x66, 0x8f, 0xbd, 0xef, 0x3e, 0x59, 0xd8, 0x9f, 0x8e,
-	0xb3, 0x21, 0xc6, 0x57, 0x29, 0xa9, 0x21, 0x26, 0x4a, 0xd1, 0xd0, 0x7b, 0x10, 0xd2, 0x08, 0xc5,
-	0x7a, 0xc7, 0xad, 0xa3, 0xd1, 0xc5, 0xef, 0x3f, 0x5e, 0x36, 0x18, 0x8e, 0xbf, 0x72, 0x27, 0xcb,
-	0xce, 0x9f, 0x00, 0xd7, 0xd5, 0x8f, 0x39, 0x01, 0x37, 0x20, 0x44, 0xfb, 0x6e, 0x61, 0x66, 0x91,
-	0x77, 0x26, 0xc8, 0x82, 0xb4, 0xcf, 0x6a, 0xf2, 0xe6, 0xf4, 0x75, 0x1a, 0x62, 0xaf, 0xd3, 0xf4,
-	0x22, 0x76, 0x67, 0xde, 0xa5, 0x59, 0x88, 0xea, 0x76, 0x9d, 0xf4, 0x29, 0xb1, 0x0c, 0xdc, 0x61,
-	0x8f, 0xb3, 0xb0, 0x0a, 0xba, 0x5d, 0xe6, 0x5f, 0x1c, 0x00, 0xdf, 0xae, 0xa6, 0xa9, 0x11, 0xf6,
-	0xec, 0x8a, 0xa9, 0xe0, 0x7e, 0x2a, 0x9a, 0x1a, 0xd9, 0xf5, 0x87, 0x83, 0xc9, 0x90, 0x7c, 0x00,
-	0x2f, 0xb0, 0xec, 0x8b, 0x9b, 0xce, 0x4e, 0x4f, 0x77, 0x47, 0x82, 0xa0, 0x85, 0x4f, 0xea, 0xb4,
-	0xcf, 0x23, 0x3d, 0x72, 0x3a, 0xce, 0x06, 0x54, 0x7c, 0x52, 0xfb, 0x50, 0x0d, 0x58, 0xf8, 0xa4,
-	0xd6, 0x47, 0x2f, 0x42, 0xa8, 0x77, 0xd4, 0xa8, 0x1f, 0x92, 0x81, 0xbb, 0x51, 0x6a, 0xb0, 0x77,
-	0xd4, 0xb8, 0x4b, 0x06, 0xf2, 0xbf, 0x04, 0x08, 0x15, 0xcd, 0x6e, 0x17, 0x1b, 0x1a, 0x5a, 0x67,
-	0xf9, 0xcb, 0x15, 0xf1, 0x22, 0xb7, 0x3d, 0xc2, 0x27, 0x95, 0xd2, 0xe9, 0x38, 0x2b, 0x2a, 0x25,
-	0x27, 0x51, 0xa1, 0x14, 0x84, 0x9a, 0xee, 0x67, 0xf7, 0x6a, 0xa5, 0x4e, 0x86, 0xce, 0x99, 0xee,
-	0x61, 0x0b, 0x77, 0xdd, 0xad, 0x73, 0xd4, 0xb0, 0x11, 0x6a, 0x40, 0xf0, 0x90, 0x0c, 0x9c, 0x38,
-	0x71, 0xb7, 0xe2, 0xae, 0xc3, 0xf0, 0x2e, 0x19, 0x28, 0xa5, 0x47, 0xe3, 0xec, 0x3b, 0x97, 0x0c,
-	0xff, 0x73, 0x6d, 0x98, 0x1c, 0x93, 0xa0, 0x06, 0x0e, 0xc9, 0x40, 0xd1, 0x90, 0x04, 0xb1, 0x2e,
-	0xee, 0xd7, 0x5b, 0xd8, 0xae, 0x37, 0x4d, 0xdb, 0x4d, 0x1f, 0x71, 0x15, 0xba, 0xb8, 0xbf, 0x8d,
-	0xed, 0xa2, 0x69, 0x53, 0xf9, 0x13, 0x3f, 0xac, 0x72, 0x7b, 0x0a, 0x98, 0x36, 0xdb, 0x53, 0x07,
-	0x5e, 0xf3, 0x58, 0x1e, 0xf4, 0x18, 0xfa, 0x1e, 0x44, 0xb9, 0x65, 0x75, 0x5d, 0x73, 0xbb, 0x2d,
-	0xb1, 0x42, 0x76, 0x91, 0x6b, 0x60, 0x3a, 0xb0, 0x55, 0xe0, 0x6b, 0x14, 0xcd, 0x76, 0xea, 0x84,
-	0xa3, 0x81, 0xbb, 0x83, 0xfd, 0x47, 0xeb, 0x10, 0x76, 0xde, 0xa2, 0x4b, 0xaf, 0x80, 0x21, 0x5b,
-	0x6f, 0xb1, 0x50, 0xdb, 0x9a, 0x86, 0x5a, 0x80, 0x85, 0xda, 0xcd, 0x45, 0xa1, 0xc6, 0x2c, 0x21,
-	0x1a, 0xd7, 0x6f, 0x9f, 0x89, 0xbc, 0x99, 0xe3, 0x83, 0x57, 0xe6, 0x78, 0x15, 0x52, 0xec, 0x3e,
-	0xd4, 0x70, 0x99, 0xd4, 0xb9, 0xf9, 0xb6, 0xa3, 0x35, 0xc4, 0xec, 0xbb, 0x7e, 0x3a, 0xce, 0xae,
-	0x1d, 0x58, 0xe4, 0xf8, 0x0c, 0x59, 0xa5, 0xa4, 0xae, 0xf5, 0x16, 0x7c, 0xd6, 0xd0, 0x0f, 0x21,
-	0x62, 0xeb, 0x2d, 0x03, 0xd3, 0x23, 0x8b, 0xf0, 0x66, 0xc6, 0x6a, 0xce, 0xed, 0x02, 0xe6, 0x26,
-	0x5d, 0xc0, 0xdc, 0x96, 0x31, 0x28, 0xdc, 0xfa, 0xe2, 0xf7, 0xb7, 0x17, 0x66, 0x1b, 0x8d, 0x34,
-	0xf3, 0x07, 0x0e, 0x72, 0x0f, 0x5b, 0x76, 0x1b, 0x77, 0x88, 0xa5, 0xce, 0x44, 0xca, 0x7f, 0x15,
-	0x20, 0x5a, 0xd5, 0x5b, 0xd3, 0x08, 0xc8, 0x83, 0xdf, 0xc9, 0x4a, 0x2c, 0x06, 0x12, 0x9b, 0xff,
-	0xb7, 0xf0, 0x4c, 0xeb, 0xad, 0xda, 0xa0, 0x47, 0x54, 0x06, 0x9c, 0x35, 0xd0, 0xc4, 0x67, 0xde,
-	0x40, 0xfb, 0xbe, 0xf3, 0xa2, 0x70, 0xa3, 0x8e, 0xb9, 0xd4, 0xf1, 0x23, 0x8b, 0x9f, 0x02, 0x3a,
-	0x1d, 0x67, 0x13, 0xde, 0x08, 0x56, 0x4a, 0x6a, 0xa2, 0xe9, 0x1d, 0x6b, 0xf2, 0xef, 0x04, 0x88,
-	0x4e, 0xae, 0x68, 0x77, 0xc9, 0xe0, 0x49, 0xaa, 0xa0, 0xe9, 0xdc, 0xa0, 0xfa, 0xb4, 0xce, 0x23,
-	0xc6, 0xad, 0xfe, 0xfb, 0xa7, 0xe3, 0x6c, 0xa4, 0x42, 0xfa, 0xf4, 0x59, 0x45, 0x4d, 0xc4, 0xe0,
-	0xc2, 0x34, 0x7e, 0xb5, 0x3a, 0x86, 0xc0, 0x16, 0xab, 0x27, 0x57, 0xd8, 0x94, 0x9c, 0xdc, 0xd7,
-	0xc4, 0xd9, 0x7d, 0x4d, 0xfe, 0x4c, 0x80, 0x98, 0xb7, 0x8c, 0xa0, 0x6f, 0x4c, 0xda, 0x87, 0x9e,
-	0xab, 0x9d, 0xdb, 0x18, 0x74, 0x44, 0x79, 0x2e, 0x2c, 0xe2, 0xdc, 0x85, 0xe5, 0x06, 0x84, 0x35,
-	0xd2, 0xd4, 0xbb, 0x98, 0x57, 0xac, 0x78, 0x21, 0xf2, 0x68, 0x9c, 0x0d, 0x1c, 0xe9, 0x06, 0x7d,
-	0x5b, 0x9d, 0x4e, 0xa1, 0x5d, 0x08, 0x37, 0x71, 0x0f, 0x37, 0x75, 0x3a, 0xe0, 0xc7, 0xfe, 0x49,
-	0x2f, 0x89, 0xd3, 0xf5, 0xf2, 0xcf, 0x44, 0x08, 0x6d, 0x63, 0x4a, 0x4e, 0xb0, 0xb3, 0xc1, 0xa1,
-	0xc7, 0x5c, 0x10, 0x27, 0xf3, 0xe8, 0x9d, 0x69, 0x42, 0x11, 0x59, 0x9c, 0x2f, 0xec, 0x8d, 0x72,
-	0xb9, 0xbc, 0x86, 0x15, 0xc4, 0x94, 0x30, 0xc9, 0x26, 0xf2, 0x2f, 0x67, 0x2d, 0xac, 0xdb, 0x4b,
-	0x5a, 0x58, 0x6b, 0xc3, 0x91, 0xf4, 0x3c, 0x17, 0xe1, 0x42, 0x2b, 0xa6, 0x41, 0xd0, 0x6b, 0x90,
-	0xe0, 0xf0, 0x83, 0x72, 0xa5, 0xa4, 0x54, 0xb6, 0x93, 0x42, 0x3a, 0x35, 0x1c, 0x49, 0xab, 0x73,
-	0xd0, 0x03, 0x62, 0x68, 0xba, 0xd1, 0x42, 0xaf, 0x2f, 0xe8, 0x78, 0x89, 0xe9, 0xf4, 0x70, 0x24,
-	0x5d, 0x9b, 0xc3, 0x4f, 0x3b, 0x5f, 0xb3, 0x5e, 0x95, 0xfc, 0x53, 0x01, 0x62, 0x07, 0x66, 0xa7,
-	0x33, 0x3d, 0xda, 0x5f, 0x8b, 0x56, 0xf7, 0xad, 0xdf, 0xce, 0x5c, 0xb7, 0xbe, 0xc4, 0x75, 0xac,
-	0x01, 0xe7, 0x6d, 0xfc, 0xcd, 0x80, 0x4a, 0x45, 0xa9, 0x29, 0x5b, 0xf7, 0x94, 0x8f, 0x59, 0xeb,
-	0x8f, 0x01, 0x15, 0x43, 0xa7, 0x3a, 0xee, 0xe8, 0x3f, 0x22, 0x1a, 0xca, 0x9e, 0xf3, 0xae, 0x98,
-	0x8e, 0x0e, 0x47, 0x52, 0x68, 0xe2, 0xd0, 0x97, 0x17, 0x38, 0xd4, 0x9f, 0x8e, 0x0f, 0x47, 0x52,
-	0x64, 0x81, 0x0f, 0x6f, 0xfd, 0x47, 0x80, 0xb5, 0x85, 0x75, 0x05, 0xfd, 0x00, 0x5e, 0x2e, 0x6c,
-	0xd5, 0x8a, 0x3b, 0xe5, 0x52, 0xbd, 0xb8, 0xbf, 0xb7, 0xb7, 0x55, 0x29, 0x55, 0xeb, 0xcb, 0x5b,
-	0x99, 0x4c, 0x86, 0xd7, 0xa2, 0xb7, 0x20, 0xbb, 0x6c, 0x79, 0x55, 0xd9, 0xae, 0xb8, 0x71, 0x91,
-	0x1c, 0x8e, 0xa4, 0x18, 0x5b, 0x5a, 0xd5, 0x5b, 0x86, 0x43, 0xff, 0x82, 0x65, 0x5b, 0x85, 0x7d,
-	0xd5, 0x6d, 0x73, 0xce, 0x96, 0x6d, 0x35, 0xd8, 0x7e, 0xa1, 0x37, 0x21, 0x73, 0x91, 0xb6, 0x59,
-	0xd7, 0x73, 0xaa, 0x8c, 0x68, 0x69, 0xbf, 0xe3, 0x85, 0x5b, 0x03, 0x08, 0xf1, 0x7c, 0x8f, 0x64,
-	0x58, 0xad, 0x2a, 0xdb, 0xf5, 0xda, 0x47, 0x07, 0xe5, 0x33, 0x36, 0x86, 0x87, 0x23, 0xc9, 0xcf,
-	0xc2, 0x3b, 0x0d, 0xd1, 0x29, 0xa6, 0xf6, 0x61, 0x52, 0x48, 0x47, 0x86, 0x23, 0x29, 0xe0, 0x48,
-	0xe8, 0xa3, 0x57, 0x20, 0x39, 0x9d, 0xe3, 0x34, 0x92, 0x62, 0x3a, 0x31, 0x1c, 0x49, 0x50, 0xd5,
-	0x5b, 0xdc, 0xbf, 0x1e, 0xe7, 0xff, 0x59, 0x80, 0x38, 0x7f, 0xc2, 0x70, 0xa7, 0x6f, 0x40, 0xba,
-	0x54, 0x3e, 0xd8, 0xaf, 0x2a, 0xb5, 0xc5, 0xbe, 0x9e, 0xf1, 0x58, 0x87, 0x6b, 0x67, 0x90, 0xb3,
-	0xe3, 0x36, 0x17, 0x10, 0xdf, 0x84, 0xd4, 0x19, 0xa0, 0xf7, 0xa4, 0xcd, 0x07, 0x06, 0xba, 0x01,
-	0x6b, 0x67, 0xc0, 0x85, 0xfb, 0xaa, 0xeb, 0x3e, 0x18, 0x8e, 0xa4, 0x20, 0x7b, 0xb4, 0xba, 0x26,
-	0x08, 0xac, 0x5f, 0x5c, 0xf9, 0xfc, 0x9f, 0x19, 0xdf, 0xe7, 0xa7, 0x19, 0xe1, 0xcb, 0xd3, 0x8c,
-	0xf0, 0x8f, 0xd3, 0x8c, 0xf0, 0xe9, 0xc3, 0x8c, 0xef, 0xcb, 0x87, 0x19, 0xdf, 0xdf, 0x1e, 0x66,
-	0x7c, 0x1f, 0xbf, 0x7e, 0xc9, 0xd3, 0x47, 0x8e, 0xbb, 0x6e, 0xf2, 0x6b, 0x04, 0x59, 0xc9, 0x7f,
-	0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x00, 0xb0, 0xe8, 0x3c, 0x1c, 0x00, 0x00,
+	// 2394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x3d, 0x6c, 0x23, 0xc7,
+	0xf5, 0xe7, 0xae, 0xf8, 0xf9, 0xf8, 0x71, 0xbc, 0xb1, 0x74, 0xe6, 0xf1, 0xff, 0x0f, 0xb9, 0x47,
+	0xfb, 0x2c, 0xdd, 0xc5, 0x47, 0xda, 0xb2, 0x9d, 0x38, 0x41, 0x62, 0x5b, 0xfc, 0x38, 0x69, 0x75,
+	0x27, 0x4a, 0x58, 0xf2, 0xfc, 0x55, 0x64, 0x31, 0xe4, 0xce, 0x91, 0x0b, 0x91, 0xbb, 0xc4, 0xee,
+	0x48, 0x22, 0xd3, 0xa5, 0x09, 0x02, 0x56, 0x2e, 0x52, 0x04, 0x08, 0x08, 0x24, 0x48, 0x8a, 0x20,
+	0x4d, 0x9a, 0x04, 0x48, 0x91, 0x00, 0x41, 0x2a, 0xc3, 0x95, 0xbb, 0x04, 0x29, 0x88, 0x44, 0xee,
+	0xd2, 0xa4, 0x49, 0xe5, 0x26, 0xc1, 0xce, 0x0e, 0xc9, 0xa5, 0x44, 0xea, 0xe4, 0xf3, 0x09, 0x30,
+	0x90, 0x8a, 0x3b, 0x33, 0xbf, 0x79, 0x5f, 0xf3, 0xe6, 0xbd, 0x37, 0x8f, 0x90, 0xc1, 0x7d, 0xd2,
+	0xc1, 0x56, 0x81, 0x1c, 0x77, 0x0b, 0xc7, 0xaf, 0x36, 0x08, 0xc5, 0xaf, 0x16, 0xe8, 0xa0, 0x47,
+	0xec, 0x7c, 0xcf, 0x32, 0xa9, 0x89, 0x90, 0xbb, 0x9e, 0x27, 0xc7, 0xdd, 0x3c, 0x5f, 0x4f, 0xdf,
+	0x6c, 0x99, 0x66, 0xab, 0x43, 0x0a, 0x0c, 0xd1, 0x38, 0x7a, 0x5c, 0xc0, 0xc6, 0xc0, 0x85, 0xa7,
+	0x57, 0x5b, 0x66, 0xcb, 0x64, 0x9f, 0x05, 0xe7, 0x8b, 0xcf, 0xde, 0x6c, 0x9a, 0x76, 0xd7, 0xb4,
+	0x55, 0x77, 0xc1, 0x1d, 0xf0, 0xa5, 0x3b, 0x9c, 0xbf, 0x41, 0xfa, 0x47, 0x76, 0x81, 0xf4, 0x7b,
+	0xa6, 0x45, 0x89, 0xb6, 0x48, 0x94, 0xf4, 0xcb, 0x1c, 0xda, 0x3d, 0xea, 0x50, 0xdd, 0xd6, 0x5b,
+	0x17, 0xa2, 0x73, 0x3f, 0x13, 0x00, 0xde, 0x35, 0x29, 0xa9, 0x1c, 0x13, 0x83, 0xda, 0xe8, 0x03,
+	0x08, 0x34, 0xdb, 0x58, 0x37, 0x52, 0x82, 0x24, 0x6c, 0x44, 0x8a, 0xa5, 0xcf, 0xc7, 0xd9, 0xb7,
+	0x5b, 0x3a, 0x6d, 0x1f, 0x35, 0xf2, 0x4d, 0xb3, 0x5b, 0x70, 0x49, 0x1b, 0x84, 0x9e, 0x98, 0xd6,
+	0x21, 0x1f, 0xdd, 0x6b, 0x9a, 0x16, 0x29, 0xf4, 0xcf, 0x88, 0x96, 0x2f, 0x39, 0x64, 0xaa, 0xb8,
+	0x4b, 0x14, 0x97, 0x22, 0xfa, 0x26, 0x04, 0x09, 0x63, 0x92, 0x12, 0xa5, 0x95, 0x8d, 0xe8, 0xe6,
+	0xcd, 0xfc, 0x79, 0x9b, 0xe5, 0x99, 0x18, 0x45, 0xff, 0xc7, 0xe3, 0xac, 0x4f, 0xe1, 0xf0, 0xdc,
+	0x27, 0x61, 0x08, 0xb0, 0xf9, 0xab, 0x94, 0xee, 0x0e, 0x04, 0x68, 0x5f, 0xd5, 0xb5, 0x94, 0x28,
+	0x09, 0x1b, 0xb1, 0xe2, 0xaa, 0x23, 0xc1, 0xdf, 0xc6, 0x59, 0xff, 0x0e, 0xb6, 0xdb, 0xa7, 0xe3,
+	0xac, 0xbf, 0xde, 0x97, 0xcb, 0x8a, 0x9f, 0xf6, 0x65, 0x0d, 0xad, 0x42, 0x40, 0x37, 0x34, 0xd2,
+	0x4f, 0xad, 0x48, 0xc2, 0x86, 0x5f, 0x71, 0x07, 0xe8, 0x4d, 0x08, 0xda, 0x14, 0xd3, 0x23, 0x3b,
+	0xe5, 0x97, 0x84, 0x8d, 0xc4, 0xa6, 0xb4, 0x54, 0xbd, 0x7c, 0x8d, 0xe1, 0x14, 0x8e, 0x47, 0x25,
+	0x00, 0x6a, 0x1e, 0x12, 0x43, 0xb5, 0x89, 0x41, 0x53, 0x01, 0x49, 0xd8, 0x88, 0x6e, 0xe6, 0x96,
+	0xee, 0xae, 0x3b, 0xd0, 0x1a, 0x31, 0xe8, 0x8e, 0x4f, 0x89, 0xd0, 0xc9, 0x00, 0x3d, 0x84, 0x78,
+	0xd3, 0x34, 0xa8, 0x85, 0x9b, 0x54, 0x6d, 0xe2, 0x4e, 0x27, 0x15, 0x64, 0x74, 0x6e, 0x2f, 0xa5,
+	0x53, 0xe2, 0xe8, 0x12, 0xee, 0x74, 0x76, 0x7c, 0x4a, 0xac, 0xe9, 0x19, 0x23, 0x1d, 0x52, 0x73,
+	0xd4, 0xd4, 0x13, 0x9d, 0xb6, 0x55, 0xc6, 0x2d, 0x15, 0x62, 0x84, 0xf3, 0x97, 0x22, 0xfc, 0x9e,
+	0x4e, 0xdb, 0x4c, 0xe0, 0x1d, 0x9f, 0xb2, 0xd6, 0x5c, 0xb4, 0x80, 0xde, 0x86, 0x30, 0xb5, 0xb0,
+	0x61, 0x3f, 0x26, 0x56, 0x2a, 0xcc, 0x48, 0xdf, 0x5a, 0xae, 0x3b, 0x07, 0xee, 0xf8, 0x94, 0xe9,
+	0x26, 0xb4, 0x0f, 0x09, 0xd7, 0x7c, 0x1a, 0xe9, 0x75, 0xcc, 0x01, 0xd1, 0x52, 0x11, 0x46, 0xe6,
+	0xa5, 0x8b, 0x4d, 0x58, 0xe6, 0xe8, 0x1d, 0x9f, 0x12, 0xa7, 0xde, 0x09, 0xf4, 0x03, 0x01, 0x32,
+	0x93, 0xcb, 0xa3, 0x9a, 0x27, 0x06, 0xb1, 0xec, 0xb6, 0xde, 0x53, 0x27, 0x0c, 0x2d, 0xa2, 0xa5,
+	0x80, 0x71, 0x78, 0x63, 0x29, 0x87, 0x3d, 0xbe, 0x7d, 0x7f, 0xb2, 0xbb, 0x3e, 0xdb, 0x5c, 0x14,
+	0x53, 0xc2, 0x8e, 0x4f, 0xf9, 0xff, 0xee, 0x05, 0x18, 0xf4, 0x43, 0x01, 0x6e, 0xcd, 0x64, 0xe8,
+	0x11, 0x0b, 0x53, 0xf3, 0xbc, 0x18, 0x51, 0x26, 0xc6, 0x9b, 0x4f, 0x16, 0xc3, 0x43, 0xc0, 0xc3,
+	0x65, 0xc7, 0xa7, 0x64, 0xbb, 0x17, 0x43, 0x72, 0xbf, 0x17, 0x20, 0xe8, 0xfa, 0x2b, 0x7a, 0x19,
+	0x50, 0xad, 0xbe, 0x55, 0x7f, 0x54, 0x53, 0x1f, 0x55, 0x6b, 0x07, 0x95, 0x92, 0x7c, 0x5f, 0xae,
+	0x94, 0x93, 0xbe, 0xf4, 0xea, 0x70, 0x24, 0x25, 0x19, 0xbf, 0xaa, 0x69, 0x54, 0xfa, 0xba, 0x4d,
+	0x1d, 0x87, 0xdc, 0x80, 0x24, 0x47, 0x97, 0xf6, 0xab, 0xf7, 0x65, 0x65, 0xaf, 0x52, 0x4e, 0x0a,
+	0x69, 0x34, 0x1c, 0x49, 0x89, 0x89, 0x9b, 0x3c, 0xd6, 0xad, 0x2e, 0xd1, 0xe6, 0x90, 0x7b, 0x07,
+	0x0f, 0x2b, 0xf5, 0x4a, 0x39, 0x29, 0xce, 0x21, 0xbb, 0xbd, 0x0e, 0xa1, 0x44, 0x43, 0x39, 0x88,
+	0x73, 0xe4, 0xfd, 0x2d, 0xf9, 0x61, 0xa5, 0x9c, 0x5c, 0x49, 0x5f, 0x1b, 0x8e, 0xa4, 0x28, 0x83,
+	0xdd, 0xc7, 0x7a, 0x87, 0x68, 0xe9, 0xf0, 0x8f, 0x7e, 0x91, 0xf1, 0xfd, 0xea, 0x97, 0x19, 0xa1,
+	0x18, 0x82, 0x00, 0x8b, 0x20, 0xbb, 0xfe, 0x70, 0x2c, 0x19, 0xdf, 0xf5, 0x87, 0xe3, 0xc9, 0x44,
+	0xee, 0x8f, 0x22, 0x24, 0xe6, 0xef, 0x11, 0x5a, 0x87, 0xa0, 0x4d, 0x0c, 0x8d, 0x58, 0x2c, 0xac,
+	0xc4, 0x8a, 0xd7, 0xf8, 0xdd, 0x0f, 0x6d, 0x69, 0x9a, 0x45, 0x6c, 0x5b, 0xe1, 0xcb, 0xa8, 0x07,
+	0xd7, 0x35, 0x62, 0x53, 0xdd, 0xc0, 0x54, 0x37, 0x0d, 0xd5, 0x0d, 0x45, 0xe2, 0xb3, 0x0b, 0x45,
+	0x49, 0x0f, 0x75, 0x36, 0x8b, 0x0a, 0xf0, 0x9c, 0x97, 0x23, 0x76, 0x05, 0x62, 0x81, 0x27, 0xa2,
+	0x20, 0xcf, 0x12, 0x17, 0x15, 0xdd, 0x80, 0xa0, 0x3d, 0xe8, 0x36, 0xcc, 0x0e, 0x8b, 0x42, 0x11,
+	0x85, 0x8f, 0xd0, 0x36, 0x04, 0x71, 0xd7, 0x3c, 0xe2, 0xf1, 0x25, 0x56, 0x2c, 0x70, 0x1d, 0xd7,
+	0x3d, 0x32, 0xbb, 0x09, 0x87, 0xff, 0xdc, 0xb3, 0xb5, 0x43, 0x9e, 0x28, 0x1e, 0xe9, 0x06, 0x55,
+	0xf8, 0xf6, 0xdc, 0x50, 0x84, 0xeb, 0xe7, 0xae, 0xf9, 0x57, 0xd9, 0x84, 0x77, 0x20, 0x39, 0x0d,
+	0x65, 0xf3, 0xf6, 0xbb, 0x36, 0x99, 0x9f, 0x18, 0xaf, 0x00, 0xb1, 0x1e, 0x1e, 0x74, 0x4c, 0xac,
+	0xa9, 0x6d, 0x6c, 0xb7, 0x99, 0x09, 0x63, 0xc5, 0x98, 0x37, 0x15, 0x28, 0x51, 0x8e, 0x70, 0x06,
+	0xb9, 0x7f, 0x8b, 0x90, 0x5e, 0x1e, 0xf3, 0xfe, 0x47, 0xad, 0xe2, 0xf1, 0xc1, 0xc0, 0x12, 0x1f,
+	0x0c, 0x7e, 0x39, 0x1f, 0x1c, 0x40, 0x7c, 0x2e, 0x1d, 0xa0, 0x2c, 0x88, 0xd4, 0x5c, 0x66, 0x64,
+	0x91, 0x9a, 0x1e, 0xd6, 0xe2, 0x97, 0x63, 0xdd, 0x00, 0x74, 0x3e, 0x85, 0x78, 0x34, 0x16, 0xe6,
+	0x34, 0x7e, 0x1d, 0xdc, 0xd4, 0x32, 0x35, 0xb1, 0xb8, 0x58, 0xc4, 0x18, 0x43, 0xf1, 0x51, 0xee,
+	0x77, 0x22, 0xdc, 0x7a, 0x62, 0x16, 0x41, 0x79, 0x80, 0x9e, 0x45, 0x78, 0x7e, 0x4a, 0x09, 0xd2,
+	0xca, 0x22, 0xc2, 0x91, 0x9e, 0x45, 0xdc, 0xdd, 0xe8, 0x5d, 0x48, 0xf4, 0x2c, 0x72, 0xac, 0xd2,
+	0xb6, 0x45, 0xec, 0xb6, 0xd9, 0xd1, 0x9e, 0xd6, 0x14, 0x71, 0x87, 0x4c, 0x7d, 0x42, 0xc5, 0x91,
+	0xc3, 0x20, 0x27, 0x13, 0x39, 0x56, 0x96, 0xc8, 0x61, 0x90, 0x13, 0x2e, 0x47, 0x1d, 0xe2, 0x0e,
+	0x7e, 0x26, 0x86, 0xff, 0xe9, 0xc4, 0x88, 0x19, 0xe4, 0x64, 0x2a, 0xc5, 0xb7, 0xc5, 0x94, 0x90,
+	0xfb, 0x48, 0x84, 0x17, 0x2f, 0x93, 0xf6, 0xd0, 0xeb, 0xae, 0x08, 0xd3, 0xb4, 0xba, 0x4c, 0x6a,
+	0x87, 0xc5, 0x94, 0xc6, 0xd5, 0x08, 0x8e, 0x0e, 0x20, 0xea, 0x50, 0x3d, 0x21, 0x7a, 0xab, 0x4d,
+	0xed, 0x54, 0x40, 0x5a, 0x79, 0x1a, 0x9a, 0xce, 0x11, 0xbc, 0xe7, 0x92, 0xd8, 0xf5, 0x87, 0x85,
+	0xa4, 0xb8, 0xeb, 0x0f, 0x8b, 0xc9, 0x95, 0x1c, 0x86, 0x68, 0xd5, 0x0d, 0x15, 0xb2, 0xf1, 0xd8,
+	0x44, 0x08, 0xfc, 0x06, 0xee, 0x12, 0xee, 0xa5, 0xec, 0x1b, 0xbd, 0x05, 0xe2, 0xb4, 0xea, 0xcd,
+	0x73, 0xbe, 0x2f, 0x5d, 0x82, 0xaf, 0x6c, 0x50, 0x45, 0xd4, 0xb5, 0xdc, 0x1f, 0x44, 0x80, 0xe2,
+	0x91, 0x65, 0x10, 0x8b, 0xb1, 0xf8, 0x06, 0x24, 0x1a, 0x6c, 0x34, 0xf5, 0xf9, 0x25, 0xd7, 0x32,
+	0xee, 0xc2, 0xf8, 0xf0, 0xe9, 0xae, 0xca, 0xe2, 0xc0, 0xb9, 0x72, 0x95, 0x81, 0x73, 0x59, 0x82,
+	0x5d, 0x85, 0x00, 0xb6, 0x6d, 0x42, 0x79, 0xcc, 0x73, 0x07, 0x48, 0x02, 0xbf, 0x8d, 0x3b, 0x93,
+	0x80, 0x37, 0x1f, 0x33, 0xd9, 0x4a, 0xee, 0xcf, 0x22, 0xc4, 0x2a, 0x4a, 0x69, 0xf3, 0x95, 0x32,
+	0xe9, 0x99, 0xb6, 0x4e, 0x67, 0x0f, 0x11, 0xe1, 0x89, 0x0f, 0x91, 0x67, 0x15, 0xd5, 0x66, 0xc2,
+	0xaf, 0x78, 0x85, 0x5f, 0x68, 0x5c, 0xff, 0x55, 0x1a, 0xf7, 0xbc, 0xf3, 0x04, 0x2e, 0xe3, 0x3c,
+	0xb9, 0x9f, 0xae, 0x00, 0x62, 0x46, 0x64, 0x61, 0x79, 0x8f, 0x50, 0xac, 0x61, 0x8a, 0x67, 0x6a,
+	0x09, 0x5e, 0xb5, 0xea, 0x10, 0x66, 0xaa, 0xcc, 0x1e, 0x7b, 0xdf, 0xfa, 0x62, 0x6e, 0x7f, 0x3a,
+	0xce, 0x86, 0x98, 0xbc, 0x72, 0x59, 0x09, 0x31, 0x52, 0xb2, 0x86, 0xde, 0x81, 0x90, 0x46, 0x28,
+	0xd6, 0x3b, 0x6e, 0x1e, 0x8d, 0x2e, 0x7e, 0xff, 0xf1, 0xb4, 0xc1, 0x70, 0xfc, 0x95, 0x3b, 0xd9,
+	0x76, 0xfe, 0x06, 0xb8, 0xa6, 0x7e, 0xc2, 0x0d, 0xb8, 0x0d, 0x21, 0xda, 0x77, 0x13, 0x33, 0xf3,
+	0xbc, 0x33, 0x4e, 0x16, 0xa4, 0x7d, 0xe7, 0x17, 0x6d, 0x4e, 0x5f, 0xa7, 0x21, 0xf6, 0x3a, 0x4d,
+	0x2f, 0x92, 0xee, 0xcc, 0xbb, 0x34, 0x0b, 0x51, 0xdd, 0x56, 0x49, 0x9f, 0x12, 0xcb, 0xc0, 0x1d,
+	0xf6, 0x38, 0x0b, 0x2b, 0xa0, 0xdb, 0x15, 0x3e, 0xe3, 0x00, 0xf8, 0x71, 0x35, 0x4d, 0x8d, 0xb0,
+	0x67, 0x57, 0x4c, 0x01, 0x77, 0xaa, 0x64, 0x6a, 0x64, 0xd7, 0x1f, 0x0e, 0x26, 0x43, 0xb9, 0x03,
+	0x78, 0x8e, 0x45, 0x5f, 0xdc, 0x74, 0x4e, 0x7a, 0x7a, 0x3a, 0x12, 0x04, 0x2d, 0x7c, 0xa2, 0xd2,
+	0x3e, 0xf7, 0xf4, 0xc8, 0xe9, 0x38, 0x1b, 0x50, 0xf0, 0x49, 0xfd, 0x7d, 0x25, 0x60, 0xe1, 0x93,
+	0x7a, 0x1f, 0x3d, 0x0f, 0xa1, 0xde, 0x51, 0x43, 0x3d, 0x24, 0x03, 0xf7, 0xa0, 0x94, 0x60, 0xef,
+	0xa8, 0xf1, 0x80, 0x0c, 0x72, 0xff, 0x14, 0x20, 0x54, 0x32, 0xbb, 0x5d, 0x6c, 0x68, 0x68, 0x9d,
+	0xc5, 0x2f, 0x97, 0xc4, 0xf3, 0x5c, 0xf7, 0x08, 0x5f, 0x94, 0xcb, 0xa7, 0xe3, 0xac, 0x28, 0x97,
+	0x9d, 0x40, 0x85, 0x52, 0x10, 0x6a, 0xba, 0xd3, 0x6e, 0x69, 0xa5, 0x4c, 0x86, 0xce, 0x9d, 0xee,
+	0x61, 0x0b, 0x77, 0xdd, 0xa3, 0x8b, 0x29, 0x7c, 0x84, 0x1a, 0x10, 0x3c, 0x24, 0x03, 0xc7, 0x4f,
+	0xdc, 0xa3, 0x78, 0xe0, 0x48, 0xf8, 0x80, 0x0c, 0xe4, 0xf2, 0xe7, 0xe3, 0xec, 0x5b, 0x97, 0x74,
+	0xff, 0x73, 0x6d, 0x98, 0x3c, 0xa3, 0xa0, 0x04, 0x0e, 0xc9, 0x40, 0xd6, 0x90, 0x04, 0xb1, 0x2e,
+	0xee, 0xab, 0x2d, 0x6c, 0xab, 0x4d, 0xd3, 0x76, 0xc3, 0x47, 0x5c, 0x81, 0x2e, 0xee, 0x6f, 0x63,
+	0xbb, 0x64, 0xda, 0x34, 0xf7, 0x2f, 0x3f, 0xac, 0x72, 0x7d, 0x8a, 0x98, 0x36, 0xdb, 0x53, 0x03,
+	0xde, 0xf0, 0x68, 0x1e, 0xf4, 0x28, 0xfa, 0x0e, 0x44, 0xb9, 0x66, 0xaa, 0xae, 0xb9, 0xdd, 0x96,
+	0x58, 0x31, 0xbb, 0xc8, 0x34, 0x30, 0x1d, 0xd8, 0x0a, 0xf0, 0x3d, 0xb2, 0x66, 0x3b, 0x79, 0xc2,
+	0xe1, 0xc0, 0xcd, 0xc1, 0xbe, 0xd1, 0x3a, 0x84, 0x9d, 0xb7, 0xe8, 0xd2, 0x12, 0x30, 0x64, 0xeb,
+	0x2d, 0xe7, 0x03, 0x6d, 0x4d, 0x5d, 0x2d, 0xc0, 0x5c, 0xed, 0xce, 0x22, 0x57, 0x63, 0x9a, 0x10,
+	0x8d, 0xf3, 0xb7, 0xcf, 0x78, 0xde, 0xcc, 0xf0, 0xc1, 0x2b, 0x33, 0xbc, 0x02, 0x29, 0x56, 0x0f,
+	0x35, 0x5c, 0x49, 0x54, 0xae, 0xbe, 0xed, 0x70, 0x0d, 0x31, 0xfd, 0x6e, 0x9e, 0x8e, 0xb3, 0x6b,
+	0x07, 0x16, 0x39, 0x3e, 0x23, 0xac, 0x5c, 0x56, 0xd6, 0x7a, 0x0b, 0xa6, 0x35, 0xf4, 0x3d, 0x88,
+	0xd8, 0x7a, 0xcb, 0xc0, 0xf4, 0xc8, 0x22, 0xbc, 0x99, 0xb1, 0x9a, 0x77, 0xbb, 0x80, 0xf9, 0x49,
+	0x17, 0x30, 0xbf, 0x65, 0x0c, 0x8a, 0x77, 0x3f, 0xf9, 0xed, 0xbd, 0x85, 0xd1, 0x46, 0x23, 0xcd,
+	0xc2, 0x81, 0x83, 0xdc, 0xc3, 0x96, 0xdd, 0xc6, 0x1d, 0x62, 0x29, 0x33, 0x92, 0x8e, 0xa3, 0xb6,
+	0x59, 0x96, 0x67, 0x77, 0x6d, 0x45, 0xe1, 0x23, 0x74, 0x0b, 0x62, 0x0e, 0x48, 0xb5, 0x08, 0xb5,
+	0x74, 0x62, 0xb3, 0xf6, 0x44, 0x5c, 0x89, 0x3a, 0x73, 0x8a, 0x3b, 0x85, 0xee, 0xc2, 0xf5, 0x29,
+	0x64, 0xa0, 0x72, 0x2a, 0x51, 0x46, 0xe5, 0xda, 0x04, 0x37, 0xd8, 0x61, 0xd3, 0xb9, 0xbf, 0x08,
+	0x10, 0xad, 0xe9, 0xad, 0xa9, 0xa3, 0x15, 0xc0, 0xef, 0x04, 0x3f, 0xe6, 0x6a, 0x89, 0xcd, 0xff,
+	0x5b, 0x18, 0x3a, 0xf4, 0x56, 0x7d, 0xd0, 0x23, 0x0a, 0x03, 0xce, 0xfa, 0x74, 0xe2, 0x33, 0xef,
+	0xd3, 0x7d, 0xc7, 0x79, 0xb8, 0xb8, 0xce, 0xcd, 0x4e, 0xce, 0x39, 0x2e, 0xe6, 0xa6, 0x45, 0x74,
+	0x3a, 0xce, 0x26, 0xbc, 0x17, 0x45, 0x2e, 0x2b, 0x89, 0xa6, 0x77, 0xac, 0xe5, 0x7e, 0x23, 0x40,
+	0x74, 0x52, 0x09, 0x3e, 0x20, 0x83, 0x2f, 0x92, 0x6c, 0x4d, 0xa7, 0x50, 0xeb, 0x53, 0x95, 0x3b,
+	0xa6, 0x5b, 0x64, 0xec, 0x9f, 0x8e, 0xb3, 0x91, 0x2a, 0xe9, 0xd3, 0x67, 0xe5, 0x9c, 0x11, 0x83,
+	0x13, 0xd3, 0x78, 0x05, 0x77, 0x0c, 0x81, 0x2d, 0x96, 0xb6, 0xae, 0xb0, 0xf7, 0x39, 0x29, 0x0b,
+	0xc5, 0x59, 0x59, 0xe8, 0xf4, 0x7d, 0x62, 0xde, 0x6c, 0x85, 0xbe, 0x36, 0xe9, 0x52, 0x7a, 0x2a,
+	0x48, 0xb7, 0xff, 0xe8, 0x90, 0xf2, 0xd4, 0x45, 0xe2, 0x5c, 0x5d, 0x74, 0x1b, 0xc2, 0x1a, 0x69,
+	0xea, 0x5d, 0xcc, 0x13, 0x63, 0xbc, 0x18, 0xf9, 0x7c, 0x9c, 0x0d, 0x1c, 0xe9, 0x06, 0x7d, 0x53,
+	0x99, 0x2e, 0xa1, 0x5d, 0x08, 0x37, 0x71, 0x0f, 0x37, 0x75, 0x3a, 0x48, 0xf9, 0x9f, 0xaa, 0x16,
+	0x9d, 0xee, 0xcf, 0xfd, 0x44, 0x84, 0xd0, 0x36, 0xa6, 0xe4, 0x04, 0x3b, 0x07, 0x1c, 0x7a, 0x42,
+	0x1d, 0x3a, 0x59, 0x47, 0x6f, 0x4d, 0xe3, 0x96, 0xc8, 0xfc, 0x7c, 0x61, 0x0b, 0x96, 0xd3, 0xe5,
+	0xa9, 0xd2, 0x69, 0xe5, 0x4d, 0x82, 0x56, 0xee, 0xe7, 0xb3, 0x4e, 0xd9, 0xbd, 0x25, 0x9d, 0xb2,
+	0xb5, 0xe1, 0x48, 0xba, 0xce, 0x49, 0xb8, 0xd0, 0xaa, 0x69, 0x10, 0xf4, 0x32, 0x24, 0x38, 0xfc,
+	0xa0, 0x52, 0x2d, 0xcb, 0xd5, 0xed, 0xa4, 0x90, 0x4e, 0x0d, 0x47, 0xd2, 0xea, 0x1c, 0xf4, 0x80,
+	0x18, 0x9a, 0x6e, 0xb4, 0xd0, 0x2b, 0x0b, 0x1a, 0x6b, 0x62, 0x3a, 0x3d, 0x1c, 0x49, 0x37, 0xe6,
+	0xf0, 0xd3, 0x06, 0xdb, 0xac, 0x25, 0x96, 0xfb, 0xb1, 0x00, 0xb1, 0x03, 0xb3, 0xd3, 0x99, 0x5e,
+	0xed, 0xaf, 0x44, 0x47, 0xfd, 0xee, 0xaf, 0x67, 0xa6, 0x5b, 0x5f, 0x62, 0x3a, 0xd6, 0xe7, 0xf3,
+	0xf6, 0x17, 0x67, 0x40, 0xb9, 0x2a, 0xd7, 0xe5, 0xad, 0x87, 0xf2, 0x87, 0xac, 0xc3, 0xc8, 0x80,
+	0xb2, 0xa1, 0x53, 0x1d, 0x77, 0xf4, 0xef, 0x13, 0x0d, 0x65, 0xcf, 0x59, 0x57, 0x4c, 0x47, 0x87,
+	0x23, 0x29, 0x34, 0x31, 0xe8, 0x0b, 0x0b, 0x0c, 0xea, 0x4f, 0xc7, 0x87, 0x23, 0x29, 0xb2, 0xc0,
+	0x86, 0x77, 0xff, 0x23, 0xc0, 0xda, 0xc2, 0xf4, 0x85, 0xbe, 0x0b, 0x2f, 0x14, 0xb7, 0xea, 0xa5,
+	0x9d, 0x4a, 0xd9, 0xe9, 0x64, 0xee, 0x6d, 0x55, 0xcb, 0x35, 0x75, 0x79, 0xc7, 0x94, 0xd1, 0xf0,
+	0x6a, 0xf4, 0x06, 0x64, 0x97, 0x6d, 0xaf, 0xc9, 0xdb, 0x55, 0xd7, 0x2f, 0x92, 0xc3, 0x91, 0x14,
+	0x63, 0x5b, 0x6b, 0x7a, 0xcb, 0x70, 0xc4, 0xbf, 0x60, 0xdb, 0x56, 0x71, 0x5f, 0x71, 0xbb, 0xa9,
+	0xb3, 0x6d, 0x5b, 0x0d, 0x76, 0x5e, 0xe8, 0x35, 0xc8, 0x5c, 0xc4, 0x6d, 0xd6, 0x5c, 0x9d, 0x32,
+	0x23, 0x5a, 0xda, 0xef, 0x58, 0xe1, 0xee, 0x00, 0x42, 0x3c, 0xde, 0xa3, 0x1c, 0xac, 0xd6, 0xe4,
+	0x6d, 0xb5, 0xfe, 0xc1, 0x41, 0xe5, 0x8c, 0x8e, 0xe1, 0xe1, 0x48, 0xf2, 0x33, 0xf7, 0x4e, 0x43,
+	0x74, 0x8a, 0xa9, 0xbf, 0x9f, 0x14, 0xd2, 0x91, 0xe1, 0x48, 0x0a, 0x38, 0x14, 0xfa, 0xe8, 0x45,
+	0x48, 0x4e, 0xd7, 0xb8, 0x18, 0x49, 0x31, 0x9d, 0x18, 0x8e, 0x24, 0xa8, 0xe9, 0x2d, 0x6e, 0x5f,
+	0x8f, 0xf1, 0xff, 0x24, 0x40, 0x9c, 0xbf, 0x94, 0xb8, 0xd1, 0x37, 0x20, 0x5d, 0xae, 0x1c, 0xec,
+	0xd7, 0xe4, 0xfa, 0x62, 0x5b, 0xcf, 0xe4, 0x58, 0x87, 0x1b, 0x67, 0x90, 0xb3, 0xeb, 0x36, 0xe7,
+	0x10, 0x5f, 0x87, 0xd4, 0x19, 0xa0, 0xf7, 0xa6, 0xcd, 0x3b, 0x06, 0xba, 0x0d, 0x6b, 0x67, 0xc0,
+	0xc5, 0x47, 0x8a, 0x6b, 0x3e, 0x18, 0x8e, 0xa4, 0x20, 0x7b, 0x1b, 0xbb, 0x2a, 0x08, 0xac, 0x2d,
+	0x5d, 0xfd, 0xf8, 0x1f, 0x19, 0xdf, 0xc7, 0xa7, 0x19, 0xe1, 0xd3, 0xd3, 0x8c, 0xf0, 0xf7, 0xd3,
+	0x8c, 0xf0, 0xd1, 0x67, 0x19, 0xdf, 0xa7, 0x9f, 0x65, 0x7c, 0x7f, 0xfd, 0x2c, 0xe3, 0xfb, 0xf0,
+	0x95, 0x4b, 0xde, 0x3e, 0xe7, 0x8f, 0x48, 0x16, 0xfc, 0x1a, 0x41, 0x56, 0x59, 0xbc, 0xf6, 0xdf,
+	0x01, 0x00, 0x35, 0x30, 0xfb, 0x5e, 0xa3, 0x1c, 0x00, 0x00,
 }
 
 func (m *VoteEvents) Marshal() (dAtA []byte, err error) {
@@ -2444,6 +2455,21 @@ func (m *CommandBatchMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignRetryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignRetryHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SignRetries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Signature != nil {
 		{
 			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
@@ -3228,6 +3254,15 @@ func (m *CommandBatchMetadata) Size() (n int) {
 		l = m.Signature.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.SignRetries != 0 {
+		n += 1 + sovTypes(uint64(m.SignRetries))
+	}
+	if m.SignRetryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SignRetryHeight))
+	}
 	return n
 }
 
@@ -6527,6 +6562,63 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRetries", wireType)
+			}
+			m.SignRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRetryHeight", wireType)
+			}
+			m.SignRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
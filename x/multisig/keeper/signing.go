@@ -87,7 +87,7 @@ func (k Keeper) Sign(ctx sdk.Context, keyID exported.KeyID, payloadHash exported
 
 	k.setSigningSession(ctx, signingSession)
 
-	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(types.NewSigningStarted(signingSession.GetID(), key, payloadHash[:], module)))
+	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(types.NewSigningStarted(signingSession.GetID(), key, payloadHash[:], module, expiresAt)))
 	k.Logger(ctx).Info("signing session started",
 		"sig_id", signingSession.GetID(),
 		"key_id", key.GetID(),
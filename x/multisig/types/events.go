@@ -8,11 +8,12 @@ import (
 )
 
 // NewKeygenStarted is the constructor for event keygen started
-func NewKeygenStarted(keyID exported.KeyID, participants []sdk.ValAddress) *KeygenStarted {
+func NewKeygenStarted(keyID exported.KeyID, participants []sdk.ValAddress, expiresAt int64) *KeygenStarted {
 	return &KeygenStarted{
 		Module:       ModuleName,
 		KeyID:        keyID,
 		Participants: participants,
+		ExpiresAt:    expiresAt,
 	}
 }
 
@@ -43,7 +44,7 @@ func NewPubKeySubmitted(keyID exported.KeyID, participant sdk.ValAddress, pubKey
 }
 
 // NewSigningStarted is the constructor for event signing started
-func NewSigningStarted(sigID uint64, key Key, payloadHash exported.Hash, requestingModule string) *SigningStarted {
+func NewSigningStarted(sigID uint64, key Key, payloadHash exported.Hash, requestingModule string, expiresAt int64) *SigningStarted {
 	return &SigningStarted{
 		Module:           ModuleName,
 		SigID:            sigID,
@@ -51,6 +52,7 @@ func NewSigningStarted(sigID uint64, key Key, payloadHash exported.Hash, request
 		PubKeys:          key.GetPubKeys(),
 		PayloadHash:      payloadHash,
 		RequestingModule: requestingModule,
+		ExpiresAt:        expiresAt,
 	}
 }
 
@@ -31,6 +31,7 @@ type KeygenStarted struct {
 	Module       string                                                         `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	KeyID        github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
 	Participants []github_com_cosmos_cosmos_sdk_types.ValAddress                `protobuf:"bytes,3,rep,name=participants,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"participants,omitempty"`
+	ExpiresAt    int64                                                          `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *KeygenStarted) Reset()         { *m = KeygenStarted{} }
@@ -87,6 +88,13 @@ func (m *KeygenStarted) GetParticipants() []github_com_cosmos_cosmos_sdk_types.V
 	return nil
 }
 
+func (m *KeygenStarted) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type KeygenCompleted struct {
 	Module string                                                         `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	KeyID  github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
@@ -266,6 +274,7 @@ type SigningStarted struct {
 	PubKeys          map[string]github_com_axelarnetwork_axelar_core_x_multisig_exported.PublicKey `protobuf:"bytes,4,rep,name=pub_keys,json=pubKeys,proto3,castvalue=github.com/axelarnetwork/axelar-core/x/multisig/exported.PublicKey" json:"pub_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	PayloadHash      github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash                 `protobuf:"bytes,5,opt,name=payload_hash,json=payloadHash,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.Hash" json:"payload_hash,omitempty"`
 	RequestingModule string                                                                        `protobuf:"bytes,6,opt,name=requesting_module,json=requestingModule,proto3" json:"requesting_module,omitempty"`
+	ExpiresAt        int64                                                                         `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *SigningStarted) Reset()         { *m = SigningStarted{} }
@@ -339,6 +348,13 @@ func (m *SigningStarted) GetRequestingModule() string {
 	return ""
 }
 
+func (m *SigningStarted) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type SigningCompleted struct {
 	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	SigID  uint64 `protobuf:"varint,2,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
@@ -650,51 +666,52 @@ func init() {
 }
 
 var fileDescriptor_36b18b0391cba3fc = []byte{
-	// 690 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xee, 0x36, 0x1f, 0x7d, 0xb3, 0x4d, 0xdf, 0x16, 0xab, 0x82, 0xa8, 0x87, 0x38, 0xb2, 0x38,
-	0x54, 0xaa, 0xea, 0xa8, 0x7c, 0x48, 0xa8, 0x12, 0xa0, 0xa6, 0xb4, 0xa2, 0x84, 0x8f, 0x2a, 0x11,
-	0x48, 0x70, 0x89, 0xd6, 0xf1, 0xc8, 0x59, 0xc5, 0xf6, 0x1a, 0xef, 0xba, 0xc4, 0x7f, 0x01, 0x84,
-	0xc4, 0x0d, 0x2e, 0x1c, 0xf8, 0x2d, 0x5c, 0x38, 0xf6, 0xc8, 0xc9, 0xa0, 0x84, 0x5f, 0x91, 0x13,
-	0x5a, 0xdb, 0x6d, 0xd2, 0x03, 0x18, 0x2a, 0x4a, 0x25, 0x4e, 0xde, 0xd9, 0x1d, 0x3d, 0x33, 0xf3,
-	0xcc, 0x33, 0x23, 0xe3, 0xcb, 0x64, 0x00, 0x36, 0xf1, 0xeb, 0x4e, 0x60, 0x0b, 0xca, 0xa9, 0x55,
-	0x3f, 0xd8, 0x30, 0x40, 0x90, 0x8d, 0x3a, 0x1c, 0x80, 0x2b, 0xb8, 0xee, 0xf9, 0x4c, 0x30, 0xe5,
-	0x52, 0xe2, 0xa5, 0x1f, 0x79, 0xe9, 0xa9, 0xd7, 0xca, 0xb2, 0xc5, 0x2c, 0x16, 0xfb, 0xd4, 0xe5,
-	0x29, 0x71, 0xd7, 0x86, 0x08, 0x2f, 0x34, 0x21, 0xb4, 0xc0, 0x6d, 0x0b, 0xe2, 0x0b, 0x30, 0x95,
-	0x8b, 0xb8, 0xe8, 0x30, 0x33, 0xb0, 0xa1, 0x82, 0x6a, 0x68, 0xb5, 0xd4, 0x4a, 0x2d, 0xc5, 0xc0,
-	0xc5, 0x3e, 0x84, 0x1d, 0x6a, 0x56, 0x66, 0xe5, 0x7d, 0xa3, 0x39, 0x8c, 0xd4, 0x42, 0x13, 0xc2,
-	0xbd, 0x3b, 0xe3, 0x48, 0xbd, 0x65, 0x51, 0xd1, 0x0b, 0x0c, 0xbd, 0xcb, 0x9c, 0x7a, 0x92, 0x80,
-	0x0b, 0xe2, 0x05, 0xf3, 0xfb, 0xa9, 0xb5, 0xde, 0x65, 0x3e, 0xd4, 0x07, 0x93, 0xdc, 0x61, 0xe0,
-	0x31, 0x19, 0x4e, 0x8f, 0x11, 0x5a, 0x85, 0x3e, 0x84, 0x7b, 0xa6, 0xf2, 0x18, 0x97, 0x3d, 0xe2,
-	0x0b, 0xda, 0xa5, 0x1e, 0x71, 0x05, 0xaf, 0xe4, 0x6a, 0xb9, 0xd5, 0x72, 0x63, 0x63, 0x1c, 0xa9,
-	0xeb, 0x53, 0x01, 0xba, 0x8c, 0x3b, 0x8c, 0xa7, 0x9f, 0x75, 0x6e, 0xf6, 0xeb, 0x22, 0xf4, 0x80,
-	0xeb, 0x4f, 0x88, 0xbd, 0x65, 0x9a, 0x3e, 0x70, 0xde, 0x3a, 0x01, 0xa3, 0xbd, 0x46, 0x78, 0x31,
-	0x29, 0x72, 0x9b, 0x39, 0x9e, 0x0d, 0xe7, 0x5c, 0xa6, 0xf6, 0xea, 0x98, 0xf4, 0x9d, 0x81, 0x47,
-	0xfd, 0x73, 0xce, 0xe6, 0xe3, 0x2c, 0x5e, 0xdc, 0x0f, 0x8c, 0x26, 0x84, 0xed, 0xc0, 0x70, 0xa8,
-	0x38, 0x6f, 0x11, 0xb4, 0xf1, 0xfc, 0x54, 0xf7, 0x2a, 0xb9, 0x1a, 0x3a, 0x9d, 0x06, 0xa6, 0x51,
-	0x94, 0x0e, 0x9e, 0xf3, 0x02, 0xa3, 0xd3, 0x87, 0xb0, 0x92, 0x8f, 0x01, 0x77, 0xc7, 0x91, 0xda,
-	0x38, 0x75, 0xc2, 0xfb, 0x81, 0x61, 0xd3, 0x6e, 0x13, 0xc2, 0x56, 0xd1, 0x8b, 0xa9, 0xd3, 0xde,
-	0xe7, 0xf1, 0xff, 0x6d, 0x6a, 0xb9, 0xd4, 0xb5, 0xb2, 0x26, 0xa9, 0x86, 0x8b, 0x9c, 0x5a, 0x47,
-	0x24, 0xe6, 0x1b, 0x25, 0x49, 0x62, 0x9b, 0x5a, 0x92, 0x02, 0x4e, 0xad, 0x3d, 0x73, 0x8a, 0xe6,
-	0xdc, 0x99, 0xd1, 0xfc, 0x16, 0xe1, 0xff, 0x52, 0x4a, 0x78, 0x25, 0x5f, 0xcb, 0xad, 0xce, 0x5f,
-	0xb9, 0xa6, 0xff, 0x60, 0x79, 0xe8, 0x27, 0x2b, 0xd3, 0x13, 0xb9, 0xf0, 0x1d, 0x57, 0xf8, 0x61,
-	0x63, 0xf7, 0xe5, 0x97, 0x3f, 0xc2, 0xe4, 0x5c, 0xc2, 0x24, 0x57, 0x4c, 0xb9, 0x05, 0x42, 0x9b,
-	0x11, 0xb3, 0xd3, 0x23, 0xbc, 0x57, 0x29, 0xc4, 0x0d, 0xdb, 0x1a, 0x47, 0xea, 0xcd, 0x53, 0x87,
-	0xb9, 0x4b, 0x78, 0x4f, 0x2a, 0x22, 0x86, 0x95, 0x86, 0xb2, 0x86, 0x2f, 0xf8, 0xf0, 0x3c, 0x00,
-	0x2e, 0xa8, 0x6b, 0x75, 0xd2, 0x46, 0x15, 0xe3, 0x46, 0x2d, 0x4d, 0x1e, 0x1e, 0xc4, 0xf7, 0x2b,
-	0x9b, 0xb8, 0x3c, 0x5d, 0xb3, 0xb2, 0x84, 0x73, 0x52, 0x4a, 0x49, 0x5f, 0xe5, 0x51, 0x59, 0xc6,
-	0x85, 0x03, 0x62, 0x07, 0x10, 0xf7, 0xb4, 0xdc, 0x4a, 0x8c, 0xcd, 0xd9, 0x1b, 0x68, 0x33, 0xff,
-	0xee, 0x83, 0x8a, 0xb4, 0xfb, 0x78, 0x29, 0x25, 0x31, 0x7b, 0x07, 0x65, 0x0a, 0x44, 0xbb, 0x77,
-	0x2c, 0xb6, 0xac, 0x0d, 0x92, 0x8d, 0x75, 0x88, 0xb0, 0x22, 0xc1, 0x88, 0x08, 0x7c, 0xc8, 0x5e,
-	0x01, 0xd9, 0xea, 0x3d, 0x93, 0x01, 0x5e, 0xc3, 0x25, 0x7e, 0x94, 0x64, 0x3a, 0xc2, 0x0b, 0xe3,
-	0x48, 0x2d, 0x1d, 0x67, 0xde, 0x9a, 0xbc, 0x6b, 0xdf, 0x10, 0x9e, 0x6f, 0x42, 0xb8, 0xc5, 0xe5,
-	0xd5, 0x4f, 0x6a, 0x79, 0x8a, 0x0b, 0xdd, 0x1e, 0xa1, 0x6e, 0xba, 0xcd, 0xb6, 0xc7, 0x91, 0x7a,
-	0xfb, 0x17, 0x25, 0xe6, 0xc2, 0x20, 0xe0, 0x13, 0x7d, 0x6d, 0x4b, 0x98, 0x87, 0xc4, 0x81, 0x56,
-	0x82, 0xf8, 0x37, 0x46, 0x58, 0x1b, 0x21, 0x8c, 0xe5, 0xe4, 0x30, 0x41, 0xc4, 0x3f, 0x5b, 0x65,
-	0xe3, 0xd1, 0xa7, 0x61, 0x15, 0x1d, 0x0e, 0xab, 0xe8, 0xeb, 0xb0, 0x8a, 0xde, 0x8c, 0xaa, 0x33,
-	0x87, 0xa3, 0xea, 0xcc, 0xe7, 0x51, 0x75, 0xe6, 0xd9, 0xf5, 0xdf, 0x0d, 0x10, 0x4b, 0xcc, 0x28,
-	0xc6, 0xbf, 0x3e, 0x57, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x99, 0x73, 0xa2, 0x51, 0x09,
-	0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6b, 0x13, 0x4d,
+	0x1c, 0xee, 0xe4, 0xab, 0x6f, 0x26, 0xe9, 0xdb, 0xbe, 0x4b, 0x79, 0x0d, 0x05, 0xb3, 0x61, 0xf1,
+	0x10, 0x28, 0xdd, 0x50, 0x3f, 0x40, 0x0a, 0x2a, 0x49, 0x6d, 0xb1, 0xc6, 0x8f, 0xb2, 0x41, 0x41,
+	0x2f, 0x61, 0x36, 0xfb, 0x63, 0x33, 0x64, 0xb3, 0xb3, 0xee, 0xcc, 0xd6, 0xec, 0xd1, 0xab, 0x22,
+	0x78, 0xd3, 0xab, 0xff, 0x87, 0x37, 0x2f, 0x1e, 0x7b, 0xf4, 0x14, 0x25, 0xf1, 0xaf, 0xc8, 0x49,
+	0xf6, 0x23, 0x4d, 0x2a, 0xe8, 0x6a, 0xb1, 0x16, 0x3c, 0x65, 0x67, 0xe6, 0xc7, 0x33, 0xbf, 0x79,
+	0x9e, 0xe7, 0xf7, 0x10, 0x7c, 0x81, 0x0c, 0xc0, 0x22, 0x6e, 0xad, 0xef, 0x59, 0x82, 0x72, 0x6a,
+	0xd6, 0x0e, 0x36, 0x75, 0x10, 0x64, 0xb3, 0x06, 0x07, 0x60, 0x0b, 0xae, 0x3a, 0x2e, 0x13, 0x4c,
+	0x3a, 0x17, 0x55, 0xa9, 0xd3, 0x2a, 0x35, 0xae, 0x5a, 0x5b, 0x35, 0x99, 0xc9, 0xc2, 0x9a, 0x5a,
+	0xf0, 0x15, 0x95, 0x2b, 0xcf, 0x52, 0x78, 0xa9, 0x09, 0xbe, 0x09, 0x76, 0x4b, 0x10, 0x57, 0x80,
+	0x21, 0xfd, 0x8f, 0x73, 0x7d, 0x66, 0x78, 0x16, 0x94, 0x50, 0x05, 0x55, 0xf3, 0x5a, 0xbc, 0x92,
+	0x74, 0x9c, 0xeb, 0x81, 0xdf, 0xa6, 0x46, 0x29, 0x15, 0xec, 0x37, 0x9a, 0xa3, 0xa1, 0x9c, 0x6d,
+	0x82, 0xbf, 0x77, 0x73, 0x32, 0x94, 0xaf, 0x9b, 0x54, 0x74, 0x3d, 0x5d, 0xed, 0xb0, 0x7e, 0x2d,
+	0x6a, 0xc0, 0x06, 0xf1, 0x94, 0xb9, 0xbd, 0x78, 0xb5, 0xd1, 0x61, 0x2e, 0xd4, 0x06, 0xb3, 0xde,
+	0x61, 0xe0, 0xb0, 0xe0, 0x3a, 0x35, 0x44, 0xd0, 0xb2, 0x3d, 0xf0, 0xf7, 0x0c, 0xe9, 0x01, 0x2e,
+	0x3a, 0xc4, 0x15, 0xb4, 0x43, 0x1d, 0x62, 0x0b, 0x5e, 0x4a, 0x57, 0xd2, 0xd5, 0x62, 0x63, 0x73,
+	0x32, 0x94, 0x37, 0xe6, 0x2e, 0xe8, 0x30, 0xde, 0x67, 0x3c, 0xfe, 0xd9, 0xe0, 0x46, 0xaf, 0x26,
+	0x7c, 0x07, 0xb8, 0xfa, 0x90, 0x58, 0x75, 0xc3, 0x70, 0x81, 0x73, 0xed, 0x18, 0x8c, 0x74, 0x1e,
+	0x63, 0x18, 0x38, 0xd4, 0x05, 0xde, 0x26, 0xa2, 0x94, 0xa9, 0xa0, 0x6a, 0x5a, 0xcb, 0xc7, 0x3b,
+	0x75, 0xa1, 0xbc, 0x44, 0x78, 0x39, 0xe2, 0x60, 0x9b, 0xf5, 0x1d, 0x0b, 0xce, 0x98, 0x05, 0xe5,
+	0x05, 0x9a, 0x6a, 0xb2, 0x13, 0xf6, 0x78, 0xb6, 0xdd, 0xbc, 0x4f, 0xe1, 0xe5, 0x7d, 0x4f, 0x6f,
+	0x82, 0xdf, 0xf2, 0xf4, 0x3e, 0x15, 0x67, 0xed, 0x91, 0x16, 0x2e, 0xcc, 0x89, 0x5b, 0x4a, 0x57,
+	0xd0, 0xc9, 0x2c, 0x32, 0x8f, 0x22, 0xb5, 0xf1, 0xa2, 0xe3, 0xe9, 0xed, 0x1e, 0xf8, 0xa1, 0x3d,
+	0x8a, 0x8d, 0xdd, 0xc9, 0x50, 0x6e, 0x9c, 0xb8, 0xe1, 0x7d, 0x4f, 0xb7, 0x68, 0xa7, 0x09, 0xbe,
+	0x96, 0x73, 0x42, 0xea, 0x94, 0x77, 0x19, 0xfc, 0x6f, 0x8b, 0x9a, 0x36, 0xb5, 0xcd, 0xa4, 0x41,
+	0xab, 0xe0, 0x1c, 0xa7, 0xe6, 0x94, 0xc4, 0x4c, 0x23, 0x1f, 0x90, 0xd8, 0xa2, 0x66, 0x40, 0x01,
+	0xa7, 0xe6, 0x9e, 0x31, 0x47, 0x73, 0xfa, 0xd4, 0x68, 0x7e, 0x8d, 0xf0, 0x3f, 0x31, 0x25, 0xbc,
+	0x94, 0xa9, 0xa4, 0xab, 0x85, 0x8b, 0x97, 0xd5, 0xef, 0x64, 0x8b, 0x7a, 0xfc, 0x65, 0x6a, 0x64,
+	0x17, 0xbe, 0x63, 0x0b, 0xd7, 0x6f, 0xec, 0x3e, 0xff, 0xf4, 0x5b, 0x98, 0x5c, 0x8c, 0x98, 0xe4,
+	0x92, 0x11, 0x84, 0x84, 0x6f, 0x31, 0x62, 0xb4, 0xbb, 0x84, 0x77, 0x4b, 0xd9, 0x50, 0xb0, 0xfa,
+	0x64, 0x28, 0x5f, 0x3b, 0xf1, 0x35, 0xb7, 0x08, 0xef, 0x6a, 0x85, 0x18, 0x36, 0x58, 0x48, 0xeb,
+	0xf8, 0x3f, 0x17, 0x9e, 0x78, 0xc0, 0x05, 0xb5, 0xcd, 0x76, 0x2c, 0x54, 0x2e, 0x14, 0x6a, 0x65,
+	0x76, 0x70, 0x37, 0x92, 0xec, 0x78, 0xc0, 0x2c, 0x7e, 0x13, 0x30, 0x6b, 0x5b, 0xb8, 0x38, 0x4f,
+	0x89, 0xb4, 0x82, 0xd3, 0x81, 0xd3, 0x22, 0xd9, 0x83, 0x4f, 0x69, 0x15, 0x67, 0x0f, 0x88, 0xe5,
+	0x41, 0x28, 0x79, 0x51, 0x8b, 0x16, 0x5b, 0xa9, 0xab, 0x68, 0x2b, 0xf3, 0xe6, 0xad, 0x8c, 0x94,
+	0x3b, 0x78, 0x25, 0xe6, 0x38, 0x39, 0xa2, 0x12, 0xfd, 0xa3, 0xdc, 0x3e, 0xf2, 0x62, 0x52, 0xc0,
+	0x24, 0x63, 0x1d, 0x22, 0x2c, 0x05, 0x60, 0x44, 0x78, 0x2e, 0x24, 0x27, 0x44, 0xb2, 0xb9, 0x4f,
+	0x65, 0xbe, 0xd7, 0x71, 0x9e, 0x4f, 0x9b, 0x8c, 0x27, 0x7c, 0x69, 0x32, 0x94, 0xf3, 0x47, 0x9d,
+	0x6b, 0xb3, 0x73, 0xe5, 0x0b, 0xc2, 0x85, 0x26, 0xf8, 0x75, 0x1e, 0x6c, 0xfd, 0xe0, 0x2d, 0x8f,
+	0x70, 0xb6, 0xd3, 0x25, 0xd4, 0x8e, 0xc3, 0x6e, 0x7b, 0x32, 0x94, 0x6f, 0xfc, 0xa4, 0x03, 0x6d,
+	0x18, 0x78, 0x7c, 0x66, 0xbf, 0xed, 0x00, 0xe6, 0x1e, 0xe9, 0x83, 0x16, 0x21, 0xfe, 0x89, 0x09,
+	0x57, 0xc6, 0x08, 0xe3, 0x60, 0xb0, 0x98, 0x20, 0xe2, 0xaf, 0x7d, 0x65, 0xe3, 0xfe, 0x87, 0x51,
+	0x19, 0x1d, 0x8e, 0xca, 0xe8, 0xf3, 0xa8, 0x8c, 0x5e, 0x8d, 0xcb, 0x0b, 0x87, 0xe3, 0xf2, 0xc2,
+	0xc7, 0x71, 0x79, 0xe1, 0xf1, 0x95, 0x5f, 0xbd, 0x20, 0xb4, 0x98, 0x9e, 0x0b, 0xff, 0x38, 0x5d,
+	0xfa, 0x3a, 0x00, 0x2c, 0x53, 0xd0, 0x25, 0x8f, 0x09, 0x00, 0x00,
 }
 
 func (m *KeygenStarted) Marshal() (dAtA []byte, err error) {
@@ -717,6 +734,11 @@ func (m *KeygenStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Participants) > 0 {
 		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Participants[iNdEx])
@@ -888,6 +910,11 @@ func (m *SigningStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.RequestingModule) > 0 {
 		i -= len(m.RequestingModule)
 		copy(dAtA[i:], m.RequestingModule)
@@ -1188,6 +1215,9 @@ func (m *KeygenStarted) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -1287,6 +1317,9 @@ func (m *SigningStarted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -1519,6 +1552,25 @@ func (m *KeygenStarted) Unmarshal(dAtA []byte) error {
 			m.Participants = append(m.Participants, make([]byte, postIndex-iNdEx))
 			copy(m.Participants[len(m.Participants)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2256,6 +2308,25 @@ func (m *SigningStarted) Unmarshal(dAtA []byte) error {
 			}
 			m.RequestingModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -381,3 +381,35 @@ func getCmdRecipientAddress() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdTrace returns the cli command to trace cross-chain transfers across modules
+func GetCmdTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace [transfer ID | source chain] [tx ID]",
+		Short: "Returns the lifecycle of the cross-chain transfers started by the given source tx on the given chain, or of the transfer with the given ID",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			req := types.TraceRequest{Id: args[0]}
+			if len(args) == 2 {
+				req = types.TraceRequest{Chain: nexus.ChainName(args[0]), Id: args[1]}
+			}
+
+			res, err := queryClient.Trace(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
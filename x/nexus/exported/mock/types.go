@@ -547,3 +547,178 @@ func (mock *MaintainerStateMock) UnmarshalCalls() []struct {
 	mock.lockUnmarshal.RUnlock()
 	return calls
 }
+
+// Ensure, that TransferTracerMock does implement exported.TransferTracer.
+// If this is not the case, regenerate this file with moq.
+var _ exported.TransferTracer = &TransferTracerMock{}
+
+// TransferTracerMock is a mock implementation of exported.TransferTracer.
+//
+// 	func TestSomethingThatUsesTransferTracer(t *testing.T) {
+//
+// 		// make and configure a mocked exported.TransferTracer
+// 		mockedTransferTracer := &TransferTracerMock{
+// 			TraceDestinationFunc: func(ctx types.Context, transfer exported.CrossChainTransfer) []exported.TraceStep {
+// 				panic("mock out the TraceDestination method")
+// 			},
+// 			TraceSourceFunc: func(ctx types.Context, id exported.TransferID) []exported.TraceStep {
+// 				panic("mock out the TraceSource method")
+// 			},
+// 			TraceSourceTxFunc: func(ctx types.Context, chain exported.ChainName, txID string) ([]exported.TraceStep, []exported.TransferID) {
+// 				panic("mock out the TraceSourceTx method")
+// 			},
+// 		}
+//
+// 		// use mockedTransferTracer in code that requires exported.TransferTracer
+// 		// and then make assertions.
+//
+// 	}
+type TransferTracerMock struct {
+	// TraceDestinationFunc mocks the TraceDestination method.
+	TraceDestinationFunc func(ctx types.Context, transfer exported.CrossChainTransfer) []exported.TraceStep
+
+	// TraceSourceFunc mocks the TraceSource method.
+	TraceSourceFunc func(ctx types.Context, id exported.TransferID) []exported.TraceStep
+
+	// TraceSourceTxFunc mocks the TraceSourceTx method.
+	TraceSourceTxFunc func(ctx types.Context, chain exported.ChainName, txID string) ([]exported.TraceStep, []exported.TransferID)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// TraceDestination holds details about calls to the TraceDestination method.
+		TraceDestination []struct {
+			// Ctx is the ctx argument value.
+			Ctx types.Context
+			// Transfer is the transfer argument value.
+			Transfer exported.CrossChainTransfer
+		}
+		// TraceSource holds details about calls to the TraceSource method.
+		TraceSource []struct {
+			// Ctx is the ctx argument value.
+			Ctx types.Context
+			// ID is the id argument value.
+			ID exported.TransferID
+		}
+		// TraceSourceTx holds details about calls to the TraceSourceTx method.
+		TraceSourceTx []struct {
+			// Ctx is the ctx argument value.
+			Ctx types.Context
+			// Chain is the chain argument value.
+			Chain exported.ChainName
+			// TxID is the txID argument value.
+			TxID string
+		}
+	}
+	lockTraceDestination sync.RWMutex
+	lockTraceSource      sync.RWMutex
+	lockTraceSourceTx    sync.RWMutex
+}
+
+// TraceDestination calls TraceDestinationFunc.
+func (mock *TransferTracerMock) TraceDestination(ctx types.Context, transfer exported.CrossChainTransfer) []exported.TraceStep {
+	if mock.TraceDestinationFunc == nil {
+		panic("TransferTracerMock.TraceDestinationFunc: method is nil but TransferTracer.TraceDestination was just called")
+	}
+	callInfo := struct {
+		Ctx      types.Context
+		Transfer exported.CrossChainTransfer
+	}{
+		Ctx:      ctx,
+		Transfer: transfer,
+	}
+	mock.lockTraceDestination.Lock()
+	mock.calls.TraceDestination = append(mock.calls.TraceDestination, callInfo)
+	mock.lockTraceDestination.Unlock()
+	return mock.TraceDestinationFunc(ctx, transfer)
+}
+
+// TraceDestinationCalls gets all the calls that were made to TraceDestination.
+// Check the length with:
+//     len(mockedTransferTracer.TraceDestinationCalls())
+func (mock *TransferTracerMock) TraceDestinationCalls() []struct {
+	Ctx      types.Context
+	Transfer exported.CrossChainTransfer
+} {
+	var calls []struct {
+		Ctx      types.Context
+		Transfer exported.CrossChainTransfer
+	}
+	mock.lockTraceDestination.RLock()
+	calls = mock.calls.TraceDestination
+	mock.lockTraceDestination.RUnlock()
+	return calls
+}
+
+// TraceSource calls TraceSourceFunc.
+func (mock *TransferTracerMock) TraceSource(ctx types.Context, id exported.TransferID) []exported.TraceStep {
+	if mock.TraceSourceFunc == nil {
+		panic("TransferTracerMock.TraceSourceFunc: method is nil but TransferTracer.TraceSource was just called")
+	}
+	callInfo := struct {
+		Ctx types.Context
+		ID  exported.TransferID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockTraceSource.Lock()
+	mock.calls.TraceSource = append(mock.calls.TraceSource, callInfo)
+	mock.lockTraceSource.Unlock()
+	return mock.TraceSourceFunc(ctx, id)
+}
+
+// TraceSourceCalls gets all the calls that were made to TraceSource.
+// Check the length with:
+//     len(mockedTransferTracer.TraceSourceCalls())
+func (mock *TransferTracerMock) TraceSourceCalls() []struct {
+	Ctx types.Context
+	ID  exported.TransferID
+} {
+	var calls []struct {
+		Ctx types.Context
+		ID  exported.TransferID
+	}
+	mock.lockTraceSource.RLock()
+	calls = mock.calls.TraceSource
+	mock.lockTraceSource.RUnlock()
+	return calls
+}
+
+// TraceSourceTx calls TraceSourceTxFunc.
+func (mock *TransferTracerMock) TraceSourceTx(ctx types.Context, chain exported.ChainName, txID string) ([]exported.TraceStep, []exported.TransferID) {
+	if mock.TraceSourceTxFunc == nil {
+		panic("TransferTracerMock.TraceSourceTxFunc: method is nil but TransferTracer.TraceSourceTx was just called")
+	}
+	callInfo := struct {
+		Ctx   types.Context
+		Chain exported.ChainName
+		TxID  string
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+		TxID:  txID,
+	}
+	mock.lockTraceSourceTx.Lock()
+	mock.calls.TraceSourceTx = append(mock.calls.TraceSourceTx, callInfo)
+	mock.lockTraceSourceTx.Unlock()
+	return mock.TraceSourceTxFunc(ctx, chain, txID)
+}
+
+// TraceSourceTxCalls gets all the calls that were made to TraceSourceTx.
+// Check the length with:
+//     len(mockedTransferTracer.TraceSourceTxCalls())
+func (mock *TransferTracerMock) TraceSourceTxCalls() []struct {
+	Ctx   types.Context
+	Chain exported.ChainName
+	TxID  string
+} {
+	var calls []struct {
+		Ctx   types.Context
+		Chain exported.ChainName
+		TxID  string
+	}
+	mock.lockTraceSourceTx.RLock()
+	calls = mock.calls.TraceSourceTx
+	mock.lockTraceSourceTx.RUnlock()
+	return calls
+}
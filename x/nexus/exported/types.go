@@ -13,11 +13,21 @@ import (
 	"github.com/axelarnetwork/axelar-core/utils"
 )
 
-//go:generate moq -out ./mock/types.go -pkg mock . MaintainerState
+//go:generate moq -out ./mock/types.go -pkg mock . MaintainerState TransferTracer
 
 // AddressValidator defines a function that implements address verification upon a request to link addresses
 type AddressValidator func(ctx sdk.Context, address CrossChainAddress) error
 
+// TransferTracer finds the records a chain module keeps about the cross-chain transfers from and to its chains
+type TransferTracer interface {
+	// TraceSourceTx returns the records of the given source tx on the given chain and the IDs of the transfers it started
+	TraceSourceTx(ctx sdk.Context, chain ChainName, txID string) ([]TraceStep, []TransferID)
+	// TraceSource returns the records of the source tx that started the given transfer
+	TraceSource(ctx sdk.Context, id TransferID) []TraceStep
+	// TraceDestination returns the records of the given transfer on its recipient chain
+	TraceDestination(ctx sdk.Context, transfer CrossChainTransfer) []TraceStep
+}
+
 // TransferStateFromString converts a describing state string to the corresponding TransferState
 func TransferStateFromString(s string) TransferState {
 	state, ok := TransferState_value["TRANSFER_STATE_"+strings.ToUpper(s)]
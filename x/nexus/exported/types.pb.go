@@ -296,6 +296,54 @@ func (m *Asset) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Asset proto.InternalMessageInfo
 
+// TraceStep represents a record a module keeps about a cross-chain transfer,
+// such as the event of the source tx or the command that mints the assets on
+// the recipient chain
+type TraceStep struct {
+	// module is the name of the module that keeps the record
+	Module string    `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Chain  ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=ChainName" json:"chain,omitempty"`
+	// type is the kind of the record, e.g. token_sent, deposit, command or
+	// ibc_transfer
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ID   string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// status is the status of the record as reported by the module
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *TraceStep) Reset()         { *m = TraceStep{} }
+func (m *TraceStep) String() string { return proto.CompactTextString(m) }
+func (*TraceStep) ProtoMessage()    {}
+func (*TraceStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a7a8692925fe67, []int{6}
+}
+func (m *TraceStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraceStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceStep.Merge(m, src)
+}
+func (m *TraceStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceStep proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("axelar.nexus.exported.v1beta1.TransferState", TransferState_name, TransferState_value)
 	proto.RegisterType((*Chain)(nil), "axelar.nexus.exported.v1beta1.Chain")
@@ -304,6 +352,7 @@ func init() {
 	proto.RegisterType((*TransferFee)(nil), "axelar.nexus.exported.v1beta1.TransferFee")
 	proto.RegisterType((*FeeInfo)(nil), "axelar.nexus.exported.v1beta1.FeeInfo")
 	proto.RegisterType((*Asset)(nil), "axelar.nexus.exported.v1beta1.Asset")
+	proto.RegisterType((*TraceStep)(nil), "axelar.nexus.exported.v1beta1.TraceStep")
 }
 
 func init() {
@@ -311,58 +360,61 @@ func init() {
 }
 
 var fileDescriptor_82a7a8692925fe67 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x3a, 0x76, 0xec, 0x4c, 0x1a, 0x30, 0xa3, 0x90, 0x1a, 0x4b, 0xac, 0x8d, 0xa9, 0xda,
-	0x14, 0xd1, 0x75, 0x5b, 0x54, 0x8e, 0x80, 0xff, 0x96, 0xa5, 0x62, 0x89, 0xd6, 0x0e, 0x07, 0x2e,
-	0xab, 0xc9, 0xee, 0xb3, 0x33, 0x4a, 0x77, 0xc6, 0xda, 0x19, 0x07, 0xe7, 0x1b, 0xa0, 0x70, 0xe1,
-	0x0b, 0xe4, 0x80, 0xe0, 0x80, 0xf8, 0x16, 0xdc, 0x72, 0x41, 0xea, 0x11, 0x71, 0x30, 0x90, 0x7c,
-	0x8b, 0x9e, 0xd0, 0xcc, 0xec, 0xe2, 0xe0, 0xa2, 0x06, 0x38, 0xed, 0xbe, 0x7d, 0xef, 0xfd, 0xde,
-	0xef, 0xfd, 0xde, 0x7b, 0x8b, 0xee, 0x92, 0x39, 0x3c, 0x25, 0x49, 0x8b, 0xc1, 0x7c, 0x26, 0x5a,
-	0x30, 0x9f, 0xf2, 0x44, 0x42, 0xd4, 0x3a, 0x7e, 0x70, 0x00, 0x92, 0x3c, 0x68, 0xc9, 0x93, 0x29,
-	0x08, 0x67, 0x9a, 0x70, 0xc9, 0xf1, 0x9b, 0x26, 0xd4, 0xd1, 0xa1, 0x4e, 0x16, 0xea, 0xa4, 0xa1,
-	0xb5, 0xed, 0x09, 0x9f, 0x70, 0x1d, 0xd9, 0x52, 0x6f, 0x26, 0xa9, 0x66, 0x87, 0x5c, 0xc4, 0x5c,
-	0xb4, 0x0e, 0x88, 0x80, 0xbf, 0x50, 0x43, 0x4e, 0x59, 0xea, 0xbf, 0x93, 0xd6, 0x97, 0xe2, 0xe5,
-	0xd5, 0x9b, 0x3f, 0x59, 0xa8, 0xd8, 0x3d, 0x24, 0x94, 0xe1, 0xb7, 0x50, 0x81, 0x91, 0x18, 0xaa,
-	0x56, 0xc3, 0xda, 0xdd, 0xe8, 0x6c, 0x3d, 0x5f, 0xd4, 0x37, 0xb4, 0xc3, 0x23, 0x31, 0xf8, 0xda,
-	0x85, 0xdf, 0x47, 0x37, 0xc5, 0x6c, 0xaa, 0xd0, 0x44, 0x30, 0xe6, 0x09, 0xd0, 0x09, 0x0b, 0x88,
-	0x10, 0x20, 0x45, 0x75, 0xad, 0x61, 0xed, 0x96, 0xfd, 0xd7, 0x33, 0xf7, 0xc0, 0x78, 0xdb, 0xda,
-	0x89, 0x3f, 0x44, 0xe5, 0x23, 0x38, 0x09, 0x54, 0xdd, 0x6a, 0xa1, 0x61, 0xed, 0xbe, 0xf2, 0xf0,
-	0x96, 0x93, 0x76, 0x2d, 0xc5, 0x8b, 0x3d, 0x3b, 0x4f, 0xe0, 0x64, 0x74, 0x32, 0x05, 0xbf, 0x74,
-	0x64, 0x5e, 0xf0, 0x0e, 0x5a, 0x8f, 0x79, 0x34, 0x7b, 0x0a, 0xd5, 0xa2, 0x62, 0xe7, 0xa7, 0xd6,
-	0x27, 0x85, 0x72, 0xbe, 0xb2, 0xd6, 0xe4, 0xe8, 0xb5, 0x6e, 0xc2, 0x85, 0xd0, 0x74, 0xdb, 0x51,
-	0x94, 0x80, 0x10, 0xf8, 0x23, 0x54, 0x0c, 0x95, 0xad, 0xfb, 0xd9, 0x5c, 0x16, 0xfc, 0x67, 0x99,
-	0x1d, 0x9d, 0xdb, 0x29, 0x9c, 0x2f, 0xea, 0x39, 0xdf, 0x24, 0xe2, 0x2a, 0x2a, 0x11, 0x03, 0x56,
-	0xcd, 0xeb, 0xaa, 0x99, 0xd9, 0xfc, 0x3a, 0x8f, 0xf0, 0xb2, 0xe2, 0x28, 0x21, 0x4c, 0x8c, 0x21,
-	0xc1, 0x23, 0xb4, 0x91, 0x40, 0x48, 0xa7, 0x14, 0x98, 0x4c, 0xcb, 0xde, 0xbf, 0xae, 0xec, 0x2a,
-	0xef, 0x94, 0xc2, 0x12, 0x08, 0x3f, 0x42, 0x45, 0xad, 0xb1, 0x26, 0xb1, 0xf9, 0xf0, 0x0d, 0xc7,
-	0x8c, 0xde, 0x51, 0xa3, 0x5f, 0xe2, 0xf0, 0x25, 0x7b, 0x1d, 0x8d, 0x6f, 0xa1, 0x3c, 0x8d, 0xf4,
-	0x58, 0x0a, 0x9d, 0xed, 0x8b, 0x45, 0x3d, 0xef, 0xf6, 0x9e, 0x2f, 0xea, 0x28, 0x23, 0xeb, 0xf6,
-	0xfc, 0x3c, 0x8d, 0x70, 0x07, 0x15, 0x85, 0x24, 0x32, 0x1b, 0xcb, 0xbb, 0xd7, 0xd0, 0xcd, 0xb2,
-	0x87, 0x2a, 0xc7, 0x37, 0xa9, 0xcd, 0x29, 0xda, 0xcc, 0xbe, 0x0f, 0x00, 0x30, 0x41, 0x45, 0xb5,
-	0x88, 0xa2, 0x6a, 0x35, 0xd6, 0x5e, 0xce, 0xf7, 0xbe, 0xe2, 0xfb, 0xe3, 0x6f, 0xf5, 0xdd, 0x09,
-	0x95, 0x87, 0xb3, 0x03, 0x27, 0xe4, 0x71, 0x2b, 0xdd, 0x6b, 0xf3, 0xb8, 0x27, 0xa2, 0xa3, 0x74,
-	0x5b, 0x55, 0x82, 0xf0, 0x0d, 0x72, 0xf3, 0xdb, 0x3c, 0x2a, 0x0d, 0x00, 0x5c, 0x36, 0xe6, 0xf8,
-	0xed, 0xab, 0x73, 0x7e, 0x61, 0x6f, 0xd3, 0x51, 0x6e, 0x5f, 0xd5, 0x70, 0x23, 0x93, 0xc8, 0x45,
-	0xe5, 0x31, 0x40, 0x90, 0xa8, 0xfe, 0x95, 0x50, 0x37, 0x3a, 0x8e, 0x62, 0xf4, 0xeb, 0xa2, 0x7e,
-	0xfb, 0x5f, 0x30, 0xea, 0x41, 0xe8, 0x97, 0xc6, 0x00, 0x3e, 0x91, 0x80, 0x1f, 0xa3, 0x52, 0x4c,
-	0x59, 0x30, 0x06, 0xa3, 0xe4, 0x7f, 0x43, 0x72, 0x99, 0xf4, 0xd7, 0x63, 0xca, 0x94, 0x7a, 0x0a,
-	0x88, 0xcc, 0x35, 0x50, 0xf1, 0x7f, 0x02, 0x91, 0xf9, 0x00, 0xa0, 0xf9, 0x04, 0x15, 0xf5, 0xf5,
-	0xa9, 0xde, 0x23, 0x60, 0x3c, 0x36, 0x02, 0xf9, 0xc6, 0xc0, 0xb7, 0xd1, 0xab, 0x54, 0x04, 0x8c,
-	0x48, 0x7a, 0x0c, 0xe6, 0x86, 0xd3, 0x13, 0xde, 0xa2, 0xc2, 0xd3, 0x5f, 0x75, 0xb6, 0xb9, 0xb0,
-	0x77, 0x7e, 0xb6, 0xd0, 0xd6, 0xdf, 0x66, 0x8f, 0x6d, 0x54, 0x1b, 0xf9, 0x6d, 0x6f, 0x38, 0xe8,
-	0xfb, 0xc1, 0x70, 0xd4, 0x1e, 0xf5, 0x83, 0x7d, 0x6f, 0xb8, 0xd7, 0xef, 0xba, 0x03, 0xb7, 0xdf,
-	0xab, 0xe4, 0xf0, 0x1d, 0xb4, 0xb3, 0xe2, 0xdf, 0xeb, 0x7b, 0x3d, 0xd7, 0x7b, 0x5c, 0xb1, 0x6a,
-	0x9b, 0xa7, 0x67, 0x8d, 0xd2, 0x1e, 0xb0, 0x88, 0xb2, 0x09, 0xbe, 0x8b, 0x6e, 0xae, 0x04, 0xb6,
-	0xfd, 0xee, 0xc7, 0xee, 0xe7, 0xfd, 0x5e, 0x25, 0x5f, 0xbb, 0x71, 0x7a, 0xd6, 0x28, 0xb7, 0x93,
-	0xf0, 0x90, 0x1e, 0x43, 0x84, 0x3f, 0x40, 0xcd, 0x95, 0x50, 0xd7, 0x1b, 0xee, 0x0f, 0x06, 0x6e,
-	0xd7, 0xed, 0x7b, 0xa3, 0xa0, 0xfd, 0xe9, 0x67, 0xfb, 0xde, 0xa8, 0xb2, 0x56, 0xdb, 0x39, 0x3d,
-	0x6b, 0x60, 0x97, 0x89, 0xd9, 0x78, 0x4c, 0x43, 0x75, 0x43, 0xed, 0x98, 0xcf, 0x98, 0xac, 0x95,
-	0xbf, 0xfa, 0xce, 0xce, 0xfd, 0xf0, 0xbd, 0x6d, 0x75, 0x86, 0xe7, 0x7f, 0xd8, 0xb9, 0xf3, 0x0b,
-	0xdb, 0x7a, 0x76, 0x61, 0x5b, 0xbf, 0x5f, 0xd8, 0xd6, 0x37, 0x97, 0x76, 0xee, 0xd9, 0xa5, 0x9d,
-	0xfb, 0xe5, 0xd2, 0xce, 0x7d, 0xf1, 0xe8, 0x8a, 0xd4, 0xe6, 0x1e, 0x18, 0xc8, 0x2f, 0x79, 0x72,
-	0x94, 0x5a, 0xf7, 0x42, 0x9e, 0x40, 0x6b, 0xbe, 0xf2, 0x73, 0x3f, 0x58, 0xd7, 0x7f, 0xd4, 0xf7,
-	0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x80, 0x48, 0xe7, 0x07, 0xfc, 0x05, 0x00, 0x00,
+	// 860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x8e, 0xdb, 0x54,
+	0x14, 0x8e, 0x9d, 0xff, 0x3b, 0x1d, 0x08, 0x57, 0x43, 0x1a, 0x22, 0xe1, 0x84, 0x50, 0xb5, 0x53,
+	0x44, 0x9d, 0xb6, 0xa8, 0x2c, 0x81, 0xfc, 0xb9, 0x98, 0x0a, 0x33, 0x72, 0x3c, 0x2c, 0xd8, 0x58,
+	0x1e, 0xfb, 0x24, 0x63, 0x4d, 0x7d, 0x6f, 0xe4, 0x7b, 0x33, 0x64, 0xde, 0x00, 0x0d, 0x2c, 0x78,
+	0x81, 0x59, 0x20, 0x58, 0x20, 0xde, 0x82, 0xdd, 0x6c, 0x90, 0xba, 0x44, 0x2c, 0x02, 0xcc, 0xbc,
+	0x45, 0x57, 0xc8, 0xf7, 0xda, 0x24, 0xa4, 0x68, 0x06, 0xba, 0x8a, 0x8f, 0xcf, 0xf9, 0xbe, 0xf3,
+	0x9d, 0x3f, 0x07, 0xdd, 0xf5, 0x16, 0xf0, 0xd4, 0x8b, 0xbb, 0x04, 0x16, 0x73, 0xd6, 0x85, 0xc5,
+	0x8c, 0xc6, 0x1c, 0x82, 0xee, 0xf1, 0x83, 0x03, 0xe0, 0xde, 0x83, 0x2e, 0x3f, 0x99, 0x01, 0xd3,
+	0x67, 0x31, 0xe5, 0x14, 0xbf, 0x29, 0x43, 0x75, 0x11, 0xaa, 0x67, 0xa1, 0x7a, 0x1a, 0xda, 0xdc,
+	0x99, 0xd2, 0x29, 0x15, 0x91, 0xdd, 0xe4, 0x49, 0x82, 0x9a, 0x9a, 0x4f, 0x59, 0x44, 0x59, 0xf7,
+	0xc0, 0x63, 0xf0, 0x37, 0xab, 0x4f, 0x43, 0x92, 0xfa, 0xef, 0xa4, 0xf9, 0x39, 0xbb, 0x3a, 0x7b,
+	0xe7, 0x67, 0x05, 0x15, 0x07, 0x87, 0x5e, 0x48, 0xf0, 0x5b, 0xa8, 0x40, 0xbc, 0x08, 0x1a, 0x4a,
+	0x5b, 0xd9, 0xad, 0xf6, 0xb7, 0x9f, 0x2f, 0x5b, 0x55, 0xe1, 0xb0, 0xbc, 0x08, 0x6c, 0xe1, 0xc2,
+	0xef, 0xa3, 0x9b, 0x6c, 0x3e, 0x4b, 0xd8, 0x98, 0x3b, 0xa1, 0x31, 0x84, 0x53, 0xe2, 0x7a, 0x8c,
+	0x01, 0x67, 0x8d, 0x7c, 0x5b, 0xd9, 0xad, 0xd8, 0xaf, 0x67, 0x6e, 0x43, 0x7a, 0x7b, 0xc2, 0x89,
+	0x3f, 0x44, 0x95, 0x23, 0x38, 0x71, 0x93, 0xbc, 0x8d, 0x42, 0x5b, 0xd9, 0x7d, 0xe5, 0xe1, 0x2d,
+	0x3d, 0xad, 0x9a, 0xb3, 0x17, 0x6b, 0xd6, 0x9f, 0xc0, 0x89, 0x73, 0x32, 0x03, 0xbb, 0x7c, 0x24,
+	0x1f, 0x70, 0x1d, 0x95, 0x22, 0x1a, 0xcc, 0x9f, 0x42, 0xa3, 0x98, 0xa8, 0xb3, 0x53, 0xeb, 0x93,
+	0x42, 0x45, 0xad, 0xe5, 0x3b, 0x14, 0xbd, 0x36, 0x88, 0x29, 0x63, 0x42, 0x6e, 0x2f, 0x08, 0x62,
+	0x60, 0x0c, 0x7f, 0x84, 0x8a, 0x7e, 0x62, 0x8b, 0x7a, 0xb6, 0x56, 0x09, 0xff, 0xbd, 0xcd, 0xba,
+	0xc0, 0xf6, 0x0b, 0xe7, 0xcb, 0x56, 0xce, 0x96, 0x40, 0xdc, 0x40, 0x65, 0x4f, 0x92, 0x35, 0x54,
+	0x91, 0x35, 0x33, 0x3b, 0x5f, 0xab, 0x08, 0xaf, 0x32, 0x3a, 0xb1, 0x47, 0xd8, 0x04, 0x62, 0xec,
+	0xa0, 0x6a, 0x0c, 0x7e, 0x38, 0x0b, 0x81, 0xf0, 0x34, 0xed, 0xfd, 0xeb, 0xd2, 0x6e, 0xea, 0x4e,
+	0x25, 0xac, 0x88, 0xf0, 0x23, 0x54, 0x14, 0x3d, 0x16, 0x22, 0xb6, 0x1e, 0xbe, 0xa1, 0xcb, 0xd1,
+	0xeb, 0xc9, 0xe8, 0x57, 0x3c, 0x74, 0xa5, 0x5e, 0x44, 0xe3, 0x5b, 0x48, 0x0d, 0x03, 0x31, 0x96,
+	0x42, 0x7f, 0xe7, 0x62, 0xd9, 0x52, 0xcd, 0xe1, 0xf3, 0x65, 0x0b, 0x65, 0x62, 0xcd, 0xa1, 0xad,
+	0x86, 0x01, 0xee, 0xa3, 0x22, 0xe3, 0x1e, 0xcf, 0xc6, 0xf2, 0xee, 0x35, 0x72, 0x33, 0xf4, 0x38,
+	0xc1, 0xd8, 0x12, 0xda, 0x99, 0xa1, 0xad, 0xec, 0xbd, 0x01, 0x80, 0x3d, 0x54, 0x4c, 0x16, 0x91,
+	0x35, 0x94, 0x76, 0xfe, 0x6a, 0xbd, 0xf7, 0x13, 0xbd, 0x3f, 0xfd, 0xde, 0xda, 0x9d, 0x86, 0xfc,
+	0x70, 0x7e, 0xa0, 0xfb, 0x34, 0xea, 0xa6, 0x7b, 0x2d, 0x7f, 0xee, 0xb1, 0xe0, 0x28, 0xdd, 0xd6,
+	0x04, 0xc0, 0x6c, 0xc9, 0xdc, 0xf9, 0x4e, 0x45, 0x65, 0x03, 0xc0, 0x24, 0x13, 0x8a, 0xdf, 0x5e,
+	0x9f, 0xf3, 0x0b, 0x7b, 0x9b, 0x8e, 0x72, 0x67, 0xbd, 0x87, 0xd5, 0xac, 0x45, 0x26, 0xaa, 0x4c,
+	0x00, 0xdc, 0x38, 0xa9, 0x3f, 0x69, 0xd4, 0x8d, 0xbe, 0x9e, 0x28, 0xfa, 0x6d, 0xd9, 0xba, 0xfd,
+	0x1f, 0x14, 0x0d, 0xc1, 0xb7, 0xcb, 0x13, 0x00, 0xdb, 0xe3, 0x80, 0x1f, 0xa3, 0x72, 0x14, 0x12,
+	0x77, 0x02, 0xb2, 0x93, 0xff, 0x8f, 0xc9, 0x24, 0xdc, 0x2e, 0x45, 0x21, 0x31, 0x40, 0x12, 0x79,
+	0x0b, 0x41, 0x54, 0x7c, 0x49, 0x22, 0x6f, 0x61, 0x00, 0x74, 0x9e, 0xa0, 0xa2, 0xb8, 0xbe, 0xa4,
+	0xf6, 0x00, 0x08, 0x8d, 0x64, 0x83, 0x6c, 0x69, 0xe0, 0xdb, 0xe8, 0xd5, 0x90, 0xb9, 0xc4, 0xe3,
+	0xe1, 0x31, 0xc8, 0x1b, 0x4e, 0x4f, 0x78, 0x3b, 0x64, 0x96, 0x78, 0x2b, 0xd0, 0xe9, 0x85, 0x7d,
+	0xa3, 0xa0, 0xaa, 0x13, 0x7b, 0x3e, 0x8c, 0x39, 0xcc, 0xd6, 0xae, 0x51, 0x59, 0xbf, 0xc6, 0xd5,
+	0x28, 0xd4, 0x2b, 0x46, 0x81, 0x51, 0x41, 0x7c, 0x07, 0xf2, 0x02, 0x2a, 0x9e, 0x71, 0x5d, 0xec,
+	0x6a, 0x41, 0xa0, 0x4a, 0x72, 0x57, 0xc5, 0x76, 0xd6, 0x51, 0x29, 0x59, 0xb1, 0x39, 0xcb, 0xce,
+	0x5e, 0x5a, 0xef, 0xfc, 0xa2, 0xa0, 0xed, 0x7f, 0xac, 0x22, 0xd6, 0x50, 0xd3, 0xb1, 0x7b, 0xd6,
+	0xd8, 0x18, 0xd9, 0xee, 0xd8, 0xe9, 0x39, 0x23, 0x77, 0xdf, 0x1a, 0xef, 0x8d, 0x06, 0xa6, 0x61,
+	0x8e, 0x86, 0xb5, 0x1c, 0xbe, 0x83, 0xea, 0x1b, 0xfe, 0xbd, 0x91, 0x35, 0x34, 0xad, 0xc7, 0x35,
+	0xa5, 0xb9, 0x75, 0x7a, 0xd6, 0x2e, 0xef, 0x01, 0x09, 0x42, 0x32, 0xc5, 0x77, 0xd1, 0xcd, 0x8d,
+	0xc0, 0x9e, 0x3d, 0xf8, 0xd8, 0xfc, 0x7c, 0x34, 0xac, 0xa9, 0xcd, 0x1b, 0xa7, 0x67, 0xed, 0x4a,
+	0x2f, 0xf6, 0x0f, 0xc3, 0x63, 0x08, 0xf0, 0x07, 0xa8, 0xb3, 0x11, 0x6a, 0x5a, 0xe3, 0x7d, 0xc3,
+	0x30, 0x07, 0xe6, 0xc8, 0x72, 0xdc, 0xde, 0xa7, 0x9f, 0xed, 0x5b, 0x4e, 0x2d, 0xdf, 0xac, 0x9f,
+	0x9e, 0xb5, 0xb1, 0x49, 0xd8, 0x7c, 0x32, 0x09, 0xfd, 0xe4, 0xa4, 0x7b, 0x11, 0x9d, 0x13, 0xde,
+	0xac, 0x7c, 0xf5, 0xbd, 0x96, 0xfb, 0xf1, 0x07, 0x4d, 0xe9, 0x8f, 0xcf, 0xff, 0xd4, 0x72, 0xe7,
+	0x17, 0x9a, 0xf2, 0xec, 0x42, 0x53, 0xfe, 0xb8, 0xd0, 0x94, 0x6f, 0x2f, 0xb5, 0xdc, 0xb3, 0x4b,
+	0x2d, 0xf7, 0xeb, 0xa5, 0x96, 0xfb, 0xe2, 0xd1, 0xda, 0xe4, 0xe5, 0x79, 0x12, 0xe0, 0x5f, 0xd2,
+	0xf8, 0x28, 0xb5, 0xee, 0xf9, 0x34, 0x86, 0xee, 0x62, 0xe3, 0xbf, 0xe6, 0xa0, 0x24, 0x3e, 0xf0,
+	0xef, 0xfd, 0x35, 0x00, 0xbd, 0x64, 0xac, 0x44, 0x8b, 0x06, 0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -654,6 +706,64 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TraceStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -776,6 +886,35 @@ func (m *Asset) Size() (n int) {
 	return n
 }
 
+func (m *TraceStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1603,6 +1742,216 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TraceStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
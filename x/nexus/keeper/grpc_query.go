@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -233,3 +234,73 @@ func (q Querier) RecipientAddress(c context.Context, req *types.RecipientAddress
 		RecipientChain: linkedAddresses.RecipientAddress.Chain.Name.String(),
 	}, nil
 }
+
+// Trace returns the lifecycle of the cross-chain transfers started by a source tx, or of a single transfer
+func (q Querier) Trace(c context.Context, req *types.TraceRequest) (*types.TraceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if id, err := strconv.ParseUint(req.Id, 10, 64); err == nil {
+		transferID := nexus.TransferID(id)
+
+		trace, ok := q.traceTransfer(ctx, transferID)
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrNexus, "transfer %s not found", transferID.String())
+		}
+
+		var source []nexus.TraceStep
+		for _, tracer := range q.keeper.GetRouter().GetTransferTracers() {
+			source = append(source, tracer.TraceSource(ctx, transferID)...)
+		}
+
+		return &types.TraceResponse{Source: source, Transfers: []types.TransferTrace{trace}}, nil
+	}
+
+	chain, ok := q.keeper.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNexus, "source chain %s of tx %s not found", req.Chain, req.Id)
+	}
+
+	router := q.keeper.GetRouter()
+	if !router.HasTransferTracer(chain.Module) {
+		return nil, sdkerrors.Wrapf(types.ErrNexus, "transfers of chain %s cannot be traced", chain.Name)
+	}
+
+	source, transferIDs := router.GetTransferTracer(chain.Module).TraceSourceTx(ctx, chain.Name, req.Id)
+
+	var transfers []types.TransferTrace
+	for _, transferID := range transferIDs {
+		if trace, ok := q.traceTransfer(ctx, transferID); ok {
+			transfers = append(transfers, trace)
+		}
+	}
+
+	if len(source) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNexus, "no cross-chain records found for tx %s", req.Id)
+	}
+
+	return &types.TraceResponse{Source: source, Transfers: transfers}, nil
+}
+
+// traceTransfer follows the transfer with the given ID through all merges to the transfer that carries its assets now
+func (q Querier) traceTransfer(ctx sdk.Context, id nexus.TransferID) (types.TransferTrace, bool) {
+	current := id
+	for {
+		transfer, ok := q.keeper.GetTransfer(ctx, current)
+		if ok {
+			trace := types.TransferTrace{ID: id, Transfer: transfer}
+			if fee, ok := q.keeper.GetTransferFeeDeducted(ctx, id); ok {
+				trace.Fee = &fee
+			}
+
+			if router := q.keeper.GetRouter(); router.HasTransferTracer(transfer.Recipient.Chain.Module) {
+				trace.Destination = router.GetTransferTracer(transfer.Recipient.Chain.Module).TraceDestination(ctx, transfer)
+			}
+
+			return trace, true
+		}
+
+		if current, ok = q.keeper.GetMergedTransferID(ctx, current); !ok {
+			return types.TransferTrace{}, false
+		}
+	}
+}
@@ -20,8 +20,10 @@ import (
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported/mock"
 	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -169,3 +171,162 @@ func TestKeeper_Chains(t *testing.T) {
 		).Run(t)
 
 }
+
+func TestKeeper_Trace(t *testing.T) {
+	var (
+		k               nexusKeeper.Keeper
+		axelarnetKeeper types.AxelarnetKeeper
+		q               nexusKeeper.Querier
+		ctx             sdk.Context
+		sender          exported.CrossChainAddress
+		transferID      exported.TransferID
+		txID            string
+		sourceStep      exported.TraceStep
+		destinationStep exported.TraceStep
+		axelarnetTracer *mock.TransferTracerMock
+	)
+
+	givenKeeper := Given("a nexus keeper with transfer tracers", func() {
+		encCfg := app.MakeEncodingConfig()
+		nexusSubspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, sdk.NewKVStoreKey("nexusKey"), sdk.NewKVStoreKey("tNexusKey"), "nexus")
+		k = nexusKeeper.NewKeeper(encCfg.Codec, sdk.NewKVStoreKey("nexus"), nexusSubspace)
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.TestingLogger())
+
+		k.SetParams(ctx, types.DefaultParams())
+		k.SetChain(ctx, evm.Ethereum)
+		k.ActivateChain(ctx, evm.Ethereum)
+		k.SetChain(ctx, axelarnet.Axelarnet)
+		k.ActivateChain(ctx, axelarnet.Axelarnet)
+
+		txID = rand.HexStr(64)
+		sourceStep = exported.TraceStep{Module: evm.Ethereum.Module, Chain: evm.Ethereum.Name, Type: "token_sent", ID: txID + "-1", Status: rand.Str(10)}
+		destinationStep = exported.TraceStep{Module: axelarnet.Axelarnet.Module, Chain: axelarnet.Axelarnet.Name, Type: "ibc_transfer", Status: rand.Str(10)}
+
+		evmTracer := &mock.TransferTracerMock{
+			TraceSourceTxFunc: func(_ sdk.Context, chain exported.ChainName, id string) ([]exported.TraceStep, []exported.TransferID) {
+				if chain != evm.Ethereum.Name || id != txID {
+					return nil, nil
+				}
+				return []exported.TraceStep{sourceStep}, []exported.TransferID{transferID}
+			},
+			TraceSourceFunc: func(_ sdk.Context, id exported.TransferID) []exported.TraceStep {
+				if id != transferID {
+					return nil
+				}
+				return []exported.TraceStep{sourceStep}
+			},
+		}
+		axelarnetTracer = &mock.TransferTracerMock{
+			TraceSourceTxFunc: func(sdk.Context, exported.ChainName, string) ([]exported.TraceStep, []exported.TransferID) {
+				return nil, nil
+			},
+			TraceSourceFunc: func(sdk.Context, exported.TransferID) []exported.TraceStep { return nil },
+			TraceDestinationFunc: func(_ sdk.Context, transfer exported.CrossChainTransfer) []exported.TraceStep {
+				step := destinationStep
+				step.ID = transfer.ID.String()
+				return []exported.TraceStep{step}
+			},
+		}
+
+		nexusRouter := types.NewRouter().
+			AddAddressValidator(evm.Ethereum.Module, func(sdk.Context, exported.CrossChainAddress) error { return nil }).
+			AddAddressValidator(axelarnet.Axelarnet.Module, func(sdk.Context, exported.CrossChainAddress) error { return nil }).
+			AddTransferTracer(evm.Ethereum.Module, evmTracer).
+			AddTransferTracer(axelarnet.Axelarnet.Module, axelarnetTracer)
+		k.SetRouter(nexusRouter)
+		q = nexusKeeper.NewGRPCQuerier(k, axelarnetKeeper)
+	})
+
+	whenTransferIsEnqueued := givenKeeper.
+		When("a transfer is enqueued", func() {
+			sender = exported.CrossChainAddress{Chain: evm.Ethereum, Address: rand.Str(20)}
+			recipient := exported.CrossChainAddress{Chain: axelarnet.Axelarnet, Address: rand.AccAddr().String()}
+			assert.NoError(t, k.LinkAddresses(ctx, sender, recipient))
+
+			var err error
+			transferID, err = k.EnqueueForTransfer(ctx, sender, sdk.NewCoin(axelarnet.NativeAsset, sdk.NewInt(rand.PosI64())))
+			assert.NoError(t, err)
+		})
+
+	assertTrace := func(t *testing.T, res *types.TraceResponse, currentID exported.TransferID) {
+		assert.Equal(t, []exported.TraceStep{sourceStep}, res.Source)
+		assert.Len(t, res.Transfers, 1)
+		assert.Equal(t, transferID, res.Transfers[0].ID)
+		assert.Equal(t, currentID, res.Transfers[0].Transfer.ID)
+		assert.Nil(t, res.Transfers[0].Fee)
+		assert.Len(t, res.Transfers[0].Destination, 1)
+		assert.Equal(t, currentID.String(), res.Transfers[0].Destination[0].ID)
+	}
+
+	whenTransferIsEnqueued.
+		Branch(
+			Then("trace the transfer by source tx", func(t *testing.T) {
+				res, err := q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: txID, Chain: evm.Ethereum.Name})
+				assert.NoError(t, err)
+				assertTrace(t, res, transferID)
+				assert.Empty(t, axelarnetTracer.TraceSourceTxCalls())
+			}),
+			Then("trace the transfer by ID", func(t *testing.T) {
+				res, err := q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: transferID.String()})
+				assert.NoError(t, err)
+				assertTrace(t, res, transferID)
+			}),
+			Then("return an error for an unknown tx", func(t *testing.T) {
+				_, err := q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: rand.HexStr(64), Chain: evm.Ethereum.Name})
+				assert.Error(t, err)
+			}),
+			Then("return an error for a tx on an unknown chain", func(t *testing.T) {
+				_, err := q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: txID})
+				assert.Error(t, err)
+
+				_, err = q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: txID, Chain: exported.ChainName(rand.Str(5))})
+				assert.Error(t, err)
+			}),
+			Then("return an error for an unknown transfer ID", func(t *testing.T) {
+				_, err := q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: (transferID + 1).String()})
+				assert.Error(t, err)
+			}),
+		).Run(t)
+
+	whenTransferIsEnqueued.
+		When("another transfer to the same recipient is merged with it", func() {
+			_, err := k.EnqueueForTransfer(ctx, sender, sdk.NewCoin(axelarnet.NativeAsset, sdk.NewInt(rand.PosI64())))
+			assert.NoError(t, err)
+		}).
+		Then("trace the merged transfer", func(t *testing.T) {
+			res, err := q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: transferID.String()})
+			assert.NoError(t, err)
+			assertTrace(t, res, transferID+1)
+		}).
+		Run(t)
+
+	var fee sdk.Coin
+	givenKeeper.
+		When("a transfer fee is set for the asset", func() {
+			assert.NoError(t, k.RegisterAsset(ctx, evm.Ethereum, exported.NewAsset(axelarnet.NativeAsset, false)))
+			fee = sdk.NewCoin(axelarnet.NativeAsset, sdk.NewInt(rand.I64Between(1, 1000)))
+			assert.NoError(t, k.RegisterFee(ctx, evm.Ethereum, exported.NewFeeInfo(evm.Ethereum.Name, axelarnet.NativeAsset, sdk.ZeroDec(), fee.Amount, fee.Amount)))
+		}).
+		When("two transfers to the same recipient are enqueued", func() {
+			sender = exported.CrossChainAddress{Chain: evm.Ethereum, Address: rand.Str(20)}
+			recipient := exported.CrossChainAddress{Chain: axelarnet.Axelarnet, Address: rand.AccAddr().String()}
+			assert.NoError(t, k.LinkAddresses(ctx, sender, recipient))
+
+			var err error
+			transferID, err = k.EnqueueForTransfer(ctx, sender, sdk.NewCoin(axelarnet.NativeAsset, sdk.NewInt(rand.I64Between(1000, 100000))))
+			assert.NoError(t, err)
+			_, err = k.EnqueueForTransfer(ctx, sender, sdk.NewCoin(axelarnet.NativeAsset, sdk.NewInt(rand.I64Between(1000, 100000))))
+			assert.NoError(t, err)
+		}).
+		Then("trace the fee deducted from the first transfer and the transfer it was merged into", func(t *testing.T) {
+			res, err := q.Trace(sdk.WrapSDKContext(ctx), &types.TraceRequest{Id: transferID.String()})
+			assert.NoError(t, err)
+
+			assert.Len(t, res.Transfers, 1)
+			assert.Equal(t, transferID, res.Transfers[0].ID)
+			assert.Equal(t, transferID+1, res.Transfers[0].Transfer.ID)
+			assert.Equal(t, &fee, res.Transfers[0].Fee)
+			assert.Equal(t, transferID+1, funcs.MustOk(k.GetMergedTransferID(ctx, transferID)))
+		}).
+		Run(t)
+}
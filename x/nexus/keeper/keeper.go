@@ -24,6 +24,9 @@ var (
 	transferFee                = utils.KeyFromStr("fee")
 	assetFeePrefix             = utils.KeyFromStr("asset_fee")
 	chainMaintainerStatePrefix = key.FromUInt[uint64](1)
+	transferByIDPrefix         = key.FromUInt[uint64](2)
+	transferFeeByIDPrefix      = key.FromUInt[uint64](3)
+	mergedTransferPrefix       = key.FromUInt[uint64](4)
 	// temporary
 	latestDepositAddressPrefix = utils.KeyFromStr("latest_deposit_address")
 )
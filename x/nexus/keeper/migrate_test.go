@@ -3,9 +3,11 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/utils/funcs"
@@ -48,3 +50,28 @@ func TestGetMigrationHandler(t *testing.T) {
 		}
 	})
 }
+
+func TestGetTransfer_NotIndexedByID(t *testing.T) {
+	ctx, keeper := setup()
+
+	// transfers stored before v0.25 are not indexed by ID
+	transfers := slices.Expand(func(i int) exported.CrossChainTransfer {
+		chain := testutils.Chain()
+		keeper.SetChain(ctx, chain)
+
+		recipient := exported.CrossChainAddress{Chain: chain, Address: rand.HexStr(40)}
+		state := []exported.TransferState{exported.Pending, exported.Archived, exported.InsufficientAmount}[i%3]
+		return exported.NewCrossChainTransfer(uint64(i), recipient, sdk.NewCoin(rand.Denom(5, 10), sdk.NewInt(rand.PosI64())), state)
+	}, 5)
+
+	for _, transfer := range transfers {
+		keeper.getStore(ctx).Set(getTransferKey(transfer), &transfer)
+	}
+
+	for _, transfer := range transfers {
+		assert.Equal(t, transfer, funcs.MustOk(keeper.GetTransfer(ctx, transfer.ID)))
+	}
+
+	_, ok := keeper.GetTransfer(ctx, exported.TransferID(len(transfers)))
+	assert.False(t, ok)
+}
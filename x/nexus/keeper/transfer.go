@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/key"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/utils/funcs"
@@ -40,10 +41,62 @@ func (k Keeper) getTransfers(ctx sdk.Context) (transfers []exported.CrossChainTr
 
 func (k Keeper) setTransfer(ctx sdk.Context, transfer exported.CrossChainTransfer) {
 	k.getStore(ctx).Set(getTransferKey(transfer), &transfer)
+	k.getStore(ctx).SetRawNew(getTransferByIDKey(transfer.ID), getTransferKey(transfer).AsKey())
 }
 
+func getTransferByIDKey(id exported.TransferID) key.Key {
+	return transferByIDPrefix.Append(key.FromUInt(uint64(id)))
+}
+
+// GetTransfer returns the transfer with the given ID. Returns false if the transfer does not exist or has been merged into another transfer
+func (k Keeper) GetTransfer(ctx sdk.Context, id exported.TransferID) (exported.CrossChainTransfer, bool) {
+	var transfer exported.CrossChainTransfer
+	if bz := k.getStore(ctx).GetRawNew(getTransferByIDKey(id)); bz != nil {
+		return transfer, k.getStore(ctx).Get(utils.KeyFromBz(bz), &transfer)
+	}
+
+	// transfers that were last updated before v0.25 are not indexed by ID, so look them up by every recipient chain and state instead
+	for _, chain := range k.GetChains(ctx) {
+		for _, state := range []exported.TransferState{exported.Pending, exported.Archived, exported.InsufficientAmount} {
+			if k.getStore(ctx).Get(getTransferPrefix(chain.Name, state).Append(utils.KeyFromStr(id.String())), &transfer) {
+				return transfer, true
+			}
+		}
+	}
+
+	return exported.CrossChainTransfer{}, false
+}
+
+// setMergedTransferID records that the transfer with the given ID has been merged into the transfer with the given merged ID
+func (k Keeper) setMergedTransferID(ctx sdk.Context, id exported.TransferID, mergedID exported.TransferID) {
+	k.getStore(ctx).SetRawNew(mergedTransferPrefix.Append(key.FromUInt(uint64(id))), sdk.Uint64ToBigEndian(uint64(mergedID)))
+}
+
+// GetMergedTransferID returns the ID of the transfer the transfer with the given ID has been merged into
+func (k Keeper) GetMergedTransferID(ctx sdk.Context, id exported.TransferID) (exported.TransferID, bool) {
+	bz := k.getStore(ctx).GetRawNew(mergedTransferPrefix.Append(key.FromUInt(uint64(id))))
+	if bz == nil {
+		return 0, false
+	}
+
+	return exported.TransferID(sdk.BigEndianToUint64(bz)), true
+}
+
+func (k Keeper) setTransferFeeDeducted(ctx sdk.Context, id exported.TransferID, fee sdk.Coin) {
+	k.getStore(ctx).SetRawNew(transferFeeByIDPrefix.Append(key.FromUInt(uint64(id))), k.cdc.MustMarshalLengthPrefixed(&fee))
+}
+
+// GetTransferFeeDeducted returns the fee deducted when the transfer with the given ID was created
+func (k Keeper) GetTransferFeeDeducted(ctx sdk.Context, id exported.TransferID) (sdk.Coin, bool) {
+	var fee sdk.Coin
+	return fee, k.getStore(ctx).GetNew(transferFeeByIDPrefix.Append(key.FromUInt(uint64(id))), &fee)
+}
+
+// deleteTransfer deletes the transfer and its ID index when it is merged into a new transfer.
+// The deducted fee and the merge record are kept so the transfer ID can still be traced
 func (k Keeper) deleteTransfer(ctx sdk.Context, transfer exported.CrossChainTransfer) {
 	k.getStore(ctx).Delete(getTransferKey(transfer))
+	k.getStore(ctx).DeleteNew(getTransferByIDKey(transfer.ID))
 }
 
 func (k Keeper) setNewTransfer(ctx sdk.Context, recipient exported.CrossChainAddress, amount sdk.Coin, state exported.TransferState) exported.TransferID {
@@ -132,6 +185,9 @@ func (k Keeper) EnqueueTransfer(ctx sdk.Context, senderChain exported.Chain, rec
 			senderChain.Name, recipient.Chain.Name, recipient.Address, fee.String(), asset.String()))
 
 		transferID := k.setNewTransfer(ctx, recipient, asset, exported.InsufficientAmount)
+		if found {
+			k.setMergedTransferID(ctx, insufficientAmountTransfer.ID, transferID)
+		}
 
 		funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.InsufficientFee{
 			TransferID:       transferID,
@@ -150,8 +206,8 @@ func (k Keeper) EnqueueTransfer(ctx sdk.Context, senderChain exported.Chain, rec
 	}
 
 	// merging transfers for the specified recipient
-	previousTransfer, found := k.getTransfer(ctx, recipient, asset.Denom, exported.Pending)
-	if found {
+	previousTransfer, previousFound := k.getTransfer(ctx, recipient, asset.Denom, exported.Pending)
+	if previousFound {
 		asset = asset.Add(previousTransfer.Asset)
 		k.deleteTransfer(ctx, previousTransfer)
 	}
@@ -160,6 +216,15 @@ func (k Keeper) EnqueueTransfer(ctx sdk.Context, senderChain exported.Chain, rec
 		asset.String(), senderChain.Name, recipient.Chain.Name, recipient.Address))
 
 	transferID := k.setNewTransfer(ctx, recipient, asset, exported.Pending)
+	if found {
+		k.setMergedTransferID(ctx, insufficientAmountTransfer.ID, transferID)
+	}
+	if previousFound {
+		k.setMergedTransferID(ctx, previousTransfer.ID, transferID)
+	}
+	if fee.IsPositive() {
+		k.setTransferFeeDeducted(ctx, transferID, fee)
+	}
 
 	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.FeeDeducted{
 		TransferID:       transferID,
@@ -199,7 +264,8 @@ func (k Keeper) getTransfer(ctx sdk.Context, recipient exported.CrossChainAddres
 
 // ArchivePendingTransfer marks the transfer for the given recipient as concluded and archived
 func (k Keeper) ArchivePendingTransfer(ctx sdk.Context, transfer exported.CrossChainTransfer) {
-	k.deleteTransfer(ctx, transfer)
+	// only the state of the transfer changes, so its indexes are kept
+	k.getStore(ctx).Delete(getTransferKey(transfer))
 
 	transfer.State = exported.Archived
 	k.setTransfer(ctx, transfer)
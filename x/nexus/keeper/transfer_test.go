@@ -24,6 +24,7 @@ import (
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexusKeeper "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -401,6 +402,48 @@ func TestTransfer(t *testing.T) {
 		).Run(t, repeated)
 }
 
+func TestGetTransfer(t *testing.T) {
+	k, ctx := setup(app.MakeEncodingConfig())
+
+	sender, recipient := makeRandAddresses(k, ctx)
+	assert.NoError(t, k.LinkAddresses(ctx, sender, recipient))
+
+	asset := randAsset()
+	amount := sdk.NewCoin(asset, sdk.NewInt(maxAmount*4))
+	fee, err := k.ComputeTransferFee(ctx, sender.Chain, recipient.Chain, amount)
+	assert.NoError(t, err)
+
+	firstID, err := k.EnqueueForTransfer(ctx, sender, amount)
+	assert.NoError(t, err)
+
+	transfer, ok := k.GetTransfer(ctx, firstID)
+	assert.True(t, ok)
+	assert.Equal(t, nexus.Pending, transfer.State)
+	assert.Equal(t, amount.Sub(fee), transfer.Asset)
+	assert.Equal(t, fee, funcs.MustOk(k.GetTransferFeeDeducted(ctx, firstID)))
+
+	secondID, err := k.EnqueueForTransfer(ctx, sender, amount)
+	assert.NoError(t, err)
+
+	_, ok = k.GetTransfer(ctx, firstID)
+	assert.False(t, ok)
+	assert.Equal(t, fee, funcs.MustOk(k.GetTransferFeeDeducted(ctx, firstID)))
+	assert.Equal(t, secondID, funcs.MustOk(k.GetMergedTransferID(ctx, firstID)))
+	_, ok = k.GetMergedTransferID(ctx, secondID)
+	assert.False(t, ok)
+
+	transfer, ok = k.GetTransfer(ctx, secondID)
+	assert.True(t, ok)
+	assert.Equal(t, amount.Sub(fee).Add(amount.Sub(fee)), transfer.Asset)
+
+	k.ArchivePendingTransfer(ctx, transfer)
+	assert.Equal(t, nexus.Archived, funcs.MustOk(k.GetTransfer(ctx, secondID)).State)
+	assert.Equal(t, fee, funcs.MustOk(k.GetTransferFeeDeducted(ctx, secondID)))
+
+	_, ok = k.GetTransfer(ctx, secondID+1)
+	assert.False(t, ok)
+}
+
 func setup(cfg params.EncodingConfig) (nexusKeeper.Keeper, sdk.Context) {
 	subspace := paramstypes.NewSubspace(cfg.Codec, cfg.Amino, sdk.NewKVStoreKey("nexusKey"), sdk.NewKVStoreKey("tNexusKey"), "nexus")
 	k := nexusKeeper.NewKeeper(cfg.Codec, sdk.NewKVStoreKey(types.StoreKey), subspace)
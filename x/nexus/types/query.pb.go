@@ -787,6 +787,137 @@ func (m *RecipientAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RecipientAddressResponse proto.InternalMessageInfo
 
+// TraceRequest represents a message that queries the lifecycle of the
+// cross-chain transfers started by a source tx, or of a single transfer
+type TraceRequest struct {
+	// id is either the hash of a source tx or a decimal transfer ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// chain is the chain of the source tx. It is required if id is the hash of a
+	// source tx
+	Chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+}
+
+func (m *TraceRequest) Reset()         { *m = TraceRequest{} }
+func (m *TraceRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceRequest) ProtoMessage()    {}
+func (*TraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{19}
+}
+func (m *TraceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceRequest.Merge(m, src)
+}
+func (m *TraceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceRequest proto.InternalMessageInfo
+
+type TraceResponse struct {
+	// source are the records of the source tx or of the source tx of the
+	// transfer
+	Source    []exported.TraceStep `protobuf:"bytes,1,rep,name=source,proto3" json:"source"`
+	Transfers []TransferTrace      `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers"`
+}
+
+func (m *TraceResponse) Reset()         { *m = TraceResponse{} }
+func (m *TraceResponse) String() string { return proto.CompactTextString(m) }
+func (*TraceResponse) ProtoMessage()    {}
+func (*TraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{20}
+}
+func (m *TraceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceResponse.Merge(m, src)
+}
+func (m *TraceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceResponse proto.InternalMessageInfo
+
+// TransferTrace represents the lifecycle of a cross-chain transfer
+type TransferTrace struct {
+	// id is the ID the transfer was created with
+	ID github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.TransferID" json:"id,omitempty"`
+	// transfer is the transfer that carries the assets now. It differs from the
+	// created transfer if that has been merged with a later transfer to the same
+	// recipient
+	Transfer exported.CrossChainTransfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer"`
+	// fee is the fee deducted when the transfer was created. It is not set if
+	// no fee was deducted
+	Fee *types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// destination are the records of the transfer on its recipient chain
+	Destination []exported.TraceStep `protobuf:"bytes,4,rep,name=destination,proto3" json:"destination"`
+}
+
+func (m *TransferTrace) Reset()         { *m = TransferTrace{} }
+func (m *TransferTrace) String() string { return proto.CompactTextString(m) }
+func (*TransferTrace) ProtoMessage()    {}
+func (*TransferTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{21}
+}
+func (m *TransferTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferTrace.Merge(m, src)
+}
+func (m *TransferTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferTrace proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("axelar.nexus.v1beta1.ChainStatus", ChainStatus_name, ChainStatus_value)
 	proto.RegisterType((*QueryChainMaintainersResponse)(nil), "axelar.nexus.v1beta1.QueryChainMaintainersResponse")
@@ -808,70 +939,82 @@ func init() {
 	proto.RegisterType((*ChainsByAssetResponse)(nil), "axelar.nexus.v1beta1.ChainsByAssetResponse")
 	proto.RegisterType((*RecipientAddressRequest)(nil), "axelar.nexus.v1beta1.RecipientAddressRequest")
 	proto.RegisterType((*RecipientAddressResponse)(nil), "axelar.nexus.v1beta1.RecipientAddressResponse")
+	proto.RegisterType((*TraceRequest)(nil), "axelar.nexus.v1beta1.TraceRequest")
+	proto.RegisterType((*TraceResponse)(nil), "axelar.nexus.v1beta1.TraceResponse")
+	proto.RegisterType((*TransferTrace)(nil), "axelar.nexus.v1beta1.TransferTrace")
 }
 
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/query.proto", fileDescriptor_e78aa4ff0c7b81c7) }
 
 var fileDescriptor_e78aa4ff0c7b81c7 = []byte{
-	// 923 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0x1b, 0x55,
-	0x14, 0xf6, 0x24, 0x4d, 0x20, 0xc7, 0xb5, 0x93, 0x0e, 0xa6, 0xb8, 0x06, 0x26, 0xee, 0xa0, 0xd2,
-	0xa4, 0x34, 0x63, 0xd9, 0xac, 0x90, 0x2a, 0xa1, 0xb1, 0x1d, 0x53, 0x23, 0x88, 0xc2, 0xd8, 0xe9,
-	0x02, 0x16, 0xd6, 0xcd, 0xcc, 0xb1, 0x3b, 0xb4, 0x9e, 0xeb, 0xce, 0xbd, 0x2e, 0x89, 0xc4, 0x03,
-	0xa0, 0xae, 0x10, 0x6b, 0xba, 0xe2, 0x31, 0xe0, 0x01, 0xb2, 0xec, 0x92, 0x55, 0x04, 0xc9, 0x5b,
-	0x74, 0x85, 0xe6, 0xfe, 0xd8, 0x33, 0x8d, 0x95, 0x08, 0x09, 0xb1, 0x8a, 0xef, 0x9d, 0xef, 0x7c,
-	0xe7, 0x3b, 0xe7, 0x7c, 0x73, 0x26, 0x50, 0x25, 0x47, 0xf8, 0x94, 0xc4, 0xb5, 0x08, 0x8f, 0xa6,
-	0xac, 0xf6, 0xbc, 0x7e, 0x88, 0x9c, 0xd4, 0x6b, 0xcf, 0xa6, 0x18, 0x1f, 0x3b, 0x93, 0x98, 0x72,
-	0x6a, 0x96, 0x24, 0xc2, 0x11, 0x08, 0x47, 0x21, 0x2a, 0xa5, 0x11, 0x1d, 0x51, 0x01, 0xa8, 0x25,
-	0xbf, 0x24, 0xb6, 0xb2, 0x9d, 0x61, 0xc3, 0xa3, 0x09, 0x8d, 0x39, 0x06, 0x33, 0x5a, 0x7e, 0x3c,
-	0x41, 0xa6, 0xa0, 0x8b, 0x13, 0xa7, 0x11, 0xf7, 0x7c, 0xca, 0xc6, 0x94, 0xd5, 0x0e, 0x09, 0x43,
-	0xa9, 0x68, 0x06, 0x9b, 0x90, 0x51, 0x18, 0x11, 0x1e, 0xd2, 0x48, 0x61, 0xad, 0x34, 0x56, 0xa3,
-	0x7c, 0x1a, 0xaa, 0xe7, 0x36, 0x87, 0x0f, 0xbf, 0x49, 0x18, 0x5a, 0x8f, 0x49, 0x18, 0x7d, 0x4d,
-	0xc2, 0x88, 0x93, 0x30, 0xc2, 0x98, 0x79, 0xc8, 0x26, 0x34, 0x62, 0x68, 0xf6, 0x20, 0x3f, 0x9e,
-	0x5f, 0x97, 0x8d, 0xea, 0xf2, 0xd6, 0xf5, 0x66, 0xfd, 0xf5, 0xe9, 0xe6, 0xce, 0x28, 0xe4, 0x8f,
-	0xa7, 0x87, 0x8e, 0x4f, 0xc7, 0x35, 0x95, 0x44, 0xfe, 0xd9, 0x61, 0xc1, 0x13, 0xa5, 0xf7, 0x11,
-	0x79, 0xea, 0x06, 0x41, 0x8c, 0x8c, 0x79, 0x69, 0x16, 0xfb, 0x17, 0x03, 0xde, 0xff, 0x8a, 0x70,
-	0x64, 0xbc, 0x8d, 0x13, 0xca, 0x42, 0xae, 0x51, 0xf8, 0x6c, 0x8a, 0x8c, 0x9b, 0x77, 0xa0, 0x18,
-	0xa3, 0x1f, 0x4e, 0x42, 0x8c, 0xf8, 0x80, 0x04, 0x41, 0x5c, 0x36, 0xaa, 0xc6, 0xd6, 0x9a, 0x57,
-	0x98, 0xdd, 0x26, 0x01, 0xe6, 0x5d, 0x58, 0x9f, 0xc3, 0xfc, 0xa4, 0x82, 0xf2, 0x92, 0xc0, 0xcd,
-	0xa3, 0x45, 0x5d, 0xe6, 0x47, 0x50, 0x08, 0x64, 0x22, 0x05, 0x5b, 0x16, 0xb0, 0xeb, 0xea, 0x52,
-	0x80, 0x6c, 0x17, 0x3e, 0x58, 0xac, 0x49, 0x75, 0xe2, 0x36, 0x68, 0x7c, 0x5a, 0x52, 0x3e, 0x98,
-	0xa3, 0xed, 0x3f, 0x0c, 0x28, 0xf7, 0x63, 0x12, 0xb1, 0x21, 0xc6, 0xac, 0x43, 0x63, 0x41, 0xac,
-	0x8b, 0x2a, 0xc1, 0x8a, 0x4c, 0x2e, 0x03, 0xe5, 0xc1, 0x6c, 0xc2, 0x0a, 0xe3, 0x84, 0xa3, 0x50,
-	0x5e, 0x6c, 0xdc, 0x77, 0x32, 0xae, 0xd2, 0x4e, 0xd1, 0xf6, 0x72, 0x34, 0x7b, 0x2f, 0x89, 0xf1,
-	0x64, 0xa8, 0xd9, 0x01, 0x98, 0x0f, 0x5e, 0xd4, 0x96, 0x6f, 0x7c, 0xec, 0xc8, 0x69, 0x38, 0xc9,
-	0xe4, 0x1d, 0xe9, 0x5b, 0x4d, 0xb2, 0x4f, 0x46, 0xa8, 0x54, 0x79, 0xa9, 0x48, 0xfb, 0x77, 0x03,
-	0x6e, 0x2d, 0x90, 0xaf, 0xea, 0x3f, 0x80, 0x35, 0xae, 0x1f, 0x0a, 0x1f, 0xe4, 0x1b, 0xf5, 0x2b,
-	0xd4, 0xb6, 0x62, 0xca, 0x98, 0x60, 0xd1, 0xb4, 0xcd, 0x6b, 0x27, 0xa7, 0x9b, 0x39, 0x6f, 0xce,
-	0x64, 0x7e, 0x91, 0x11, 0xbf, 0x24, 0xc4, 0xdf, 0xbd, 0x52, 0xbc, 0xd4, 0x94, 0x51, 0xff, 0x00,
-	0x8a, 0x1d, 0xc4, 0x6e, 0x34, 0xa4, 0x97, 0x77, 0xbc, 0x04, 0x2b, 0x84, 0x31, 0xe4, 0xca, 0x2b,
-	0xf2, 0x60, 0xf7, 0x61, 0x7d, 0x16, 0xad, 0x0a, 0x76, 0xe1, 0xed, 0x21, 0xe2, 0x20, 0x8c, 0x86,
-	0x54, 0x30, 0x24, 0x4d, 0xbd, 0xbc, 0x5e, 0xcd, 0xf0, 0xd6, 0x50, 0xfe, 0xb0, 0x7f, 0x04, 0x53,
-	0x57, 0xde, 0x41, 0xdd, 0xf3, 0xc4, 0x49, 0x8c, 0x4e, 0x63, 0x1f, 0x07, 0x69, 0x79, 0x79, 0x79,
-	0x27, 0x1d, 0xfb, 0x09, 0xdc, 0x08, 0x90, 0x71, 0x55, 0x5b, 0xc6, 0xdc, 0x1b, 0xa9, 0x07, 0x12,
-	0x7c, 0x13, 0x56, 0xc9, 0x98, 0x4e, 0x23, 0xae, 0x7c, 0xad, 0x4e, 0xf6, 0x43, 0x78, 0x27, 0x93,
-	0x5d, 0xd5, 0x55, 0x87, 0xe5, 0x21, 0xa2, 0x2a, 0xe9, 0x56, 0xa6, 0xd5, 0xb3, 0xc1, 0xd1, 0x30,
-	0x52, 0xa3, 0x4a, 0xb0, 0xf6, 0x97, 0x50, 0x10, 0xa9, 0x66, 0x6f, 0xe8, 0x67, 0xb0, 0x9a, 0x78,
-	0x6f, 0xca, 0x04, 0x4d, 0xb1, 0x71, 0xdb, 0x59, 0xb4, 0x0d, 0x1d, 0x11, 0xd4, 0x13, 0x40, 0x4f,
-	0x05, 0xd8, 0x63, 0x28, 0x6a, 0x2e, 0x25, 0xe8, 0x3b, 0x58, 0x15, 0x05, 0x4a, 0x5b, 0xad, 0x35,
-	0x5b, 0xaf, 0x4f, 0x37, 0x3f, 0x4f, 0xad, 0x17, 0x49, 0x1d, 0x21, 0xff, 0x81, 0xc6, 0x4f, 0xd4,
-	0x69, 0xc7, 0xa7, 0x31, 0xd6, 0x8e, 0xde, 0xd8, 0xa8, 0x32, 0xe1, 0x1e, 0x19, 0xa3, 0xa7, 0x28,
-	0xed, 0x3b, 0x50, 0x70, 0x93, 0x09, 0xb3, 0x4b, 0x5d, 0x61, 0x6f, 0x41, 0x51, 0xc3, 0x94, 0xaa,
-	0xa4, 0xab, 0xe2, 0x46, 0xaa, 0xf2, 0xd4, 0xc9, 0xde, 0x86, 0x1b, 0xb3, 0xb2, 0xf0, 0x72, 0x52,
-	0x0f, 0xcc, 0x34, 0x54, 0x11, 0x3f, 0xd0, 0xaf, 0xbc, 0x9c, 0x40, 0xf5, 0x8a, 0xd6, 0xa1, 0x1a,
-	0x84, 0x0c, 0xb2, 0xef, 0x43, 0x49, 0xb6, 0xaf, 0x79, 0x2c, 0x04, 0xa7, 0x14, 0x48, 0x5b, 0x1b,
-	0x69, 0x5b, 0x73, 0x78, 0xf7, 0x0d, 0xf4, 0xff, 0xd1, 0x73, 0x02, 0xef, 0x79, 0xe9, 0x4d, 0x9d,
-	0x5a, 0xed, 0x57, 0x6f, 0xd1, 0x8b, 0xdb, 0x7a, 0x69, 0xc1, 0xb6, 0xfe, 0x1e, 0xca, 0x17, 0x53,
-	0xa8, 0xda, 0xfe, 0xe3, 0xcf, 0xc7, 0xbd, 0x5f, 0x0d, 0xc8, 0xa7, 0x9c, 0x6c, 0xee, 0x40, 0xb9,
-	0xf5, 0xd0, 0xed, 0xee, 0x0d, 0x7a, 0x7d, 0xb7, 0x7f, 0xd0, 0x1b, 0x1c, 0xec, 0xf5, 0xf6, 0x77,
-	0x5b, 0xdd, 0x4e, 0x77, 0xb7, 0xbd, 0x91, 0xab, 0xac, 0xbf, 0x78, 0x59, 0xcd, 0x1f, 0x44, 0x6c,
-	0x82, 0x7e, 0x38, 0x0c, 0x31, 0x30, 0xb7, 0xe1, 0x66, 0x06, 0xee, 0xb6, 0xfa, 0xdd, 0x47, 0x6e,
-	0x7f, 0xb7, 0xbd, 0x61, 0x54, 0x0a, 0x2f, 0x5e, 0x56, 0xd7, 0x5c, 0x9f, 0x87, 0xcf, 0x09, 0xc7,
-	0xe0, 0x02, 0x73, 0x7b, 0x77, 0x0e, 0x5e, 0x92, 0xcc, 0x6d, 0x24, 0x1a, 0x5e, 0xb9, 0xf6, 0xd3,
-	0x6f, 0x56, 0xae, 0xb9, 0x7f, 0xf2, 0xb7, 0x95, 0x3b, 0x39, 0xb3, 0x8c, 0x57, 0x67, 0x96, 0xf1,
-	0xd7, 0x99, 0x65, 0xfc, 0x7c, 0x6e, 0xe5, 0x5e, 0x9d, 0x5b, 0xb9, 0x3f, 0xcf, 0xad, 0xdc, 0xb7,
-	0x8d, 0x7f, 0x35, 0x54, 0xf1, 0xdd, 0x3e, 0x5c, 0x15, 0xff, 0x1c, 0x7c, 0xfa, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x95, 0xce, 0x05, 0x5e, 0x05, 0x09, 0x00, 0x00,
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0x1b, 0x55,
+	0x17, 0xf6, 0x38, 0x1f, 0x6f, 0x73, 0x1c, 0x3b, 0xe9, 0xbc, 0xa1, 0xb8, 0x01, 0x1c, 0x77, 0xaa,
+	0xd2, 0xa4, 0x6d, 0xc6, 0x4a, 0x58, 0x21, 0x55, 0x02, 0x7f, 0xc4, 0xad, 0x2b, 0x88, 0xc2, 0xd8,
+	0xa9, 0x44, 0x59, 0x58, 0x37, 0x33, 0xc7, 0xee, 0xd0, 0x7a, 0xae, 0x3b, 0xf7, 0xba, 0x24, 0x12,
+	0x3f, 0x00, 0x75, 0x85, 0x58, 0x53, 0x09, 0x89, 0x9f, 0x01, 0x12, 0xdb, 0x2c, 0xbb, 0x64, 0x65,
+	0x41, 0xf2, 0x2f, 0xb2, 0x42, 0x73, 0x3f, 0xc6, 0xe3, 0xc6, 0x8a, 0x45, 0x54, 0xb1, 0xb2, 0xef,
+	0x9d, 0xe7, 0x3c, 0xe7, 0x39, 0x1f, 0x7e, 0xc6, 0x50, 0x24, 0x87, 0xf8, 0x9c, 0x84, 0xa5, 0x00,
+	0x0f, 0x07, 0xac, 0xf4, 0x72, 0xeb, 0x00, 0x39, 0xd9, 0x2a, 0xbd, 0x18, 0x60, 0x78, 0x64, 0xf7,
+	0x43, 0xca, 0xa9, 0xb9, 0x22, 0x11, 0xb6, 0x40, 0xd8, 0x0a, 0xb1, 0xba, 0xd2, 0xa5, 0x5d, 0x2a,
+	0x00, 0xa5, 0xe8, 0x9b, 0xc4, 0xae, 0x6e, 0x8c, 0xb1, 0xe1, 0x61, 0x9f, 0x86, 0x1c, 0xbd, 0x98,
+	0x96, 0x1f, 0xf5, 0x91, 0x29, 0xe8, 0xe4, 0xc4, 0x49, 0xc4, 0x1d, 0x97, 0xb2, 0x1e, 0x65, 0xa5,
+	0x03, 0xc2, 0x50, 0x2a, 0x8a, 0x61, 0x7d, 0xd2, 0xf5, 0x03, 0xc2, 0x7d, 0x1a, 0x28, 0x6c, 0x21,
+	0x89, 0xd5, 0x28, 0x97, 0xfa, 0xea, 0xb9, 0xc5, 0xe1, 0xa3, 0xaf, 0x22, 0x86, 0xea, 0x53, 0xe2,
+	0x07, 0x5f, 0x12, 0x3f, 0xe0, 0xc4, 0x0f, 0x30, 0x64, 0x0e, 0xb2, 0x3e, 0x0d, 0x18, 0x9a, 0x4d,
+	0xc8, 0xf4, 0x46, 0xd7, 0x79, 0xa3, 0x38, 0xb3, 0xbe, 0x58, 0xd9, 0x3a, 0x1b, 0xae, 0x6d, 0x76,
+	0x7d, 0xfe, 0x74, 0x70, 0x60, 0xbb, 0xb4, 0x57, 0x52, 0x49, 0xe4, 0xc7, 0x26, 0xf3, 0x9e, 0x29,
+	0xbd, 0x8f, 0xc9, 0xf3, 0xb2, 0xe7, 0x85, 0xc8, 0x98, 0x93, 0x64, 0xb1, 0x7e, 0x32, 0xe0, 0x83,
+	0x2f, 0x08, 0x47, 0xc6, 0x6b, 0xd8, 0xa7, 0xcc, 0xe7, 0x1a, 0x85, 0x2f, 0x06, 0xc8, 0xb8, 0x79,
+	0x0b, 0x72, 0x21, 0xba, 0x7e, 0xdf, 0xc7, 0x80, 0xb7, 0x89, 0xe7, 0x85, 0x79, 0xa3, 0x68, 0xac,
+	0x2f, 0x38, 0xd9, 0xf8, 0x36, 0x0a, 0x30, 0x6f, 0xc3, 0xd2, 0x08, 0xe6, 0x46, 0x15, 0xe4, 0xd3,
+	0x02, 0x37, 0x8a, 0x16, 0x75, 0x99, 0x37, 0x21, 0xeb, 0xc9, 0x44, 0x0a, 0x36, 0x23, 0x60, 0x8b,
+	0xea, 0x52, 0x80, 0xac, 0x32, 0x7c, 0x38, 0x59, 0x93, 0xea, 0xc4, 0x0d, 0xd0, 0xf8, 0xa4, 0xa4,
+	0x8c, 0x37, 0x42, 0x5b, 0xbf, 0x1b, 0x90, 0x6f, 0x85, 0x24, 0x60, 0x1d, 0x0c, 0x59, 0x9d, 0x86,
+	0x82, 0x58, 0x17, 0xb5, 0x02, 0x73, 0x32, 0xb9, 0x0c, 0x94, 0x07, 0xb3, 0x02, 0x73, 0x8c, 0x13,
+	0x8e, 0x42, 0x79, 0x6e, 0xfb, 0x9e, 0x3d, 0xb6, 0x55, 0x7a, 0x53, 0xf4, 0x7a, 0xd9, 0x9a, 0xbd,
+	0x19, 0xc5, 0x38, 0x32, 0xd4, 0xac, 0x03, 0x8c, 0x06, 0x2f, 0x6a, 0xcb, 0x6c, 0x7f, 0x6c, 0xcb,
+	0x69, 0xd8, 0xd1, 0xe4, 0x6d, 0xb9, 0xb7, 0x9a, 0x64, 0x8f, 0x74, 0x51, 0xa9, 0x72, 0x12, 0x91,
+	0xd6, 0x6f, 0x06, 0x5c, 0x9f, 0x20, 0x5f, 0xd5, 0xbf, 0x0f, 0x0b, 0x5c, 0x3f, 0x14, 0x7b, 0x90,
+	0xd9, 0xde, 0x9a, 0xa2, 0xb6, 0x1a, 0x52, 0xc6, 0x04, 0x8b, 0xa6, 0xad, 0xcc, 0x1e, 0x0f, 0xd7,
+	0x52, 0xce, 0x88, 0xc9, 0x7c, 0x30, 0x26, 0x3e, 0x2d, 0xc4, 0xdf, 0x9e, 0x2a, 0x5e, 0x6a, 0x1a,
+	0x53, 0x7f, 0x1f, 0x72, 0x75, 0xc4, 0x46, 0xd0, 0xa1, 0x17, 0x77, 0x7c, 0x05, 0xe6, 0x08, 0x63,
+	0xc8, 0xd5, 0xae, 0xc8, 0x83, 0xd5, 0x82, 0xa5, 0x38, 0x5a, 0x15, 0x5c, 0x86, 0x2b, 0x1d, 0xc4,
+	0xb6, 0x1f, 0x74, 0x68, 0xde, 0x50, 0x4d, 0xbd, 0xb8, 0x5e, 0xcd, 0xf0, 0xbf, 0x8e, 0xfc, 0x62,
+	0x7d, 0x0f, 0xa6, 0xae, 0xbc, 0x8e, 0xba, 0xe7, 0xd1, 0x26, 0x31, 0x3a, 0x08, 0x5d, 0x6c, 0x27,
+	0xe5, 0x65, 0xe4, 0x9d, 0xdc, 0xd8, 0xbb, 0x70, 0xd5, 0x43, 0xc6, 0x55, 0x6d, 0x63, 0xcb, 0xbd,
+	0x9c, 0x78, 0x20, 0xc1, 0xd7, 0x60, 0x9e, 0xf4, 0xe8, 0x20, 0xe0, 0x6a, 0xaf, 0xd5, 0xc9, 0x7a,
+	0x08, 0xff, 0x1f, 0xcb, 0xae, 0xea, 0xda, 0x82, 0x99, 0x0e, 0xa2, 0x2a, 0xe9, 0xfa, 0x58, 0xab,
+	0xe3, 0xc1, 0x51, 0x3f, 0x50, 0xa3, 0x8a, 0xb0, 0xd6, 0x23, 0xc8, 0x8a, 0x54, 0xf1, 0x2f, 0xf4,
+	0x53, 0x98, 0x8f, 0x76, 0x6f, 0xc0, 0x04, 0x4d, 0x6e, 0xfb, 0x86, 0x3d, 0xc9, 0x0d, 0x6d, 0x11,
+	0xd4, 0x14, 0x40, 0x47, 0x05, 0x58, 0x3d, 0xc8, 0x69, 0x2e, 0x25, 0xe8, 0x1b, 0x98, 0x17, 0x05,
+	0xca, 0xb5, 0x5a, 0xa8, 0x54, 0xcf, 0x86, 0x6b, 0x9f, 0x25, 0xec, 0x45, 0x52, 0x07, 0xc8, 0xbf,
+	0xa3, 0xe1, 0x33, 0x75, 0xda, 0x74, 0x69, 0x88, 0xa5, 0xc3, 0xb7, 0x1c, 0x55, 0x26, 0xdc, 0x25,
+	0x3d, 0x74, 0x14, 0xa5, 0x75, 0x0b, 0xb2, 0xe5, 0x68, 0xc2, 0xec, 0xc2, 0xad, 0xb0, 0xd6, 0x21,
+	0xa7, 0x61, 0x4a, 0x55, 0xd4, 0x55, 0x71, 0x23, 0x55, 0x39, 0xea, 0x64, 0x6d, 0xc0, 0xd5, 0xb8,
+	0x2c, 0xbc, 0x98, 0xd4, 0x01, 0x33, 0x09, 0x55, 0xc4, 0xf7, 0xf5, 0x4f, 0x5e, 0x4e, 0xa0, 0x38,
+	0xa5, 0x75, 0xa8, 0x06, 0x21, 0x83, 0xac, 0x7b, 0xb0, 0x22, 0x1e, 0xb1, 0xca, 0x91, 0x10, 0x9c,
+	0x50, 0x20, 0xd7, 0xda, 0x48, 0xae, 0x35, 0x87, 0xf7, 0xde, 0x42, 0xff, 0x17, 0x3d, 0x27, 0xf0,
+	0xbe, 0x93, 0x74, 0xea, 0x84, 0xb5, 0x4f, 0x77, 0xd1, 0xf3, 0x6e, 0x9d, 0x9e, 0xe0, 0xd6, 0xdf,
+	0x42, 0xfe, 0x7c, 0x0a, 0x55, 0xdb, 0x3b, 0x7e, 0x7d, 0x58, 0x47, 0xb0, 0xd8, 0x0a, 0x89, 0x1b,
+	0x0f, 0x3b, 0x07, 0x69, 0xdf, 0x53, 0x9c, 0x69, 0xdf, 0x33, 0xbf, 0x86, 0xb9, 0x44, 0xf8, 0xbb,
+	0x69, 0xa5, 0xda, 0xa0, 0x5f, 0x0c, 0xc8, 0xaa, 0xdc, 0xaa, 0xb8, 0x3a, 0xcc, 0x4b, 0xa3, 0x50,
+	0x1e, 0xbc, 0x3e, 0xfd, 0x8d, 0xe1, 0x62, 0x93, 0x63, 0x5f, 0xad, 0x91, 0x8a, 0x36, 0x1f, 0x24,
+	0xed, 0x3c, 0x2d, 0xa8, 0x6e, 0x4e, 0xde, 0x44, 0xed, 0x21, 0x82, 0xe9, 0x9c, 0x81, 0x5b, 0x7f,
+	0xa4, 0x85, 0xc4, 0x11, 0xc4, 0x7c, 0x12, 0xf7, 0x67, 0xb6, 0xf2, 0xe8, 0x64, 0xb8, 0x96, 0x6e,
+	0xd4, 0xce, 0x86, 0x6b, 0x9f, 0x5f, 0xae, 0x25, 0x9a, 0xba, 0x51, 0x13, 0xbd, 0x6e, 0xc2, 0x15,
+	0x9d, 0x5a, 0xbd, 0x2c, 0x2e, 0xfd, 0x12, 0x8a, 0x89, 0xcc, 0xbb, 0xd2, 0x11, 0x67, 0xa6, 0x38,
+	0xa2, 0xf0, 0x42, 0x73, 0x0f, 0x32, 0x09, 0x07, 0xce, 0xcf, 0x5e, 0x6a, 0x0a, 0x49, 0x8a, 0x3b,
+	0x3f, 0x1b, 0x90, 0x49, 0x38, 0xa5, 0xb9, 0x09, 0xf9, 0xea, 0xc3, 0x72, 0x63, 0xb7, 0xdd, 0x6c,
+	0x95, 0x5b, 0xfb, 0xcd, 0xf6, 0xfe, 0x6e, 0x73, 0x6f, 0xa7, 0xda, 0xa8, 0x37, 0x76, 0x6a, 0xcb,
+	0xa9, 0xd5, 0xa5, 0x57, 0xaf, 0x8b, 0x99, 0xfd, 0x80, 0xf5, 0xd1, 0xf5, 0x3b, 0x3e, 0x7a, 0xe6,
+	0x06, 0x5c, 0x1b, 0x83, 0x97, 0xab, 0xad, 0xc6, 0xe3, 0x72, 0x6b, 0xa7, 0xb6, 0x6c, 0xac, 0x66,
+	0x5f, 0xbd, 0x2e, 0x2e, 0x94, 0x5d, 0xee, 0xbf, 0x24, 0x1c, 0xbd, 0x73, 0xcc, 0xb5, 0x9d, 0x11,
+	0x38, 0x2d, 0x99, 0x6b, 0x48, 0x34, 0x7c, 0x75, 0xf6, 0x87, 0x5f, 0x0b, 0xa9, 0xca, 0xde, 0xf1,
+	0xdf, 0x85, 0xd4, 0xf1, 0x49, 0xc1, 0x78, 0x73, 0x52, 0x30, 0xfe, 0x3a, 0x29, 0x18, 0x3f, 0x9e,
+	0x16, 0x52, 0x6f, 0x4e, 0x0b, 0xa9, 0x3f, 0x4f, 0x0b, 0xa9, 0x27, 0xdb, 0xff, 0x6a, 0xac, 0xe2,
+	0x7f, 0xe1, 0xc1, 0xbc, 0xf8, 0xf3, 0xf9, 0xc9, 0x3f, 0x03, 0x00, 0x16, 0x3b, 0xcf, 0xff, 0x65,
+	0x0b, 0x00, 0x00,
 }
 
 func (m *QueryChainMaintainersResponse) Marshal() (dAtA []byte, err error) {
@@ -1546,6 +1689,158 @@ func (m *RecipientAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *TraceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		for iNdEx := len(m.Source) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Source[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		for iNdEx := len(m.Destination) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destination[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1848,6 +2143,68 @@ func (m *RecipientAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *TraceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TraceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		for _, e := range m.Source {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = m.Transfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Destination) > 0 {
+		for _, e := range m.Destination {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3722,6 +4079,410 @@ func (m *RecipientAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TraceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source, exported.TraceStep{})
+			if err := m.Source[len(m.Source)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, TransferTrace{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &types.Coin{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination, exported.TraceStep{})
+			if err := m.Destination[len(m.Destination)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,29 +2,36 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 )
 
-// Router implements a AddressValidator router based on module name.
+// Router implements a AddressValidator and TransferTracer router based on module name.
 type Router interface {
 	AddAddressValidator(module string, handler exported.AddressValidator) Router
 	HasAddressValidator(module string) bool
 	GetAddressValidator(module string) exported.AddressValidator
+	AddTransferTracer(module string, tracer exported.TransferTracer) Router
+	HasTransferTracer(module string) bool
+	GetTransferTracer(module string) exported.TransferTracer
+	GetTransferTracers() []exported.TransferTracer
 	Seal()
 }
 
 var _ Router = (*router)(nil)
 
 type router struct {
-	routes map[string]exported.AddressValidator
-	sealed bool
+	routes  map[string]exported.AddressValidator
+	tracers map[string]exported.TransferTracer
+	sealed  bool
 }
 
 // NewRouter creates a new Router interface instance
 func NewRouter() Router {
 	return &router{
-		routes: make(map[string]exported.AddressValidator),
+		routes:  make(map[string]exported.AddressValidator),
+		tracers: make(map[string]exported.TransferTracer),
 	}
 }
 
@@ -65,3 +72,52 @@ func (r *router) GetAddressValidator(module string) exported.AddressValidator {
 
 	return r.routes[module]
 }
+
+// AddTransferTracer registers the transfer tracer of the given module and returns the router.
+// Panics if the router is sealed, module is an empty string, or if the module has been registered already.
+func (r *router) AddTransferTracer(module string, tracer exported.TransferTracer) Router {
+	if r.sealed {
+		panic("cannot add transfer tracer (router sealed)")
+	}
+
+	if module == "" {
+		panic("module name cannot be an empty string")
+	}
+
+	if r.HasTransferTracer(module) {
+		panic(fmt.Sprintf("transfer tracer for module %s has already been registered", module))
+	}
+
+	r.tracers[module] = tracer
+	return r
+}
+
+// HasTransferTracer returns true if the router has a transfer tracer registered for the given module
+func (r *router) HasTransferTracer(module string) bool {
+	return r.tracers[module] != nil
+}
+
+// GetTransferTracer returns the transfer tracer of the given module.
+func (r *router) GetTransferTracer(module string) exported.TransferTracer {
+	if !r.HasTransferTracer(module) {
+		panic(fmt.Sprintf("transfer tracer for module \"%s\" not registered", module))
+	}
+
+	return r.tracers[module]
+}
+
+// GetTransferTracers returns all registered transfer tracers ordered by module name
+func (r *router) GetTransferTracers() []exported.TransferTracer {
+	modules := make([]string, 0, len(r.tracers))
+	for module := range r.tracers {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	tracers := make([]exported.TransferTracer, len(modules))
+	for i, module := range modules {
+		tracers[i] = r.tracers[module]
+	}
+
+	return tracers
+}
@@ -37,61 +37,63 @@ func init() {
 }
 
 var fileDescriptor_fbc63daa8a033391 = []byte{
-	// 851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x4f, 0x4f, 0x3b, 0x45,
-	0x18, 0xc7, 0x19, 0x0f, 0x35, 0x19, 0x7f, 0x44, 0x9d, 0x90, 0x18, 0x10, 0x37, 0xb8, 0x14, 0xa1,
-	0x40, 0x77, 0xd3, 0x0a, 0x1a, 0xf1, 0x54, 0x24, 0x8d, 0x26, 0x42, 0x14, 0x3c, 0x71, 0xd9, 0x4c,
-	0xb7, 0x4f, 0x97, 0x8d, 0xb0, 0x53, 0x76, 0xa6, 0xb5, 0x4d, 0xed, 0x41, 0x5f, 0x81, 0x7f, 0x4e,
-	0x5e, 0xf4, 0x05, 0xf8, 0x02, 0x4c, 0x8c, 0x07, 0xf5, 0xe4, 0x91, 0xc4, 0x8b, 0x37, 0x09, 0x35,
-	0xbe, 0x8e, 0x5f, 0x76, 0x76, 0x06, 0x58, 0x98, 0xdd, 0x96, 0x5b, 0x77, 0xe7, 0xfb, 0xec, 0xf3,
-	0xf9, 0x3e, 0xf3, 0xcc, 0x33, 0xc5, 0x36, 0x1d, 0xc0, 0x39, 0x8d, 0xdd, 0x08, 0x06, 0x3d, 0xee,
-	0xf6, 0x6b, 0x2d, 0x10, 0xb4, 0xe6, 0x72, 0x88, 0xfb, 0xa1, 0x0f, 0x4e, 0x37, 0x66, 0x82, 0x91,
-	0x85, 0x54, 0xe3, 0x48, 0x8d, 0xa3, 0x34, 0x4b, 0x0b, 0x01, 0x0b, 0x98, 0x14, 0xb8, 0xc9, 0xaf,
-	0x54, 0xbb, 0xb4, 0x1c, 0x30, 0x16, 0x9c, 0x83, 0x4b, 0xbb, 0xa1, 0x4b, 0xa3, 0x88, 0x09, 0x2a,
-	0x42, 0x16, 0x71, 0xb5, 0xfa, 0x86, 0x31, 0x9b, 0x18, 0xa8, 0xe5, 0x15, 0xe3, 0xf2, 0x65, 0x0f,
-	0xe2, 0x61, 0xaa, 0xa8, 0xff, 0x5b, 0xc2, 0xf8, 0x90, 0x07, 0x27, 0x29, 0x1f, 0xf9, 0x15, 0xe1,
-	0xd7, 0x8e, 0x21, 0x08, 0xb9, 0x80, 0xf8, 0x83, 0x33, 0x1a, 0x46, 0x87, 0x34, 0x8c, 0x04, 0x0d,
-	0x23, 0x88, 0xc9, 0x8e, 0x63, 0xc2, 0x76, 0x72, 0xe4, 0xc7, 0x70, 0xd9, 0x03, 0x2e, 0x96, 0x76,
-	0x9f, 0x18, 0xc5, 0xbb, 0x2c, 0xe2, 0x60, 0xd7, 0xbf, 0xfe, 0xfb, 0xbf, 0xef, 0x5f, 0xd8, 0xb6,
-	0xd7, 0xdd, 0x8c, 0x85, 0x58, 0x85, 0x79, 0x7e, 0x12, 0xe7, 0x5d, 0xdc, 0x06, 0xee, 0xa1, 0x4d,
-	0xf2, 0x27, 0xc2, 0x8b, 0x07, 0x10, 0xe7, 0xe0, 0xbf, 0x63, 0x06, 0xc9, 0x0d, 0xd0, 0x06, 0xde,
-	0x7d, 0x72, 0x9c, 0xb2, 0xb0, 0x23, 0x2d, 0x38, 0x76, 0x25, 0x6b, 0xa1, 0x0d, 0x85, 0x26, 0xbe,
-	0x45, 0x78, 0xbe, 0xe1, 0x8b, 0xb0, 0x4f, 0x05, 0xc8, 0x2f, 0x93, 0x4d, 0x33, 0x40, 0x46, 0xa4,
-	0x61, 0xb7, 0x66, 0xd2, 0x2a, 0xc0, 0x75, 0x09, 0xf8, 0xa6, 0xbd, 0x9c, 0x05, 0xa4, 0x4a, 0x9c,
-	0xe2, 0x25, 0x4c, 0x3f, 0x20, 0xfc, 0xf2, 0x01, 0xd0, 0x0c, 0xd5, 0x76, 0x5e, 0x59, 0xa8, 0x89,
-	0xab, 0x3a, 0xa3, 0x5a, 0x91, 0x55, 0x24, 0xd9, 0xaa, 0x6d, 0x3d, 0x2c, 0xdd, 0x63, 0xb6, 0x1f,
-	0x11, 0x7e, 0x45, 0x37, 0x53, 0x83, 0x73, 0x10, 0x4d, 0x00, 0x52, 0x2d, 0x6e, 0x3a, 0xad, 0xd3,
-	0x74, 0xce, 0xac, 0x72, 0x85, 0xb7, 0x25, 0xf1, 0xd6, 0xec, 0x95, 0x9c, 0xe6, 0xa4, 0x49, 0x80,
-	0xd7, 0x01, 0xd8, 0x43, 0x9b, 0xf5, 0xdf, 0x9e, 0xe1, 0x67, 0x9f, 0x26, 0x27, 0x4e, 0x9f, 0xb1,
-	0xff, 0x11, 0x5e, 0xf8, 0x98, 0x0a, 0xe0, 0xe2, 0x00, 0xba, 0x8c, 0x87, 0xa2, 0xd1, 0x6e, 0xc7,
-	0xc0, 0x39, 0xa9, 0x99, 0x31, 0x4c, 0x5a, 0x4d, 0x5e, 0x7f, 0x4a, 0x88, 0xa2, 0x0f, 0x24, 0x3d,
-	0x25, 0x9e, 0x6b, 0x9c, 0x0e, 0xe7, 0x32, 0xd6, 0x6b, 0xa7, 0xc1, 0x1e, 0x4d, 0xa3, 0xdd, 0x51,
-	0x0c, 0x7e, 0xd8, 0x0d, 0x21, 0x4a, 0x5f, 0x8d, 0xef, 0xbf, 0x90, 0x9b, 0x31, 0x76, 0x47, 0x3a,
-	0x26, 0x7d, 0x26, 0xbf, 0x20, 0xfc, 0xea, 0x67, 0x31, 0x8d, 0x78, 0x07, 0x62, 0xde, 0x64, 0xe9,
-	0x41, 0x21, 0x39, 0xc5, 0x7e, 0x24, 0xd4, 0x16, 0xdd, 0x99, 0xf5, 0xca, 0x5f, 0x43, 0xfa, 0x7b,
-	0x9f, 0xbc, 0x67, 0xf6, 0x27, 0x74, 0xa0, 0xd7, 0x61, 0xea, 0x08, 0xba, 0x23, 0xed, 0x80, 0x0b,
-	0x2a, 0x60, 0x4c, 0x7e, 0x46, 0xf8, 0xc5, 0x26, 0xc0, 0x47, 0x51, 0x87, 0x91, 0xb2, 0x39, 0xbf,
-	0x5a, 0xd6, 0x94, 0x6b, 0x53, 0x54, 0x8a, 0xed, 0x44, 0xb2, 0x1d, 0x12, 0xc7, 0xcc, 0xd6, 0x01,
-	0xf0, 0xc2, 0xa8, 0xc3, 0xee, 0x80, 0x64, 0x2b, 0x8d, 0x4f, 0x5f, 0x27, 0x8b, 0xb9, 0x11, 0xe4,
-	0x1a, 0xe1, 0x97, 0x74, 0x39, 0x92, 0xee, 0xdf, 0x28, 0xae, 0xd8, 0xbd, 0xc6, 0xaf, 0xcc, 0xa0,
-	0x54, 0xe4, 0x5f, 0x4a, 0xf2, 0x3e, 0x39, 0x2a, 0xae, 0x6a, 0xd2, 0xf5, 0xee, 0x88, 0xb3, 0x5e,
-	0xec, 0xc3, 0xbd, 0xbe, 0xe0, 0x22, 0x8c, 0xe4, 0xc5, 0x75, 0xfb, 0x8e, 0x5e, 0xb0, 0x5e, 0x24,
-	0xc6, 0xa7, 0x65, 0x62, 0x4f, 0xff, 0x22, 0x19, 0xe2, 0x92, 0xdc, 0x64, 0x4e, 0x56, 0xcd, 0xc8,
-	0xe9, 0xaa, 0xf6, 0x55, 0x2e, 0x16, 0x29, 0x4b, 0x65, 0x69, 0xc9, 0x22, 0xcb, 0x66, 0x00, 0x3f,
-	0x4d, 0xf8, 0x15, 0xc2, 0x25, 0x39, 0x01, 0x72, 0x73, 0xa7, 0xab, 0x53, 0x72, 0x6b, 0x91, 0xca,
-	0xbd, 0x2d, 0x73, 0xbf, 0x45, 0xca, 0xe6, 0xdc, 0x72, 0xdb, 0xb9, 0x6e, 0x03, 0xf2, 0x1d, 0xc2,
-	0x58, 0xc2, 0x9f, 0x24, 0xfd, 0x49, 0xd6, 0x0b, 0xec, 0x49, 0x85, 0x66, 0xd9, 0x98, 0x2e, 0x54,
-	0x3c, 0x35, 0xc9, 0xb3, 0x45, 0x2a, 0x05, 0xb5, 0xf0, 0xe4, 0xe9, 0xb8, 0x85, 0xfa, 0x09, 0xe1,
-	0xf9, 0xb4, 0xa2, 0xfb, 0x43, 0xe9, 0x2e, 0xef, 0xa6, 0xca, 0x88, 0xa6, 0xdc, 0x54, 0x0f, 0xb4,
-	0x8a, 0x6e, 0x57, 0xd2, 0xb9, 0xa4, 0x5a, 0xb4, 0x53, 0x5e, 0x6b, 0x98, 0x4e, 0x5e, 0x7d, 0x6a,
-	0xc8, 0x1f, 0xf2, 0x6e, 0x50, 0x53, 0x4a, 0x4f, 0xd9, 0xdc, 0xbb, 0x21, 0xab, 0x9b, 0x7a, 0x37,
-	0x3c, 0x94, 0x2b, 0xd4, 0x23, 0x89, 0xfa, 0x21, 0x69, 0x9a, 0x51, 0xb3, 0x53, 0x54, 0x0e, 0xd6,
-	0xec, 0xd4, 0xbc, 0x7b, 0x96, 0x63, 0x76, 0xff, 0x93, 0xbf, 0x6e, 0x2c, 0x74, 0x75, 0x63, 0xa1,
-	0xeb, 0x1b, 0x0b, 0x7d, 0x33, 0xb1, 0xe6, 0x7e, 0x9f, 0x58, 0xe8, 0x6a, 0x62, 0xcd, 0xfd, 0x33,
-	0xb1, 0xe6, 0x4e, 0xeb, 0x41, 0x28, 0xce, 0x7a, 0x2d, 0xc7, 0x67, 0x17, 0x2a, 0x5f, 0x04, 0xe2,
-	0x0b, 0x16, 0x7f, 0xae, 0x9e, 0xaa, 0x3e, 0x8b, 0xc1, 0x1d, 0x28, 0x08, 0x31, 0xec, 0x02, 0x6f,
-	0x95, 0xe4, 0x3f, 0xbf, 0xb7, 0x9f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xec, 0xb8, 0xda, 0xaa,
-	0x0a, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xc7, 0xd3, 0x48, 0x04, 0xa9, 0x21, 0x02, 0x5a, 0x91, 0xd0, 0x86, 0x30, 0x0a, 0x13, 0x2f,
+	0xf9, 0x9e, 0x56, 0xcc, 0x2e, 0x88, 0xe5, 0x94, 0x25, 0x8a, 0x40, 0x22, 0x2b, 0xd8, 0xec, 0x29,
+	0x97, 0x51, 0x67, 0x5c, 0x9e, 0x1d, 0x91, 0x4c, 0x7b, 0xbb, 0xdb, 0x21, 0xc6, 0xf8, 0x00, 0x4f,
+	0xc0, 0xc7, 0x89, 0x0b, 0x3c, 0x00, 0x0f, 0x00, 0xe2, 0x04, 0x9c, 0x38, 0xae, 0xc4, 0x85, 0x1b,
+	0xab, 0x18, 0xf1, 0x1c, 0x2b, 0xd7, 0x74, 0x7b, 0x33, 0x49, 0xcf, 0xd8, 0xb9, 0x79, 0xa6, 0xff,
+	0x35, 0xfd, 0xfb, 0x57, 0x57, 0x55, 0x9b, 0x86, 0xe2, 0x0c, 0x8e, 0x85, 0xe2, 0x39, 0x9c, 0x75,
+	0x35, 0x3f, 0xdd, 0x3e, 0x02, 0x23, 0xb6, 0xb9, 0x06, 0x75, 0x9a, 0x25, 0x10, 0x75, 0x94, 0x34,
+	0x92, 0xcd, 0x17, 0x9a, 0x08, 0x35, 0x91, 0xd5, 0x2c, 0xcc, 0xa7, 0x32, 0x95, 0x28, 0xe0, 0xa3,
+	0x5f, 0x85, 0x76, 0x61, 0x31, 0x95, 0x32, 0x3d, 0x06, 0x2e, 0x3a, 0x19, 0x17, 0x79, 0x2e, 0x8d,
+	0x30, 0x99, 0xcc, 0xb5, 0x5d, 0x7d, 0xc3, 0xbb, 0x9b, 0x39, 0xb3, 0xcb, 0x4b, 0xde, 0xe5, 0x47,
+	0x5d, 0x50, 0xbd, 0x42, 0xd1, 0xfc, 0x77, 0x96, 0xd2, 0x7d, 0x9d, 0x1e, 0x14, 0x7c, 0xec, 0x37,
+	0x42, 0x5f, 0xbb, 0x0f, 0x69, 0xa6, 0x0d, 0xa8, 0x0f, 0x1e, 0x8a, 0x2c, 0xdf, 0x17, 0x59, 0x6e,
+	0x44, 0x96, 0x83, 0x62, 0xb7, 0x22, 0x1f, 0x76, 0x54, 0x21, 0xbf, 0x0f, 0x8f, 0xba, 0xa0, 0xcd,
+	0xc2, 0xed, 0x6b, 0x46, 0xe9, 0x8e, 0xcc, 0x35, 0x84, 0xcd, 0xaf, 0xff, 0xfe, 0xef, 0xfb, 0xe7,
+	0x36, 0xc3, 0x15, 0x5e, 0xb2, 0xa0, 0x6c, 0x58, 0x9c, 0x8c, 0xe2, 0xe2, 0x93, 0x71, 0xe0, 0x1d,
+	0xb2, 0xce, 0xfe, 0x24, 0xf4, 0xc6, 0x2e, 0xa8, 0x0a, 0xfc, 0x77, 0xfc, 0x20, 0x95, 0x01, 0xce,
+	0xc0, 0xbb, 0xd7, 0x8e, 0xb3, 0x16, 0x6e, 0xa1, 0x85, 0x28, 0x5c, 0x2b, 0x5b, 0x68, 0x41, 0xad,
+	0x89, 0x6f, 0x09, 0x9d, 0xdb, 0x49, 0x4c, 0x76, 0x2a, 0x0c, 0xe0, 0x97, 0xd9, 0xba, 0x1f, 0xa0,
+	0x24, 0x72, 0xb0, 0x1b, 0x53, 0x69, 0x2d, 0xe0, 0x0a, 0x02, 0xbe, 0x19, 0x2e, 0x96, 0x01, 0x85,
+	0x15, 0x17, 0x78, 0x23, 0xa6, 0x1f, 0x08, 0x7d, 0x79, 0x17, 0x44, 0x89, 0x6a, 0xb3, 0x2a, 0x2d,
+	0xc2, 0xc7, 0xb5, 0x35, 0xa5, 0xda, 0x92, 0xad, 0x21, 0xd9, 0x72, 0x18, 0x5c, 0x4e, 0xdd, 0x55,
+	0xb6, 0x1f, 0x09, 0x7d, 0xc5, 0x15, 0xd3, 0x8e, 0xd6, 0x60, 0xf6, 0x00, 0xd8, 0x56, 0x7d, 0xd1,
+	0x39, 0x9d, 0xa3, 0x8b, 0xa6, 0x95, 0x5b, 0xbc, 0x0d, 0xc4, 0xbb, 0x19, 0x2e, 0x55, 0x14, 0xa7,
+	0x18, 0x05, 0xc4, 0x6d, 0x80, 0x3b, 0x64, 0xbd, 0xf9, 0xeb, 0x1c, 0x7d, 0xe9, 0xd3, 0x51, 0xc7,
+	0xb9, 0x1e, 0xfb, 0x9f, 0xd0, 0xf9, 0x8f, 0x85, 0x01, 0x6d, 0x76, 0xa1, 0x23, 0x75, 0x66, 0x76,
+	0x5a, 0x2d, 0x05, 0x5a, 0xb3, 0x6d, 0x3f, 0x86, 0x4f, 0xeb, 0xc8, 0x9b, 0xd7, 0x09, 0xb1, 0xf4,
+	0x29, 0xd2, 0x0b, 0x16, 0x73, 0xef, 0x74, 0x38, 0xc6, 0xd8, 0xb8, 0x55, 0x04, 0xc7, 0xa2, 0x88,
+	0xe6, 0x7d, 0x05, 0x49, 0xd6, 0xc9, 0x20, 0x2f, 0x5e, 0x0d, 0x2e, 0xbe, 0xc0, 0xc3, 0x18, 0xf0,
+	0xbe, 0x8b, 0x29, 0x9e, 0xd9, 0x2f, 0x84, 0xbe, 0xfa, 0x40, 0x89, 0x5c, 0xb7, 0x41, 0xe9, 0x3d,
+	0x59, 0x34, 0x0a, 0xab, 0x48, 0xf6, 0x15, 0xa1, 0xb3, 0xc8, 0xa7, 0xd6, 0x5b, 0x7f, 0x3b, 0xe8,
+	0xef, 0x7d, 0xf6, 0x9e, 0xdf, 0x9f, 0x71, 0x81, 0x71, 0x5b, 0xda, 0x16, 0xe4, 0x7d, 0xe7, 0x40,
+	0x1b, 0x61, 0x60, 0xc0, 0x7e, 0x26, 0xf4, 0x85, 0x3d, 0x80, 0x8f, 0xf2, 0xb6, 0x64, 0x0d, 0xff,
+	0xfe, 0x76, 0xd9, 0x51, 0xde, 0x9c, 0xa0, 0xb2, 0x6c, 0x07, 0xc8, 0xb6, 0xcf, 0x22, 0x3f, 0x5b,
+	0x1b, 0x20, 0xce, 0xf2, 0xb6, 0x7c, 0x06, 0x84, 0xa5, 0x34, 0x38, 0x7c, 0x9d, 0xdd, 0xa8, 0x8c,
+	0x60, 0x4f, 0x08, 0x7d, 0xd1, 0xa5, 0x63, 0x54, 0xfd, 0xab, 0xf5, 0x19, 0xbb, 0x50, 0xf8, 0x6b,
+	0x53, 0x28, 0x2d, 0xf9, 0x97, 0x48, 0x7e, 0xca, 0xee, 0xd5, 0x67, 0x75, 0x54, 0xf5, 0xbc, 0xaf,
+	0x65, 0x57, 0x25, 0x70, 0xa1, 0x2e, 0xb4, 0xc9, 0x72, 0xbc, 0xb8, 0xc6, 0xef, 0xc4, 0x89, 0xec,
+	0xe6, 0x66, 0x70, 0xd8, 0x60, 0xe1, 0xe4, 0x2f, 0xb2, 0x1e, 0x9d, 0xc5, 0x43, 0xd6, 0x6c, 0xd9,
+	0x8f, 0x5c, 0xac, 0x3a, 0x5f, 0x8d, 0x7a, 0x91, 0xb5, 0xd4, 0x40, 0x4b, 0x01, 0x5b, 0xf4, 0x03,
+	0x24, 0xc5, 0x86, 0x5f, 0x11, 0x3a, 0x8b, 0x13, 0xa0, 0x72, 0xef, 0x62, 0x75, 0xc2, 0xde, 0x4e,
+	0x64, 0xf7, 0xde, 0xc4, 0xbd, 0xdf, 0x62, 0x0d, 0xff, 0xde, 0x78, 0xec, 0xda, 0x95, 0x01, 0xfb,
+	0x8e, 0x50, 0x8a, 0xf0, 0x07, 0x46, 0x18, 0x60, 0x2b, 0x35, 0xf6, 0x50, 0xe1, 0x58, 0x56, 0x27,
+	0x0b, 0x2d, 0xcf, 0x36, 0xf2, 0x6c, 0xb0, 0xb5, 0x9a, 0x5c, 0xc4, 0xd8, 0x1d, 0x63, 0xa8, 0x9f,
+	0x08, 0x9d, 0x2b, 0x32, 0x7a, 0xb7, 0x87, 0xee, 0xaa, 0x6e, 0xaa, 0x92, 0x68, 0xc2, 0x4d, 0x75,
+	0x49, 0x6b, 0xe9, 0x6e, 0x23, 0x1d, 0x67, 0x5b, 0x75, 0x27, 0x15, 0x1f, 0xf5, 0x8a, 0xc9, 0xeb,
+	0xba, 0x86, 0xfd, 0x81, 0x77, 0x83, 0x9d, 0x52, 0x6e, 0xca, 0x56, 0xde, 0x0d, 0x65, 0xdd, 0xc4,
+	0xbb, 0xe1, 0xb2, 0xdc, 0xa2, 0xde, 0x43, 0xd4, 0x0f, 0xd9, 0x9e, 0x1f, 0xb5, 0x3c, 0x45, 0x71,
+	0xb0, 0x96, 0xa7, 0xe6, 0xb3, 0x67, 0x1c, 0xb3, 0xec, 0x0b, 0xfa, 0xfc, 0x03, 0x25, 0x12, 0x60,
+	0x61, 0x65, 0xaf, 0x26, 0xe3, 0xf3, 0x5e, 0xae, 0xd5, 0x58, 0xc2, 0x55, 0x24, 0x0c, 0xd9, 0x52,
+	0x65, 0xdf, 0x25, 0xc0, 0xfb, 0x59, 0x6b, 0x70, 0xf7, 0x93, 0xbf, 0xce, 0x03, 0xf2, 0xf8, 0x3c,
+	0x20, 0x4f, 0xce, 0x03, 0xf2, 0xcd, 0x30, 0x98, 0xf9, 0x7d, 0x18, 0x90, 0xc7, 0xc3, 0x60, 0xe6,
+	0x9f, 0x61, 0x30, 0x73, 0xd8, 0x4c, 0x33, 0xf3, 0xb0, 0x7b, 0x14, 0x25, 0xf2, 0xc4, 0x7e, 0x29,
+	0x07, 0xf3, 0xb9, 0x54, 0x9f, 0xd9, 0xa7, 0xad, 0x44, 0x2a, 0xe0, 0x67, 0xf6, 0xf3, 0xa6, 0xd7,
+	0x01, 0x7d, 0x34, 0x8b, 0xff, 0x3a, 0xdf, 0x7e, 0x3a, 0x00, 0xd4, 0x95, 0x03, 0x21, 0x26, 0x0b,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -341,6 +343,9 @@ type QueryServiceClient interface {
 	ChainsByAsset(ctx context.Context, in *ChainsByAssetRequest, opts ...grpc.CallOption) (*ChainsByAssetResponse, error)
 	// RecipientAddress queries the recipient address for a given deposit address
 	RecipientAddress(ctx context.Context, in *RecipientAddressRequest, opts ...grpc.CallOption) (*RecipientAddressResponse, error)
+	// Trace queries the lifecycle of the cross-chain transfers started by a
+	// source tx, or of a single transfer
+	Trace(ctx context.Context, in *TraceRequest, opts ...grpc.CallOption) (*TraceResponse, error)
 }
 
 type queryServiceClient struct {
@@ -432,6 +437,15 @@ func (c *queryServiceClient) RecipientAddress(ctx context.Context, in *Recipient
 	return out, nil
 }
 
+func (c *queryServiceClient) Trace(ctx context.Context, in *TraceRequest, opts ...grpc.CallOption) (*TraceResponse, error) {
+	out := new(TraceResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/Trace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	// LatestDepositAddress queries the a deposit address by recipient
@@ -453,6 +467,9 @@ type QueryServiceServer interface {
 	ChainsByAsset(context.Context, *ChainsByAssetRequest) (*ChainsByAssetResponse, error)
 	// RecipientAddress queries the recipient address for a given deposit address
 	RecipientAddress(context.Context, *RecipientAddressRequest) (*RecipientAddressResponse, error)
+	// Trace queries the lifecycle of the cross-chain transfers started by a
+	// source tx, or of a single transfer
+	Trace(context.Context, *TraceRequest) (*TraceResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -486,6 +503,9 @@ func (*UnimplementedQueryServiceServer) ChainsByAsset(ctx context.Context, req *
 func (*UnimplementedQueryServiceServer) RecipientAddress(ctx context.Context, req *RecipientAddressRequest) (*RecipientAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecipientAddress not implemented")
 }
+func (*UnimplementedQueryServiceServer) Trace(ctx context.Context, req *TraceRequest) (*TraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trace not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -653,6 +673,24 @@ func _QueryService_RecipientAddress_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_Trace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Trace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.QueryService/Trace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Trace(ctx, req.(*TraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.nexus.v1beta1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -693,6 +731,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecipientAddress",
 			Handler:    _QueryService_RecipientAddress_Handler,
 		},
+		{
+			MethodName: "Trace",
+			Handler:    _QueryService_Trace_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/nexus/v1beta1/service.proto",
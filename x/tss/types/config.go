@@ -7,6 +7,14 @@ type TssConfig struct {
 	Host        string        `mapstructure:"tofnd-host"`
 	Port        string        `mapstructure:"tofnd-port"`
 	DialTimeout time.Duration `mapstructure:"tofnd-dial-timeout"`
+
+	// TLS secures the connection to tofnd. The server certificate is verified with TLSCACert, or the system's root CAs if it is not set.
+	// Mutual TLS is used if a client certificate and key are set.
+	TLS           bool   `mapstructure:"tofnd-tls"`
+	TLSCACert     string `mapstructure:"tofnd-tls-ca-cert"`
+	TLSClientCert string `mapstructure:"tofnd-tls-client-cert"`
+	TLSClientKey  string `mapstructure:"tofnd-tls-client-key"`
+	TLSServerName string `mapstructure:"tofnd-tls-server-name"` // Overrides the host name that is used to verify the server certificate
 }
 
 // DefaultConfig returns the default tss configuration
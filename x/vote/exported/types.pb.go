@@ -153,6 +153,7 @@ var xxx_messageInfo_PollKey proto.InternalMessageInfo
 type PollParticipants struct {
 	PollID       PollID                                          `protobuf:"varint,1,opt,name=poll_id,json=pollId,proto3,customtype=PollID" json:"poll_id"`
 	Participants []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,rep,name=participants,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"participants,omitempty"`
+	ExpiresAt    int64                                           `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *PollParticipants) Reset()         { *m = PollParticipants{} }
@@ -200,60 +201,60 @@ func init() {
 }
 
 var fileDescriptor_9e15e2bdf7e02581 = []byte{
-	// 844 bytes of a gzipped FileDescriptorProto
+	// 845 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0xe3, 0x44,
 	0x18, 0xc6, 0xe3, 0x34, 0x75, 0x93, 0x49, 0xda, 0x78, 0x47, 0x55, 0x65, 0x22, 0x70, 0xbc, 0x65,
 	0xb5, 0x1b, 0x15, 0x6a, 0xab, 0x0b, 0x27, 0x24, 0x0e, 0x49, 0x93, 0xa2, 0x94, 0x34, 0x6b, 0xb9,
 	0xdd, 0x15, 0xe2, 0x62, 0x4d, 0x3d, 0x43, 0x62, 0xad, 0xed, 0xb1, 0x66, 0x26, 0xdd, 0xf4, 0x1b,
-	0xa0, 0x9e, 0x38, 0x72, 0xa0, 0x12, 0x12, 0x1c, 0xb8, 0xc3, 0x9d, 0x6b, 0xc5, 0x69, 0x8f, 0x88,
-	0x43, 0x05, 0xed, 0xb7, 0xe0, 0x84, 0xc6, 0x7f, 0xb2, 0xa9, 0x16, 0xf5, 0xb4, 0xa7, 0xcc, 0x3c,
-	0xf3, 0x9b, 0x37, 0xef, 0xf3, 0xcc, 0x9b, 0x80, 0x0e, 0x9a, 0x93, 0x10, 0x31, 0xfb, 0x8c, 0x0a,
-	0x62, 0x93, 0x79, 0x42, 0x99, 0x20, 0xd8, 0x3e, 0xdb, 0x3b, 0x25, 0x02, 0xed, 0xd9, 0xe2, 0x3c,
-	0x21, 0xdc, 0x4a, 0x18, 0x15, 0x14, 0xbe, 0x9f, 0x91, 0x96, 0x24, 0xad, 0x82, 0xb4, 0x72, 0xb2,
-	0xb5, 0x39, 0xa1, 0x13, 0x9a, 0x82, 0xb6, 0x5c, 0x65, 0x77, 0x5a, 0xef, 0x4d, 0x28, 0x9d, 0x84,
-	0xc4, 0x4e, 0x77, 0xa7, 0xb3, 0x6f, 0x6c, 0x14, 0x9f, 0x17, 0x47, 0x3e, 0xe5, 0x11, 0xe5, 0x5e,
-	0x76, 0x27, 0xdb, 0xe4, 0x47, 0x1f, 0xe7, 0x3d, 0xf1, 0x18, 0x25, 0x7c, 0x4a, 0xc5, 0xbd, 0x7d,
-	0xb5, 0x1e, 0xe5, 0xf4, 0x4c, 0x04, 0x21, 0x7f, 0x43, 0x4c, 0x19, 0xe1, 0x53, 0x1a, 0xe2, 0x8c,
-	0xda, 0xfe, 0x7d, 0x15, 0x34, 0x1c, 0x1a, 0x86, 0x47, 0x44, 0x20, 0x8c, 0x04, 0x82, 0x1f, 0x00,
-	0x40, 0xe6, 0x49, 0xc0, 0x08, 0xf7, 0x90, 0xd0, 0x57, 0x4c, 0xa5, 0xb3, 0xe2, 0xd6, 0x72, 0xa5,
-	0x2b, 0xe0, 0x57, 0x40, 0x65, 0x84, 0xcf, 0x42, 0xa1, 0x57, 0x4c, 0xa5, 0x53, 0x7f, 0xba, 0x69,
-	0x65, 0x56, 0xac, 0xc2, 0x8a, 0xd5, 0x8d, 0xcf, 0x7b, 0x3b, 0x7f, 0xfc, 0xb6, 0xfb, 0x78, 0x12,
-	0x88, 0xe9, 0xec, 0xd4, 0xf2, 0x69, 0x94, 0xdb, 0xb0, 0x7d, 0x8a, 0x89, 0x6f, 0x3b, 0x92, 0x3c,
-	0x42, 0x8c, 0x4f, 0x51, 0x48, 0x98, 0x9b, 0xd7, 0x83, 0x0e, 0xd0, 0xce, 0xa8, 0x08, 0xe2, 0x89,
-	0xb7, 0xe8, 0x51, 0x5f, 0x4d, 0xbf, 0xa3, 0x6d, 0xe5, 0x11, 0xa7, 0x56, 0x8a, 0x68, 0xad, 0x93,
-	0x02, 0xeb, 0x55, 0xae, 0xae, 0xdb, 0x25, 0xb7, 0x99, 0x5d, 0x5f, 0xc8, 0xf0, 0x73, 0xb0, 0xca,
-	0x05, 0x12, 0x44, 0x57, 0x4d, 0xa5, 0xb3, 0xf1, 0xf4, 0x89, 0x75, 0xdf, 0x4b, 0x59, 0x32, 0x85,
-	0x63, 0x89, 0xbb, 0xd9, 0x2d, 0xf8, 0x18, 0x34, 0xa3, 0x20, 0xf6, 0x24, 0xcd, 0x3c, 0x9f, 0xce,
-	0x62, 0xa1, 0xaf, 0xa5, 0x71, 0xac, 0x47, 0x41, 0xfc, 0x42, 0xaa, 0xfb, 0x52, 0x84, 0x1d, 0xa0,
-	0x31, 0xf2, 0x0a, 0x31, 0xec, 0x25, 0x94, 0x86, 0x5e, 0x8c, 0x22, 0xa2, 0x03, 0x53, 0xe9, 0xd4,
-	0xdc, 0x8d, 0x4c, 0x77, 0x28, 0x0d, 0xc7, 0x28, 0x22, 0xf0, 0x21, 0x68, 0x4c, 0x18, 0xf2, 0x89,
-	0x97, 0x10, 0x16, 0x50, 0xac, 0xd7, 0xd3, 0x72, 0xf5, 0x54, 0x73, 0x52, 0x49, 0x22, 0x3e, 0x8d,
-	0x92, 0x90, 0x08, 0x82, 0xe5, 0x03, 0x34, 0x32, 0x64, 0xa1, 0x75, 0x05, 0x7c, 0x04, 0xca, 0x01,
-	0xd6, 0xd7, 0x4d, 0xa5, 0x53, 0xe9, 0x6d, 0x4a, 0xe7, 0x7f, 0x5d, 0xb7, 0x55, 0xd9, 0xfd, 0xb0,
-	0x7f, 0x73, 0xdd, 0x2e, 0x0f, 0xfb, 0x6e, 0x39, 0xc0, 0x70, 0x04, 0xaa, 0xc5, 0x9c, 0xe8, 0xcd,
-	0x34, 0xc6, 0x9d, 0xc2, 0x7f, 0xa1, 0xbf, 0x9d, 0xc1, 0x71, 0x7e, 0x92, 0x27, 0xba, 0xa8, 0x00,
-	0xb7, 0x80, 0x1a, 0x51, 0x3c, 0x0b, 0x89, 0xae, 0xa5, 0xce, 0xf2, 0x1d, 0x0c, 0x40, 0x33, 0x5b,
-	0x79, 0x51, 0x3e, 0x40, 0xfa, 0x83, 0x77, 0x34, 0x17, 0x1b, 0x59, 0xe1, 0x62, 0x30, 0x0f, 0x2b,
-	0x55, 0x45, 0x2b, 0x1f, 0x56, 0xaa, 0x55, 0xad, 0x76, 0x58, 0xa9, 0xd6, 0x34, 0x70, 0x58, 0xa9,
-	0x6e, 0x68, 0xcd, 0xed, 0x2e, 0x58, 0x93, 0xe6, 0xbf, 0x24, 0xe7, 0x4b, 0x5d, 0x2a, 0x77, 0xba,
-	0xdc, 0x4a, 0x13, 0x2b, 0x4b, 0xad, 0xa7, 0xbe, 0xc9, 0xe8, 0x33, 0xf5, 0xfb, 0x1f, 0xdb, 0x25,
-	0x5d, 0xd9, 0xfe, 0x41, 0x01, 0x9a, 0xac, 0xe1, 0x20, 0x26, 0x02, 0x3f, 0x48, 0x50, 0x2c, 0x38,
-	0xdc, 0x03, 0x6b, 0x09, 0x0d, 0x43, 0x2f, 0xc0, 0x69, 0xb5, 0x4a, 0x4f, 0x7f, 0x2b, 0xeb, 0x7c,
-	0xe5, 0xaa, 0x12, 0x1c, 0x62, 0xf8, 0x1c, 0x34, 0x92, 0xa5, 0x12, 0x7a, 0xd9, 0x5c, 0xe9, 0x34,
-	0x7a, 0x7b, 0xff, 0x5e, 0xb7, 0x77, 0xff, 0xcf, 0xb4, 0xfc, 0xd8, 0xe5, 0xf8, 0x65, 0xfe, 0xb3,
-	0x7d, 0x81, 0xc2, 0x2e, 0xc6, 0x8c, 0x70, 0xee, 0xde, 0x29, 0xb3, 0xf3, 0xab, 0x02, 0x6a, 0x8b,
-	0xe9, 0x84, 0x1f, 0x81, 0x2d, 0xe7, 0xd9, 0x68, 0xe4, 0x1d, 0x9f, 0x74, 0x4f, 0x06, 0xde, 0xf3,
-	0xf1, 0xb1, 0x33, 0xd8, 0x1f, 0x1e, 0x0c, 0x07, 0x7d, 0xad, 0xd4, 0x6a, 0x5e, 0x5c, 0x9a, 0xf5,
-	0x31, 0x8d, 0x07, 0xf3, 0x80, 0x0b, 0x12, 0x0b, 0xf8, 0x21, 0x80, 0x4b, 0xb0, 0x33, 0x18, 0xf7,
-	0x87, 0xe3, 0x2f, 0x34, 0xa5, 0x55, 0xbf, 0xb8, 0x34, 0xd7, 0x1c, 0x12, 0xe3, 0x20, 0x9e, 0xc0,
-	0x27, 0x60, 0x73, 0x09, 0xda, 0x7f, 0x76, 0xe4, 0x8c, 0x06, 0x27, 0x83, 0xbe, 0x56, 0x6e, 0xad,
-	0x5f, 0x5c, 0x9a, 0xb5, 0xfd, 0x62, 0xf6, 0xe0, 0x43, 0xf0, 0x60, 0x09, 0x3c, 0xe8, 0x0e, 0x47,
-	0x83, 0xbe, 0xb6, 0xd2, 0x02, 0x17, 0x97, 0xa6, 0x7a, 0x80, 0x82, 0x90, 0xe0, 0x56, 0xf5, 0xdb,
-	0x9f, 0x8c, 0xd2, 0x2f, 0x3f, 0x1b, 0x4a, 0xcf, 0xbd, 0xfa, 0xc7, 0x28, 0x5d, 0xdd, 0x18, 0xca,
-	0xeb, 0x1b, 0x43, 0xf9, 0xfb, 0xc6, 0x50, 0xbe, 0xbb, 0x35, 0x4a, 0xaf, 0x6f, 0x8d, 0xd2, 0x9f,
-	0xb7, 0x46, 0xe9, 0xeb, 0x4f, 0x97, 0x02, 0xc9, 0xc6, 0x32, 0x26, 0xe2, 0x15, 0x65, 0x2f, 0xf3,
-	0xdd, 0xae, 0x4f, 0x19, 0xb1, 0xe7, 0x77, 0xff, 0x7f, 0x4f, 0xd5, 0x74, 0x9a, 0x3e, 0xf9, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0xc1, 0xdf, 0x1c, 0xdd, 0x9e, 0x05, 0x00, 0x00,
+	0xa0, 0x9e, 0x38, 0x72, 0xa9, 0x84, 0x04, 0x07, 0xee, 0x70, 0xe3, 0xc0, 0xb5, 0xe2, 0xb4, 0x47,
+	0xc4, 0xa1, 0x82, 0xf6, 0x5b, 0x70, 0x42, 0xe3, 0x3f, 0xd9, 0x54, 0x8b, 0xca, 0x85, 0x53, 0x66,
+	0x9e, 0xf9, 0xcd, 0x9b, 0xf7, 0x79, 0xfc, 0xda, 0xa0, 0x83, 0xe6, 0x24, 0x44, 0xcc, 0x3e, 0xa3,
+	0x82, 0xd8, 0x64, 0x9e, 0x50, 0x26, 0x08, 0xb6, 0xcf, 0xf6, 0x4e, 0x89, 0x40, 0x7b, 0xb6, 0x38,
+	0x4f, 0x08, 0xb7, 0x12, 0x46, 0x05, 0x85, 0xef, 0x66, 0xa4, 0x25, 0x49, 0xab, 0x20, 0xad, 0x9c,
+	0x6c, 0x6d, 0x4e, 0xe8, 0x84, 0xa6, 0xa0, 0x2d, 0x57, 0xd9, 0x9d, 0xd6, 0x3b, 0x13, 0x4a, 0x27,
+	0x21, 0xb1, 0xd3, 0xdd, 0xe9, 0xec, 0x2b, 0x1b, 0xc5, 0xe7, 0xc5, 0x91, 0x4f, 0x79, 0x44, 0xb9,
+	0x97, 0xdd, 0xc9, 0x36, 0xf9, 0xd1, 0x87, 0x79, 0x4f, 0x3c, 0x46, 0x09, 0x9f, 0x52, 0x71, 0x6f,
+	0x5f, 0xad, 0x47, 0x39, 0x3d, 0x13, 0x41, 0xc8, 0xdf, 0x10, 0x53, 0x46, 0xf8, 0x94, 0x86, 0x38,
+	0xa3, 0xb6, 0x7f, 0x5d, 0x05, 0x0d, 0x87, 0x86, 0xe1, 0x11, 0x11, 0x08, 0x23, 0x81, 0xe0, 0x7b,
+	0x00, 0x90, 0x79, 0x12, 0x30, 0xc2, 0x3d, 0x24, 0xf4, 0x15, 0x53, 0xe9, 0xac, 0xb8, 0xb5, 0x5c,
+	0xe9, 0x0a, 0xf8, 0x05, 0x50, 0x19, 0xe1, 0xb3, 0x50, 0xe8, 0x15, 0x53, 0xe9, 0xd4, 0x9f, 0x6e,
+	0x5a, 0x99, 0x15, 0xab, 0xb0, 0x62, 0x75, 0xe3, 0xf3, 0xde, 0xce, 0x6f, 0x3f, 0xef, 0x3e, 0x9e,
+	0x04, 0x62, 0x3a, 0x3b, 0xb5, 0x7c, 0x1a, 0xe5, 0x36, 0x6c, 0x9f, 0x62, 0xe2, 0xdb, 0x8e, 0x24,
+	0x8f, 0x10, 0xe3, 0x53, 0x14, 0x12, 0xe6, 0xe6, 0xf5, 0xa0, 0x03, 0xb4, 0x33, 0x2a, 0x82, 0x78,
+	0xe2, 0x2d, 0x7a, 0xd4, 0x57, 0xd3, 0xff, 0x68, 0x5b, 0x79, 0xc4, 0xa9, 0x95, 0x22, 0x5a, 0xeb,
+	0xa4, 0xc0, 0x7a, 0x95, 0xab, 0xeb, 0x76, 0xc9, 0x6d, 0x66, 0xd7, 0x17, 0x32, 0xfc, 0x14, 0xac,
+	0x72, 0x81, 0x04, 0xd1, 0x55, 0x53, 0xe9, 0x6c, 0x3c, 0x7d, 0x62, 0xdd, 0xf7, 0xa4, 0x2c, 0x99,
+	0xc2, 0xb1, 0xc4, 0xdd, 0xec, 0x16, 0x7c, 0x0c, 0x9a, 0x51, 0x10, 0x7b, 0x92, 0x66, 0x9e, 0x4f,
+	0x67, 0xb1, 0xd0, 0xd7, 0xd2, 0x38, 0xd6, 0xa3, 0x20, 0x7e, 0x21, 0xd5, 0x7d, 0x29, 0xc2, 0x0e,
+	0xd0, 0x18, 0x79, 0x85, 0x18, 0xf6, 0x12, 0x4a, 0x43, 0x2f, 0x46, 0x11, 0xd1, 0x81, 0xa9, 0x74,
+	0x6a, 0xee, 0x46, 0xa6, 0x3b, 0x94, 0x86, 0x63, 0x14, 0x11, 0xf8, 0x10, 0x34, 0x26, 0x0c, 0xf9,
+	0xc4, 0x4b, 0x08, 0x0b, 0x28, 0xd6, 0xeb, 0x69, 0xb9, 0x7a, 0xaa, 0x39, 0xa9, 0x24, 0x11, 0x9f,
+	0x46, 0x49, 0x48, 0x04, 0xc1, 0xf2, 0x01, 0x34, 0x32, 0x64, 0xa1, 0x75, 0x05, 0x7c, 0x04, 0xca,
+	0x01, 0xd6, 0xd7, 0x4d, 0xa5, 0x53, 0xe9, 0x6d, 0x4a, 0xe7, 0x7f, 0x5c, 0xb7, 0x55, 0xd9, 0xfd,
+	0xb0, 0x7f, 0x73, 0xdd, 0x2e, 0x0f, 0xfb, 0x6e, 0x39, 0xc0, 0x70, 0x04, 0xaa, 0xc5, 0x9c, 0xe8,
+	0xcd, 0x34, 0xc6, 0x9d, 0xc2, 0x7f, 0xa1, 0xbf, 0x9d, 0xc1, 0x71, 0x7e, 0x92, 0x27, 0xba, 0xa8,
+	0x00, 0xb7, 0x80, 0x1a, 0x51, 0x3c, 0x0b, 0x89, 0xae, 0xa5, 0xce, 0xf2, 0x1d, 0x0c, 0x40, 0x33,
+	0x5b, 0x79, 0x51, 0x3e, 0x40, 0xfa, 0x83, 0xff, 0x69, 0x2e, 0x36, 0xb2, 0xc2, 0xc5, 0x60, 0x1e,
+	0x56, 0xaa, 0x8a, 0x56, 0x3e, 0xac, 0x54, 0xab, 0x5a, 0xed, 0xb0, 0x52, 0xad, 0x69, 0xe0, 0xb0,
+	0x52, 0xdd, 0xd0, 0x9a, 0xdb, 0x5d, 0xb0, 0x26, 0xcd, 0x7f, 0x4e, 0xce, 0x97, 0xba, 0x54, 0xee,
+	0x74, 0xb9, 0x95, 0x26, 0x56, 0x96, 0x5a, 0x4f, 0x7d, 0x93, 0xd1, 0x27, 0xea, 0xb7, 0xdf, 0xb5,
+	0x4b, 0xba, 0xb2, 0xfd, 0x8b, 0x02, 0x34, 0x59, 0xc3, 0x41, 0x4c, 0x04, 0x7e, 0x90, 0xa0, 0x58,
+	0x70, 0xb8, 0x07, 0xd6, 0x12, 0x1a, 0x86, 0x5e, 0x80, 0xd3, 0x6a, 0x95, 0x9e, 0xfe, 0x56, 0xd6,
+	0xf9, 0xca, 0x55, 0x25, 0x38, 0xc4, 0xf0, 0x39, 0x68, 0x24, 0x4b, 0x25, 0xf4, 0xb2, 0xb9, 0xd2,
+	0x69, 0xf4, 0xf6, 0xfe, 0xbe, 0x6e, 0xef, 0xfe, 0x9b, 0x69, 0xf9, 0xb3, 0xcb, 0xf1, 0xcb, 0xfc,
+	0xb5, 0x7d, 0x81, 0xc2, 0x2e, 0xc6, 0x8c, 0x70, 0xee, 0xde, 0x29, 0xf3, 0x1f, 0xaf, 0xe4, 0xce,
+	0x4f, 0x0a, 0xa8, 0x2d, 0x86, 0x17, 0x7e, 0x00, 0xb6, 0x9c, 0x67, 0xa3, 0x91, 0x77, 0x7c, 0xd2,
+	0x3d, 0x19, 0x78, 0xcf, 0xc7, 0xc7, 0xce, 0x60, 0x7f, 0x78, 0x30, 0x1c, 0xf4, 0xb5, 0x52, 0xab,
+	0x79, 0x71, 0x69, 0xd6, 0xc7, 0x34, 0x1e, 0xcc, 0x03, 0x2e, 0x48, 0x2c, 0xe0, 0xfb, 0x00, 0x2e,
+	0xc1, 0xce, 0x60, 0xdc, 0x1f, 0x8e, 0x3f, 0xd3, 0x94, 0x56, 0xfd, 0xe2, 0xd2, 0x5c, 0x73, 0x48,
+	0x8c, 0x83, 0x78, 0x02, 0x9f, 0x80, 0xcd, 0x25, 0x68, 0xff, 0xd9, 0x91, 0x33, 0x1a, 0x9c, 0x0c,
+	0xfa, 0x5a, 0xb9, 0xb5, 0x7e, 0x71, 0x69, 0xd6, 0xf6, 0x8b, 0xd1, 0x84, 0x0f, 0xc1, 0x83, 0x25,
+	0xf0, 0xa0, 0x3b, 0x1c, 0x0d, 0xfa, 0xda, 0x4a, 0x0b, 0x5c, 0x5c, 0x9a, 0xea, 0x01, 0x0a, 0x42,
+	0x82, 0x5b, 0xd5, 0xaf, 0xbf, 0x37, 0x4a, 0x3f, 0xfe, 0x60, 0x28, 0x3d, 0xf7, 0xea, 0x2f, 0xa3,
+	0x74, 0x75, 0x63, 0x28, 0xaf, 0x6f, 0x0c, 0xe5, 0xcf, 0x1b, 0x43, 0xf9, 0xe6, 0xd6, 0x28, 0xbd,
+	0xbe, 0x35, 0x4a, 0xbf, 0xdf, 0x1a, 0xa5, 0x2f, 0x3f, 0x5e, 0xca, 0x2b, 0x9b, 0xda, 0x98, 0x88,
+	0x57, 0x94, 0xbd, 0xcc, 0x77, 0xbb, 0x3e, 0x65, 0xc4, 0x9e, 0xdf, 0xfd, 0x3c, 0x9f, 0xaa, 0xe9,
+	0xb0, 0x7d, 0xf4, 0xcf, 0x00, 0x1c, 0xb2, 0xc0, 0xac, 0xbd, 0x05, 0x00, 0x00,
 }
 
 func (m *PollMetadata) Marshal() (dAtA []byte, err error) {
@@ -428,6 +429,11 @@ func (m *PollParticipants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Participants) > 0 {
 		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Participants[iNdEx])
@@ -535,6 +541,9 @@ func (m *PollParticipants) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -1104,6 +1113,25 @@ func (m *PollParticipants) Unmarshal(dAtA []byte) error {
 			m.Participants = append(m.Participants, make([]byte, postIndex-iNdEx))
 			copy(m.Participants[len(m.Participants)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])